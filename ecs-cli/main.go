@@ -20,15 +20,18 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/factory"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/credhelper"
 	attributecheckercommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/attributechecker"
 	clusterCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/cluster"
 	composeCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/compose"
 	configureCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/configure"
+	credhelperCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/credhelper"
 	imageCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/image"
 	licenseCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/license"
 	localCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/local"
 	logsCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/log"
 	regcredsCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/regcreds"
+	repoCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/repo"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/logger"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/version"
 	"github.com/sirupsen/logrus"
@@ -72,16 +75,22 @@ func main() {
 		clusterCommand.UpCommand(),
 		clusterCommand.DownCommand(),
 		clusterCommand.ScaleCommand(),
+		clusterCommand.MigrateTemplateCommand(),
+		clusterCommand.DescribeCommand(),
+		clusterCommand.UpgradeCommand(),
+		clusterCommand.InstancesCommand(),
 		clusterCommand.PsCommand(),
 		imageCommand.PushCommand(),
 		imageCommand.PullCommand(),
 		imageCommand.ImagesCommand(),
+		repoCommand.RepoCommand(),
 		licenseCommand.LicenseCommand(),
 		composeCommand.ComposeCommand(composeFactory),
 		attributecheckercommand.AttributecheckerCommand(),
 		logsCommand.LogCommand(),
 		regcredsCommand.RegistryCredsCommand(),
 		localCommand.LocalCommand(),
+		credhelperCommand.CredentialHelperCommand(),
 	}
 
 	app.Flags = []cli.Flag{
@@ -91,7 +100,7 @@ func main() {
 		},
 	}
 
-	err := app.Run(cliArgsWithoutTestFlags())
+	err := app.Run(credhelper.Args(cliArgsWithoutTestFlags()))
 	if err != nil {
 		logrus.Fatal(err)
 	}
@@ -16,6 +16,7 @@ package cluster
 import (
 	"bufio"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strconv"
 	"strings"
@@ -70,6 +71,42 @@ const (
 	ParameterKeyIsFargate                = "IsFargate"
 	ParameterKeyUserData                 = "UserData"
 	ParameterKeySpotPrice                = "SpotPrice"
+	ParameterKeyMetadataHopLimit         = "MetadataHopLimit"
+	ParameterKeyIsDetailedMonitoring     = "IsDetailedMonitoring"
+	ParameterKeyRootVolumeSize           = "RootVolumeSize"
+	ParameterKeyRootVolumeType           = "RootVolumeType"
+	ParameterKeyIsEncryptedVolume        = "IsEncryptedVolume"
+	ParameterKeyRootDeviceName           = "RootDeviceName"
+	ParameterKeyAmiFamily                = "AmiFamily"
+	ParameterKeyIsCapacityProvider       = "IsCapacityProvider"
+	ParameterKeyTargetCapacity           = "TargetCapacity"
+	ParameterKeyMinScalingStep           = "MinimumScalingStepSize"
+	ParameterKeyMaxScalingStep           = "MaximumScalingStepSize"
+	ParameterKeyFargateCapacityProviders = "FargateCapacityProviders"
+	ParameterKeyInstanceTypes            = "InstanceTypes"
+	ParameterKeyOnDemandBase             = "OnDemandBaseCapacity"
+	ParameterKeyOnDemandPercentage       = "OnDemandPercentage"
+	ParameterKeySpotAllocationStrategy   = "SpotAllocationStrategy"
+	ParameterKeyIsPrivateSubnets         = "IsPrivateSubnets"
+	ParameterKeyNatGateway               = "NatGateway"
+	ParameterKeyIsVpcEndpoints           = "IsVpcEndpoints"
+)
+
+// Values of the --nat-gateway flag
+const (
+	natGatewaySingle = "single"
+	natGatewayPerAZ  = "per-az"
+	natGatewayNone   = "none"
+)
+
+// bottlerocketDataDeviceName is the device of the Bottlerocket volume which stores
+// container images, and which the root volume flags configure for Bottlerocket.
+const bottlerocketDataDeviceName = "/dev/xvdb"
+
+// Capacity providers which every account has
+const (
+	fargateCapacityProvider     = "FARGATE"
+	fargateSpotCapacityProvider = "FARGATE_SPOT"
 )
 
 const (
@@ -78,22 +115,45 @@ const (
 )
 
 var flagNamesToStackParameterKeys map[string]string
+
+// launchTemplateFlags are the flags which customize the launch template of the
+// container instances, and which 'migrate-template' accepts as well as 'up'
+var launchTemplateFlags = []string{flags.RootVolumeSizeFlag, flags.RootVolumeTypeFlag, flags.MetadataHopLimitFlag}
+
+// launchTemplateBoolFlags maps the boolean flags which customize the launch
+// template to the stack parameters they set to "true"
+var launchTemplateBoolFlags map[string]string
 var requiredParameters []string = []string{ParameterKeyCluster}
 
 func init() {
 	flagNamesToStackParameterKeys = map[string]string{
-		flags.AsgMaxSizeFlag:    ParameterKeyAsgMaxSize,
-		flags.VpcAzFlag:         ParameterKeyVPCAzs,
-		flags.SecurityGroupFlag: ParameterKeySecurityGroup,
-		flags.SourceCidrFlag:    ParameterKeySourceCidr,
-		flags.EcsPortFlag:       ParameterKeyEcsPort,
-		flags.SubnetIdsFlag:     ParameterKeySubnetIds,
-		flags.VpcIdFlag:         ParameterKeyVpcId,
-		flags.InstanceTypeFlag:  ParameterKeyInstanceType,
-		flags.KeypairNameFlag:   ParameterKeyKeyPairName,
-		flags.ImageIdFlag:       ParameterKeyAmiId,
-		flags.InstanceRoleFlag:  ParameterKeyInstanceRole,
-		flags.SpotPriceFlag:     ParameterKeySpotPrice,
+		flags.AsgMaxSizeFlag:             ParameterKeyAsgMaxSize,
+		flags.VpcAzFlag:                  ParameterKeyVPCAzs,
+		flags.SecurityGroupFlag:          ParameterKeySecurityGroup,
+		flags.SourceCidrFlag:             ParameterKeySourceCidr,
+		flags.EcsPortFlag:                ParameterKeyEcsPort,
+		flags.SubnetIdsFlag:              ParameterKeySubnetIds,
+		flags.VpcIdFlag:                  ParameterKeyVpcId,
+		flags.InstanceTypeFlag:           ParameterKeyInstanceType,
+		flags.KeypairNameFlag:            ParameterKeyKeyPairName,
+		flags.ImageIdFlag:                ParameterKeyAmiId,
+		flags.InstanceRoleFlag:           ParameterKeyInstanceRole,
+		flags.SpotPriceFlag:              ParameterKeySpotPrice,
+		flags.RootVolumeSizeFlag:         ParameterKeyRootVolumeSize,
+		flags.RootVolumeTypeFlag:         ParameterKeyRootVolumeType,
+		flags.MetadataHopLimitFlag:       ParameterKeyMetadataHopLimit,
+		flags.TargetCapacityFlag:         ParameterKeyTargetCapacity,
+		flags.MinScalingStepFlag:         ParameterKeyMinScalingStep,
+		flags.MaxScalingStepFlag:         ParameterKeyMaxScalingStep,
+		flags.OnDemandBaseFlag:           ParameterKeyOnDemandBase,
+		flags.OnDemandPercentageFlag:     ParameterKeyOnDemandPercentage,
+		flags.SpotAllocationStrategyFlag: ParameterKeySpotAllocationStrategy,
+		flags.NatGatewayFlag:             ParameterKeyNatGateway,
+	}
+	launchTemplateBoolFlags = map[string]string{
+		flags.IMDSv2Flag:             ParameterKeyIsIMDSv2,
+		flags.DetailedMonitoringFlag: ParameterKeyIsDetailedMonitoring,
+		flags.EncryptRootVolumeFlag:  ParameterKeyIsEncryptedVolume,
 	}
 }
 
@@ -133,6 +193,9 @@ func ClusterUp(c *cli.Context) {
 	if err != nil {
 		logrus.Fatal("Error executing 'up': ", err)
 	}
+	if c.Bool(flags.DryRunFlag) || c.String(flags.RenderTemplateFlag) != "" {
+		return
+	}
 
 	if !c.Bool(flags.EmptyFlag) {
 		// Displays resources create by CloudFormation, as a convenience for tasks launched
@@ -181,6 +244,78 @@ func ClusterScale(c *cli.Context) {
 	}
 }
 
+func ClusterMigrateTemplate(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'migrate-template': ", err)
+	}
+
+	commandConfig, err := newCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'migrate-template': ", err)
+	}
+
+	awsClients := newAWSClients(commandConfig)
+
+	if err := migrateClusterTemplate(c, awsClients, commandConfig); err != nil {
+		logrus.Fatal("Error executing 'migrate-template': ", err)
+	}
+}
+
+func ClusterDescribe(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'describe': ", err)
+	}
+
+	commandConfig, err := newCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'describe': ", err)
+	}
+
+	awsClients := newAWSClients(commandConfig)
+
+	if err := describeCluster(c, awsClients, commandConfig, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'describe': ", err)
+	}
+}
+
+func ClusterUpgrade(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'upgrade': ", err)
+	}
+
+	commandConfig, err := newCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'upgrade': ", err)
+	}
+
+	awsClients := newAWSClients(commandConfig)
+
+	if err := upgradeCluster(c, awsClients, newClusterInstancesAPI(awsClients), commandConfig); err != nil {
+		logrus.Fatal("Error executing 'upgrade': ", err)
+	}
+}
+
+func ClusterInstancesDrain(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'instances drain': ", err)
+	}
+
+	commandConfig, err := newCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'instances drain': ", err)
+	}
+
+	awsClients := newAWSClients(commandConfig)
+
+	if err := drainInstances(c, newClusterInstancesAPI(awsClients), commandConfig); err != nil {
+		logrus.Fatal("Error executing 'instances drain': ", err)
+	}
+}
+
 func ClusterPS(c *cli.Context) {
 	rdwr, err := config.NewReadWriter()
 	if err != nil {
@@ -236,14 +371,23 @@ func createCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 
 	}
 
-	// Check if cfn stack already exists
+	// Check if cfn stack already exists. Previewed changes update an existing
+	// stack in place instead of deleting it.
+	previewOptions, preview, err := getPreviewOptions(context)
+	if err != nil {
+		return err
+	}
+	renderPath := context.String(flags.RenderTemplateFlag)
 	stackName := commandConfig.CFNStackName
-	var deleteStack bool
+	var stackExists, deleteStack bool
 	if err = cfnClient.ValidateStackExists(stackName); err == nil {
-		if !isForceSet(context) {
-			return fmt.Errorf("A CloudFormation stack already exists for the cluster '%s'. Please specify '--%s' to clean up your existing resources", commandConfig.Cluster, flags.ForceFlag)
+		stackExists = true
+		if !preview && renderPath == "" {
+			if !isForceSet(context) {
+				return fmt.Errorf("A CloudFormation stack already exists for the cluster '%s'. Please specify '--%s' to clean up your existing resources, or '--%s' to review the changes to the existing stack", commandConfig.Cluster, flags.ForceFlag, flags.PreviewFlag)
+			}
+			deleteStack = true
 		}
-		deleteStack = true
 	}
 
 	tags := make([]*ecs.Tag, 0)
@@ -280,8 +424,12 @@ func createCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 		cfnParams.Add(ParameterKeyAssociatePublicIPAddress, "false")
 	}
 
-	if context.Bool(flags.IMDSv2Flag) {
-		cfnParams.Add(ParameterKeyIsIMDSv2, "true")
+	addLaunchTemplateBoolParams(context, cfnParams)
+	if err := addCapacityProviderParams(context, launchType, cfnParams); err != nil {
+		return err
+	}
+	if err := addPrivateSubnetParams(context, cfnParams); err != nil {
+		return err
 	}
 
 	if launchType == config.LaunchTypeFargate {
@@ -298,6 +446,11 @@ func createCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 		return fmt.Errorf("You can only specify '--%s' with the EC2 launch type", flags.UserDataFlag)
 	}
 
+	// Check that the AMI family is not specified with Fargate
+	if launchType == config.LaunchTypeFargate && context.String(flags.AMIFamilyFlag) != "" {
+		return fmt.Errorf("You can only specify '--%s' with the EC2 launch type", flags.AMIFamilyFlag)
+	}
+
 	// Check if 2 AZs are specified
 	if validateCommaSeparatedParam(cfnParams, ParameterKeyVPCAzs, 2, 2) {
 		return fmt.Errorf("You must specify 2 comma-separated availability zones with the '--%s' flag", flags.VpcAzFlag)
@@ -324,7 +477,7 @@ func createCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 	}
 
 	if launchType == config.LaunchTypeEC2 {
-		instanceType, err := getInstanceType(cfnParams)
+		instanceTypes, err := getInstanceTypes(context, cfnParams)
 		if err != nil {
 			return err
 		}
@@ -333,19 +486,25 @@ func createCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 			return fmt.Errorf("describe instance type offerings: %w", err)
 		}
 
-		if err = validateInstanceType(instanceType, supportedInstanceTypes); err != nil {
-			// if we detect the default value is unsupported then we'll suggest to the user overriding the value with the appropriate flag
-			if instanceType == cloudformation.DefaultECSInstanceType {
-				logrus.Warnf("Default instance type %s not supported in region %s. Override the default instance type with the --%s flag and provide a supported value.",
-					instanceType, commandConfig.Region(), flags.InstanceTypeFlag)
+		for _, instanceType := range instanceTypes {
+			if err = validateInstanceType(instanceType, supportedInstanceTypes); err != nil {
+				// if we detect the default value is unsupported then we'll suggest to the user overriding the value with the appropriate flag
+				if instanceType == cloudformation.DefaultECSInstanceType {
+					logrus.Warnf("Default instance type %s not supported in region %s. Override the default instance type with the --%s flag and provide a supported value.",
+						instanceType, commandConfig.Region(), flags.InstanceTypeFlag)
+				}
+				return fmt.Errorf(instanceTypeUnsupportedFmt, instanceType, commandConfig.Region(), err)
 			}
-			return fmt.Errorf(instanceTypeUnsupportedFmt, instanceType, commandConfig.Region(), err)
 		}
 
 		// Check if image id was supplied, else populate
 		_, err = cfnParams.GetParameter(ParameterKeyAmiId)
 		if err == cloudformation.ParameterNotFoundError {
-			err := populateAMIID(cfnParams, metadataClient)
+			amiFamily, err := getAMIFamily(context)
+			if err != nil {
+				return err
+			}
+			err = populateAMIID(cfnParams, metadataClient, amiFamily)
 			if err != nil {
 				return err
 			}
@@ -357,6 +516,52 @@ func createCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 		return err
 	}
 
+	var mixedInstanceTypes []string
+	if param, err := cfnParams.GetParameter(ParameterKeyInstanceTypes); err == nil {
+		mixedInstanceTypes = strings.Split(aws.StringValue(param.ParameterValue), ",")
+	}
+	template, err := cloudformation.GetClusterTemplate(tags, stackName, mixedInstanceTypes)
+	if err != nil {
+		return errors.Wrapf(err, "Error building cloudformation template")
+	}
+	if extensionFile := context.String(flags.TemplateExtensionFlag); extensionFile != "" {
+		if template, err = addTemplateExtension(template, extensionFile); err != nil {
+			return err
+		}
+	}
+
+	if renderPath != "" {
+		return cloudformation.RenderStack(renderPath, stackName, template, cfnParams, nil)
+	}
+
+	if preview {
+		changeSetType := sdkCFN.ChangeSetTypeCreate
+		if stackExists {
+			changeSetType = sdkCFN.ChangeSetTypeUpdate
+		}
+		changeSet, err := cfnClient.CreateChangeSet(template, stackName, cfnParams, convertToCFNTags(tags), changeSetType)
+		if err != nil {
+			return err
+		}
+		execute, err := cloudformation.ReviewChangeSet(cfnClient, changeSet, previewOptions)
+		if err != nil || !execute {
+			return err
+		}
+
+		if _, err := ecsClient.CreateCluster(commandConfig.Cluster, tags); err != nil {
+			return err
+		}
+		if err := cfnClient.ExecuteChangeSet(changeSet.ID); err != nil {
+			return err
+		}
+		if stackExists {
+			logrus.Info("Waiting for your cluster resources to be updated...")
+			return cfnClient.WaitUntilUpdateComplete(stackName)
+		}
+		logrus.Info("Waiting for your cluster resources to be created...")
+		return cfnClient.WaitUntilCreateComplete(stackName)
+	}
+
 	// Create ECS cluster
 	if _, err := ecsClient.CreateCluster(commandConfig.Cluster, tags); err != nil {
 		return err
@@ -373,11 +578,6 @@ func createCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 		}
 	}
 	// Create cfn stack
-	template, err := cloudformation.GetClusterTemplate(tags, stackName)
-	if err != nil {
-		return errors.Wrapf(err, "Error building cloudformation template")
-	}
-
 	if _, err := cfnClient.CreateStack(template, stackName, true, cfnParams, convertToCFNTags(tags)); err != nil {
 		return err
 	}
@@ -426,6 +626,70 @@ func getInstanceType(cfnParams *cloudformation.CfnStackParams) (string, error) {
 	return aws.StringValue(param.ParameterValue), nil
 }
 
+// getInstanceTypes returns the instance types of the container instances. The
+// first of the types given with --instance-types is also the instance type of
+// the launch template, which the recommended AMI is chosen for.
+func getInstanceTypes(context *cli.Context, cfnParams *cloudformation.CfnStackParams) ([]string, error) {
+	value := context.String(flags.InstanceTypesFlag)
+	if value == "" {
+		for _, flag := range []string{flags.OnDemandBaseFlag, flags.OnDemandPercentageFlag, flags.SpotAllocationStrategyFlag} {
+			if context.String(flag) != "" {
+				return nil, fmt.Errorf("You can only specify '--%s' with '--%s'", flag, flags.InstanceTypesFlag)
+			}
+		}
+		instanceType, err := getInstanceType(cfnParams)
+		if err != nil {
+			return nil, err
+		}
+		return []string{instanceType}, nil
+	}
+	if context.String(flags.InstanceTypeFlag) != "" {
+		return nil, fmt.Errorf("You can only specify '--%s' or '--%s'", flags.InstanceTypeFlag, flags.InstanceTypesFlag)
+	}
+
+	var instanceTypes []string
+	for _, instanceType := range strings.Split(value, ",") {
+		if instanceType = strings.TrimSpace(instanceType); instanceType != "" {
+			instanceTypes = append(instanceTypes, instanceType)
+		}
+	}
+	if len(instanceTypes) == 0 {
+		return nil, fmt.Errorf("You must specify a comma-separated list of instance types with the '--%s' flag", flags.InstanceTypesFlag)
+	}
+
+	// The instances all run the AMI chosen for the first instance type
+	first := instanceTypes[0]
+	for _, instanceType := range instanceTypes[1:] {
+		if amimetadata.IsARM64Instance(instanceType) != amimetadata.IsARM64Instance(first) {
+			return nil, fmt.Errorf("The instance types specified with '--%s' must have the same architecture, but %s and %s do not", flags.InstanceTypesFlag, first, instanceType)
+		}
+		if amimetadata.IsGPUInstance(instanceType) != amimetadata.IsGPUInstance(first) {
+			return nil, fmt.Errorf("The instance types specified with '--%s' must all be GPU instance types or all be other instance types, since GPU instances run a different AMI, but %s and %s are not", flags.InstanceTypesFlag, first, instanceType)
+		}
+		if amimetadata.IsInferentiaInstance(instanceType) != amimetadata.IsInferentiaInstance(first) {
+			return nil, fmt.Errorf("The instance types specified with '--%s' must all be Inferentia instance types or all be other instance types, since Inferentia instances run a different AMI, but %s and %s are not", flags.InstanceTypesFlag, first, instanceType)
+		}
+	}
+
+	cfnParams.Add(ParameterKeyInstanceTypes, strings.Join(instanceTypes, ","))
+	cfnParams.Add(ParameterKeyInstanceType, instanceTypes[0])
+	return instanceTypes, nil
+}
+
+// usesSpotInstances returns whether the cluster may launch Spot instances,
+// either with --spot-price or with --instance-types and less than 100 percent
+// On-Demand instances.
+func usesSpotInstances(context *cli.Context) bool {
+	if price, err := strconv.ParseFloat(context.String(flags.SpotPriceFlag), 64); err == nil && price > 0 {
+		return true
+	}
+	if context.String(flags.InstanceTypesFlag) == "" {
+		return false
+	}
+	percentage, err := strconv.Atoi(context.String(flags.OnDemandPercentageFlag))
+	return err == nil && percentage < 100
+}
+
 func validateInstanceType(instanceType string, supportedInstanceTypes []string) error {
 	found := false
 	for _, it := range supportedInstanceTypes {
@@ -441,22 +705,41 @@ func validateInstanceType(instanceType string, supportedInstanceTypes []string)
 	return nil
 }
 
-func populateAMIID(cfnParams *cloudformation.CfnStackParams, client amimetadata.Client) error {
+func populateAMIID(cfnParams *cloudformation.CfnStackParams, client amimetadata.Client, amiFamily string) error {
 	instanceType, err := getInstanceType(cfnParams)
 	if err != nil {
 		return err
 	}
 
-	amiMetadata, err := client.GetRecommendedECSLinuxAMI(instanceType)
+	amiMetadata, err := client.GetRecommendedECSAMI(amiFamily, instanceType)
 	if err != nil {
 		return err
 	}
-	logrus.Infof("Using recommended %s AMI with ECS Agent %s and %s",
-		amiMetadata.OsName, amiMetadata.AgentVersion, amiMetadata.RuntimeVersion)
+	if amiMetadata.AgentVersion == "" {
+		logrus.Infof("Using recommended %s AMI %s", amiMetadata.OsName, amiMetadata.ImageID)
+	} else {
+		logrus.Infof("Using recommended %s AMI with ECS Agent %s and %s",
+			amiMetadata.OsName, amiMetadata.AgentVersion, amiMetadata.RuntimeVersion)
+	}
 	cfnParams.Add(ParameterKeyAmiId, amiMetadata.ImageID)
 	return nil
 }
 
+// getAMIFamily returns the AMI family specified with the --ami-family flag,
+// which defaults to Amazon Linux 2.
+func getAMIFamily(context *cli.Context) (string, error) {
+	amiFamily := context.String(flags.AMIFamilyFlag)
+	if amiFamily == "" {
+		return amimetadata.AMIFamilyAmazonLinux2, nil
+	}
+	for _, family := range amimetadata.AMIFamilies {
+		if family == amiFamily {
+			return amiFamily, nil
+		}
+	}
+	return "", fmt.Errorf("'--%s' must be one of %s, not '%s'", flags.AMIFamilyFlag, strings.Join(amimetadata.AMIFamilies, ", "), amiFamily)
+}
+
 // unfortunately go SDK lacks a unified Tag type
 func convertToCFNTags(tags []*ecs.Tag) []*sdkCFN.Tag {
 	var cfnTags []*sdkCFN.Tag
@@ -573,6 +856,10 @@ func scaleCluster(context *cli.Context, awsClients *AWSClients, commandConfig *c
 	if size == "" {
 		return fmt.Errorf("Missing required flag '--%s'", flags.AsgMaxSizeFlag)
 	}
+	previewOptions, preview, err := getPreviewOptions(context)
+	if err != nil {
+		return err
+	}
 
 	// Validate that cluster exists in ECS
 	ecsClient := awsClients.ECSClient
@@ -594,9 +881,32 @@ func scaleCluster(context *cli.Context, awsClients *AWSClients, commandConfig *c
 		return err
 	}
 	cfnParams.Add(ParameterKeyAsgMaxSize, size)
+	if isCapacityProviderManaged(existingParameters) {
+		logrus.Infof("The instances of cluster '%s' are scaled by its capacity provider; setting the maximum size of its Auto Scaling group to %s", commandConfig.Cluster, size)
+	}
+
+	if renderPath := context.String(flags.RenderTemplateFlag); renderPath != "" {
+		template, err := cfnClient.GetTemplate(stackName)
+		if err != nil {
+			return err
+		}
+		return cloudformation.RenderStack(renderPath, stackName, template, cfnParams, existingParameters)
+	}
 
 	// Update the stack.
-	if _, err := cfnClient.UpdateStack(stackName, cfnParams); err != nil {
+	if preview {
+		changeSet, err := cfnClient.CreateChangeSet("", stackName, cfnParams, nil, sdkCFN.ChangeSetTypeUpdate)
+		if err != nil {
+			return err
+		}
+		execute, err := cloudformation.ReviewChangeSet(cfnClient, changeSet, previewOptions)
+		if err != nil || !execute {
+			return err
+		}
+		if err := cfnClient.ExecuteChangeSet(changeSet.ID); err != nil {
+			return err
+		}
+	} else if _, err := cfnClient.UpdateStack(stackName, cfnParams); err != nil {
 		return err
 	}
 
@@ -604,6 +914,68 @@ func scaleCluster(context *cli.Context, awsClients *AWSClients, commandConfig *c
 	return cfnClient.WaitUntilUpdateComplete(stackName)
 }
 
+// migrateClusterTemplate executes the 'migrate-template' command. It updates
+// stacks created with a launch configuration to the current template, which
+// launches the container instances with a launch template instead, keeping
+// the values of all the existing stack parameters.
+func migrateClusterTemplate(context *cli.Context, awsClients *AWSClients, commandConfig *config.CommandConfig) error {
+	if !isIAMAcknowledged(context) {
+		return fmt.Errorf("Please acknowledge that this command may create IAM resources with the '--%s' flag", flags.CapabilityIAMFlag)
+	}
+
+	cfnClient := awsClients.CFNClient
+	stackName := commandConfig.CFNStackName
+	output, err := cfnClient.DescribeStacks(stackName)
+	if err != nil || len(output.Stacks) == 0 {
+		return fmt.Errorf("CloudFormation stack not found for cluster '%s'", commandConfig.Cluster)
+	}
+	stack := output.Stacks[0]
+
+	launchConfiguration, err := cfnClient.DescribeStackResource(stackName, cloudformation.LaunchConfigurationLogicalResourceId)
+	if err != nil {
+		return err
+	}
+	if launchConfiguration == nil {
+		return fmt.Errorf("The CloudFormation stack for cluster '%s' does not use a launch configuration. To change the settings of its launch template, re-run 'ecs-cli up' with the '--%s' flag", commandConfig.Cluster, flags.ForceFlag)
+	}
+
+	cfnParams, err := cloudformation.NewCfnStackParamsForUpdate(requiredParameters, stack.Parameters)
+	if err != nil {
+		return err
+	}
+	for _, flag := range launchTemplateFlags {
+		if value := context.String(flag); value != "" {
+			cfnParams.Add(flagNamesToStackParameterKeys[flag], value)
+		}
+	}
+	addLaunchTemplateBoolParams(context, cfnParams)
+
+	// The tags of the stack are the ones given to 'up', which the template
+	// also propagates to the container instances
+	var tags []*ecs.Tag
+	for _, tag := range stack.Tags {
+		tags = append(tags, &ecs.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	template, err := cloudformation.GetClusterTemplate(tags, stackName, nil)
+	if err != nil {
+		return errors.Wrapf(err, "Error building cloudformation template")
+	}
+
+	if _, err := cfnClient.UpdateStackTemplate(template, stackName, cfnParams); err != nil {
+		return err
+	}
+
+	logrus.Info("Waiting for your cluster resources to be updated...")
+	if err := cfnClient.WaitUntilUpdateComplete(stackName); err != nil {
+		return err
+	}
+	logrus.Info("Running container instances are not replaced; instances launched from now on use the launch template.")
+	return nil
+}
+
 // createPS executes the 'ps' command.
 func clusterPS(context *cli.Context, rdwr config.ReadWriter) (project.InfoSet, error) {
 	commandConfig, err := newCommandConfig(context, rdwr)
@@ -664,7 +1036,16 @@ func cliFlagsToCfnStackParams(context *cli.Context, cluster, launchType string,
 	}
 
 	if launchType == config.LaunchTypeEC2 {
+		amiFamily, err := getAMIFamily(context)
+		if err != nil {
+			return nil, err
+		}
+		cfnParams.Add(ParameterKeyAmiFamily, amiFamily)
 		builder := newUserDataBuilder(cluster, tags)
+		if amiFamily == amimetadata.AMIFamilyBottlerocket {
+			builder.UseBottlerocketSettings()
+			cfnParams.Add(ParameterKeyRootDeviceName, bottlerocketDataDeviceName)
+		}
 		// handle extra user data, which is a string slice flag
 		if userDataFiles := context.StringSlice(flags.UserDataFlag); len(userDataFiles) > 0 {
 			for _, file := range userDataFiles {
@@ -674,6 +1055,9 @@ func cliFlagsToCfnStackParams(context *cli.Context, cluster, launchType string,
 				}
 			}
 		}
+		if usesSpotInstances(context) {
+			builder.EnableSpotInstanceDraining()
+		}
 		userData, err := builder.Build()
 		if err != nil {
 			return nil, err
@@ -683,6 +1067,128 @@ func cliFlagsToCfnStackParams(context *cli.Context, cluster, launchType string,
 	return cfnParams, nil
 }
 
+// addLaunchTemplateBoolParams sets the stack parameters of the boolean flags
+// which customize the launch template.
+func addLaunchTemplateBoolParams(context *cli.Context, cfnParams *cloudformation.CfnStackParams) {
+	for flag, key := range launchTemplateBoolFlags {
+		if context.Bool(flag) {
+			cfnParams.Add(key, "true")
+		}
+	}
+}
+
+// addCapacityProviderParams sets the stack parameters which attach capacity
+// providers to the cluster.
+func addCapacityProviderParams(context *cli.Context, launchType string, cfnParams *cloudformation.CfnStackParams) error {
+	if context.Bool(flags.CapacityProviderFlag) {
+		if launchType == config.LaunchTypeFargate {
+			return fmt.Errorf("You can only specify '--%s' with the EC2 launch type", flags.CapacityProviderFlag)
+		}
+		if _, err := cfnParams.GetParameter(ParameterKeyAsgMaxSize); err == cloudformation.ParameterNotFoundError {
+			logrus.Warnf("The capacity provider will not launch more than 1 instance. Specify '--%s' to set the maximum number of instances in your cluster.", flags.AsgMaxSizeFlag)
+		}
+		cfnParams.Add(ParameterKeyIsCapacityProvider, "true")
+	} else {
+		for _, key := range []string{ParameterKeyTargetCapacity, ParameterKeyMinScalingStep, ParameterKeyMaxScalingStep} {
+			if _, err := cfnParams.GetParameter(key); err == nil {
+				return fmt.Errorf("You can only specify '--%s', '--%s' or '--%s' with '--%s'", flags.TargetCapacityFlag, flags.MinScalingStepFlag, flags.MaxScalingStepFlag, flags.CapacityProviderFlag)
+			}
+		}
+	}
+
+	if value := context.String(flags.FargateCapacityProvidersFlag); value != "" {
+		var providers []string
+		for _, provider := range strings.Split(value, ",") {
+			provider = strings.ToUpper(strings.TrimSpace(provider))
+			if provider != fargateCapacityProvider && provider != fargateSpotCapacityProvider {
+				return fmt.Errorf("'--%s' only accepts %s and %s, not '%s'", flags.FargateCapacityProvidersFlag, fargateCapacityProvider, fargateSpotCapacityProvider, provider)
+			}
+			providers = append(providers, provider)
+		}
+		cfnParams.Add(ParameterKeyFargateCapacityProviders, strings.Join(providers, ","))
+	}
+	return nil
+}
+
+// addPrivateSubnetParams sets the stack parameters which create private
+// subnets in the new VPC, and launch the container instances in them.
+func addPrivateSubnetParams(context *cli.Context, cfnParams *cloudformation.CfnStackParams) error {
+	if !context.Bool(flags.PrivateSubnetsFlag) {
+		if context.String(flags.NatGatewayFlag) != "" || context.Bool(flags.VpcEndpointsFlag) {
+			return fmt.Errorf("You can only specify '--%s' or '--%s' with '--%s'", flags.NatGatewayFlag, flags.VpcEndpointsFlag, flags.PrivateSubnetsFlag)
+		}
+		if context.Bool(flags.NoAutoAssignPublicIPAddressFlag) && context.String(flags.VpcIdFlag) == "" {
+			logrus.Warnf("Instances in the public subnets of the new VPC can not reach ECS without public IP addresses. Specify '--%s' to launch them in private subnets instead.", flags.PrivateSubnetsFlag)
+		}
+		return nil
+	}
+
+	if context.String(flags.VpcIdFlag) != "" {
+		return fmt.Errorf("You can only specify '--%s' when a new VPC is created, not with '--%s'", flags.PrivateSubnetsFlag, flags.VpcIdFlag)
+	}
+	switch natGateway := context.String(flags.NatGatewayFlag); natGateway {
+	case "", natGatewaySingle, natGatewayPerAZ:
+	case natGatewayNone:
+		if !context.Bool(flags.VpcEndpointsFlag) {
+			return fmt.Errorf("Private subnets without a NAT gateway can only reach ECS and ECR with '--%s'", flags.VpcEndpointsFlag)
+		}
+	default:
+		return fmt.Errorf("'--%s' must be %s, %s or %s, not '%s'", flags.NatGatewayFlag, natGatewaySingle, natGatewayPerAZ, natGatewayNone, natGateway)
+	}
+
+	cfnParams.Add(ParameterKeyIsPrivateSubnets, "true")
+	if context.Bool(flags.VpcEndpointsFlag) {
+		cfnParams.Add(ParameterKeyIsVpcEndpoints, "true")
+	}
+	return nil
+}
+
+// isCapacityProviderManaged returns whether the instances of a stack created
+// with the given parameters are scaled by its capacity provider.
+func isCapacityProviderManaged(params []*sdkCFN.Parameter) bool {
+	return stackParameterValue(params, ParameterKeyIsCapacityProvider) == "true"
+}
+
+// stackParameterValue returns the value of the stack parameter, or an empty
+// string if the stack does not have it.
+func stackParameterValue(params []*sdkCFN.Parameter, key string) string {
+	for _, param := range params {
+		if aws.StringValue(param.ParameterKey) == key {
+			return aws.StringValue(param.ParameterValue)
+		}
+	}
+	return ""
+}
+
+// addTemplateExtension merges the template extension in the given file into
+// the cluster template. Later updates with 'scale' keep the previous template,
+// and so the extension.
+func addTemplateExtension(template, extensionFile string) (string, error) {
+	extension, err := ioutil.ReadFile(extensionFile)
+	if err != nil {
+		return "", errors.Wrapf(err, "Error reading template extension %s", extensionFile)
+	}
+	merged, err := cloudformation.MergeTemplateExtension(template, extension)
+	if err != nil {
+		return "", errors.Wrapf(err, "Invalid template extension %s", extensionFile)
+	}
+	return merged, nil
+}
+
+// getPreviewOptions returns the options given with the --preview, --dry-run
+// and --yes flags, and whether the changes to the stack are to be previewed.
+func getPreviewOptions(context *cli.Context) (cloudformation.PreviewOptions, bool, error) {
+	options := cloudformation.PreviewOptions{
+		DryRun: context.Bool(flags.DryRunFlag),
+		Yes:    context.Bool(flags.YesFlag),
+	}
+	preview := options.DryRun || context.Bool(flags.PreviewFlag)
+	if options.Yes && !preview {
+		return options, false, fmt.Errorf("You can only specify '--%s' with '--%s'", flags.YesFlag, flags.PreviewFlag)
+	}
+	return options, preview, nil
+}
+
 // isIAMAcknowledged returns true if the 'capability-iam' flag is set from CLI.
 func isIAMAcknowledged(context *cli.Context) bool {
 	return context.Bool(flags.CapabilityIAMFlag)
@@ -19,7 +19,9 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster/userdata"
@@ -87,6 +89,9 @@ type mockUserDataBuilder struct {
 	userdata string
 	files    []string
 	tags     []*ecs.Tag
+	spot     bool
+
+	bottlerocket bool
 }
 
 func (b *mockUserDataBuilder) AddFile(fileName string) error {
@@ -94,6 +99,14 @@ func (b *mockUserDataBuilder) AddFile(fileName string) error {
 	return nil
 }
 
+func (b *mockUserDataBuilder) EnableSpotInstanceDraining() {
+	b.spot = true
+}
+
+func (b *mockUserDataBuilder) UseBottlerocketSettings() {
+	b.bottlerocket = true
+}
+
 func (b *mockUserDataBuilder) Build() (string, error) {
 	return b.userdata, nil
 }
@@ -147,7 +160,7 @@ func TestClusterUpWithForce(t *testing.T) {
 	)
 
 	gomock.InOrder(
-		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "t2.micro").Return(amiMetadata(amiID), nil),
 	)
 
 	gomock.InOrder(
@@ -176,6 +189,247 @@ func TestClusterUpWithForce(t *testing.T) {
 	assert.NoError(t, err, "Unexpected error bringing up cluster")
 }
 
+func TestClusterUpWithPreview(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	changeSet := &cloudformation.ChangeSet{
+		ID:        "changeSetID",
+		StackName: stackName,
+		Type:      sdkCFN.ChangeSetTypeCreate,
+		Changes: []*sdkCFN.ResourceChange{
+			{Action: aws.String(sdkCFN.ChangeActionAdd), LogicalResourceId: aws.String("Vpc"), ResourceType: aws.String("AWS::EC2::VPC")},
+		},
+	}
+
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "t2.micro").Return(amiMetadata(amiID), nil),
+	)
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+	)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().CreateChangeSet(gomock.Any(), stackName, gomock.Any(), gomock.Any(), sdkCFN.ChangeSetTypeCreate).Return(changeSet, nil),
+		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+		mockCloudformation.EXPECT().ExecuteChangeSet("changeSetID").Return(nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.Bool(flags.PreviewFlag, true, "")
+	flagSet.Bool(flags.YesFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
+}
+
+func TestClusterUpWithDryRunAndExistingStack(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	changeSet := &cloudformation.ChangeSet{
+		ID:        "changeSetID",
+		StackName: stackName,
+		Type:      sdkCFN.ChangeSetTypeUpdate,
+		Changes: []*sdkCFN.ResourceChange{
+			{Action: aws.String(sdkCFN.ChangeActionModify), LogicalResourceId: aws.String("EcsInstanceAsg"),
+				ResourceType: aws.String("AWS::AutoScaling::AutoScalingGroup"), Replacement: aws.String(sdkCFN.ReplacementTrue)},
+		},
+	}
+
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "t2.micro").Return(amiMetadata(amiID), nil),
+	)
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+	)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(nil),
+		mockCloudformation.EXPECT().CreateChangeSet(gomock.Any(), stackName, gomock.Any(), gomock.Any(), sdkCFN.ChangeSetTypeUpdate).Return(changeSet, nil),
+		mockCloudformation.EXPECT().DeleteChangeSet("changeSetID").Return(nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.Bool(flags.DryRunFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error previewing cluster changes")
+}
+
+func TestClusterUpWithRenderTemplate(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	tempDir, err := ioutil.TempDir("", "render")
+	assert.NoError(t, err, "Unexpected error creating temp directory")
+	defer os.RemoveAll(tempDir)
+	renderPath := filepath.Join(tempDir, "cluster.json")
+
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "t2.micro").Return(amiMetadata(amiID), nil),
+	)
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+	)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.String(flags.RenderTemplateFlag, renderPath, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error rendering cluster template")
+
+	data, err := ioutil.ReadFile(renderPath)
+	assert.NoError(t, err, "Expected the template to be rendered")
+	assert.Contains(t, string(data), `"StackName": "defaultCluster"`)
+	assert.Contains(t, string(data), `"KeyName": "default"`)
+}
+
+func TestClusterUpWithYesAndWithoutPreview(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	mockCloudformation.EXPECT().ValidateStackExists(gomock.Any()).Return(errors.New("error")).AnyTimes()
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.Bool(flags.YesFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error when --yes is specified without --preview")
+}
+
+func TestClusterUpWithTemplateExtension(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	tempDir, err := ioutil.TempDir("", "extension")
+	assert.NoError(t, err, "Unexpected error creating temp directory")
+	defer os.RemoveAll(tempDir)
+	extensionFile := filepath.Join(tempDir, "ext.yml")
+	extension := "Resources:\n  AlarmTopic:\n    Type: AWS::SNS::Topic\nOutputs:\n  AlarmTopicArn:\n    Value:\n      Ref: AlarmTopic\n"
+	err = ioutil.WriteFile(extensionFile, []byte(extension), 0644)
+	assert.NoError(t, err, "Unexpected error writing template extension")
+
+	gomock.InOrder(
+		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+	)
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "t2.micro").Return(amiMetadata(amiID), nil),
+	)
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+	)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			template := v.(string)
+			assert.Contains(t, template, `"AlarmTopic":{"Type":"AWS::SNS::Topic"}`, "Expected the template to include the extension resources")
+			assert.Contains(t, template, `"AlarmTopicArn":{"Value":{"Ref":"AlarmTopic"}}`, "Expected the template to include the extension outputs")
+			assert.Contains(t, template, `"EcsInstanceAsg":`, "Expected the template to include the cluster resources")
+		}).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.String(flags.TemplateExtensionFlag, extensionFile, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
+}
+
+func TestClusterUpWithInvalidTemplateExtension(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "extension")
+	assert.NoError(t, err, "Unexpected error creating temp directory")
+	defer os.RemoveAll(tempDir)
+	clashingExtensionFile := filepath.Join(tempDir, "clash.yml")
+	err = ioutil.WriteFile(clashingExtensionFile, []byte("Resources:\n  Vpc:\n    Type: AWS::EC2::VPC\n"), 0644)
+	assert.NoError(t, err, "Unexpected error writing template extension")
+
+	testCases := map[string]struct {
+		extensionFile string
+		expectedError string
+	}{
+		"clashing logical ID": {
+			extensionFile: clashingExtensionFile,
+			expectedError: "Logical ID 'Vpc'",
+		},
+		"missing file": {
+			extensionFile: filepath.Join(tempDir, "missing.yml"),
+			expectedError: "Error reading template extension",
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer os.Clearenv()
+			mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+			awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+			mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "t2.micro").Return(amiMetadata(amiID), nil)
+			mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil)
+			mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error"))
+
+			flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+			flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+			flagSet.String(flags.KeypairNameFlag, "default", "")
+			flagSet.String(flags.TemplateExtensionFlag, test.extensionFile, "")
+
+			context := cli.NewContext(nil, flagSet, nil)
+			rdwr := newMockReadWriter()
+			commandConfig, err := newCommandConfig(context, rdwr)
+			assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+			err = createCluster(context, awsClients, commandConfig)
+			if assert.Error(t, err, "Expected error bringing up cluster with an invalid template extension") {
+				assert.Contains(t, err.Error(), test.expectedError)
+			}
+		})
+	}
+}
+
 func TestClusterUpWithoutPublicIP(t *testing.T) {
 	defer os.Clearenv()
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
@@ -186,7 +440,7 @@ func TestClusterUpWithoutPublicIP(t *testing.T) {
 	)
 
 	gomock.InOrder(
-		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "t2.micro").Return(amiMetadata(amiID), nil),
 	)
 
 	gomock.InOrder(
@@ -243,7 +497,7 @@ func TestClusterUpWithUserData(t *testing.T) {
 	)
 
 	gomock.InOrder(
-		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "t2.micro").Return(amiMetadata(amiID), nil),
 	)
 
 	gomock.InOrder(
@@ -296,7 +550,7 @@ func TestClusterUpWithSpotPrice(t *testing.T) {
 	)
 
 	gomock.InOrder(
-		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "t2.micro").Return(amiMetadata(amiID), nil),
 	)
 
 	gomock.InOrder(
@@ -663,6 +917,349 @@ func TestCliFlagsToCfnStackParams(t *testing.T) {
 	assert.NoError(t, err, "Unexpected error getting parameter ParameterKeyAsgMaxSize")
 }
 
+func TestCliFlagsToCfnStackParamsWithLaunchTemplateFlags(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.RootVolumeSizeFlag, "50", "")
+	flagSet.String(flags.RootVolumeTypeFlag, "gp3", "")
+	flagSet.String(flags.MetadataHopLimitFlag, "2", "")
+	flagSet.Bool(flags.EncryptRootVolumeFlag, true, "")
+	flagSet.Bool(flags.DetailedMonitoringFlag, true, "")
+	flagSet.Bool(flags.IMDSv2Flag, false, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	params, err := cliFlagsToCfnStackParams(context, clusterName, config.LaunchTypeEC2, nil)
+	assert.NoError(t, err, "Unexpected error from call to cliFlagsToCfnStackParams")
+	addLaunchTemplateBoolParams(context, params)
+
+	for key, expected := range map[string]string{
+		ParameterKeyRootVolumeSize:       "50",
+		ParameterKeyRootVolumeType:       "gp3",
+		ParameterKeyMetadataHopLimit:     "2",
+		ParameterKeyIsEncryptedVolume:    "true",
+		ParameterKeyIsDetailedMonitoring: "true",
+	} {
+		param, err := params.GetParameter(key)
+		assert.NoError(t, err, "Expected %s parameter to be present", key)
+		assert.Equal(t, expected, aws.StringValue(param.ParameterValue), "Expected %s to match", key)
+	}
+	_, err = params.GetParameter(ParameterKeyIsIMDSv2)
+	assert.Equal(t, cloudformation.ParameterNotFoundError, err, "Expected IsIMDSv2 parameter to be absent")
+}
+
+func capacityProviderContext(capacityProvider bool, args ...string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapacityProviderFlag, capacityProvider, "")
+	flagSet.String(flags.TargetCapacityFlag, "", "")
+	flagSet.String(flags.MinScalingStepFlag, "", "")
+	flagSet.String(flags.MaxScalingStepFlag, "", "")
+	flagSet.String(flags.FargateCapacityProvidersFlag, "", "")
+	flagSet.String(flags.AsgMaxSizeFlag, "", "")
+	flagSet.Parse(args)
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func TestAddCapacityProviderParams(t *testing.T) {
+	context := capacityProviderContext(true, "--"+flags.TargetCapacityFlag, "80", "--"+flags.FargateCapacityProvidersFlag, "fargate_spot, FARGATE", "--"+flags.AsgMaxSizeFlag, "5")
+	params, err := cliFlagsToCfnStackParams(context, clusterName, config.LaunchTypeEC2, nil)
+	assert.NoError(t, err, "Unexpected error from call to cliFlagsToCfnStackParams")
+
+	err = addCapacityProviderParams(context, config.LaunchTypeEC2, params)
+	assert.NoError(t, err, "Unexpected error adding capacity provider params")
+	for key, expected := range map[string]string{
+		ParameterKeyIsCapacityProvider:       "true",
+		ParameterKeyTargetCapacity:           "80",
+		ParameterKeyFargateCapacityProviders: "FARGATE_SPOT,FARGATE",
+		ParameterKeyAsgMaxSize:               "5",
+	} {
+		param, err := params.GetParameter(key)
+		assert.NoError(t, err, "Expected %s parameter to be present", key)
+		assert.Equal(t, expected, aws.StringValue(param.ParameterValue), "Expected %s to match", key)
+	}
+}
+
+func TestAddCapacityProviderParamsFargateOnly(t *testing.T) {
+	context := capacityProviderContext(false, "--"+flags.FargateCapacityProvidersFlag, "FARGATE")
+	params, err := cliFlagsToCfnStackParams(context, clusterName, config.LaunchTypeFargate, nil)
+	assert.NoError(t, err, "Unexpected error from call to cliFlagsToCfnStackParams")
+
+	err = addCapacityProviderParams(context, config.LaunchTypeFargate, params)
+	assert.NoError(t, err, "Unexpected error adding capacity provider params")
+	_, err = params.GetParameter(ParameterKeyIsCapacityProvider)
+	assert.Equal(t, cloudformation.ParameterNotFoundError, err, "Expected IsCapacityProvider parameter to be absent")
+	param, err := params.GetParameter(ParameterKeyFargateCapacityProviders)
+	assert.NoError(t, err, "Expected FargateCapacityProviders parameter to be present")
+	assert.Equal(t, "FARGATE", aws.StringValue(param.ParameterValue))
+}
+
+func TestAddCapacityProviderParamsErrorCases(t *testing.T) {
+	for name, test := range map[string]struct {
+		context    *cli.Context
+		launchType string
+	}{
+		"Fargate launch type":      {capacityProviderContext(true), config.LaunchTypeFargate},
+		"scaling without provider": {capacityProviderContext(false, "--"+flags.MaxScalingStepFlag, "2"), config.LaunchTypeEC2},
+		"unknown Fargate provider": {capacityProviderContext(true, "--"+flags.FargateCapacityProvidersFlag, "FARGATE,EC2"), config.LaunchTypeEC2},
+		"target without provider":  {capacityProviderContext(false, "--"+flags.TargetCapacityFlag, "90"), config.LaunchTypeEC2},
+	} {
+		params, err := cliFlagsToCfnStackParams(test.context, clusterName, test.launchType, nil)
+		assert.NoError(t, err, "Unexpected error from call to cliFlagsToCfnStackParams")
+		err = addCapacityProviderParams(test.context, test.launchType, params)
+		assert.Error(t, err, "Expected error for %s", name)
+	}
+}
+
+func instanceTypesContext(args ...string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.InstanceTypeFlag, "", "")
+	flagSet.String(flags.InstanceTypesFlag, "", "")
+	flagSet.String(flags.OnDemandBaseFlag, "", "")
+	flagSet.String(flags.OnDemandPercentageFlag, "", "")
+	flagSet.String(flags.SpotAllocationStrategyFlag, "", "")
+	flagSet.String(flags.SpotPriceFlag, "", "")
+	flagSet.Parse(args)
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func TestGetInstanceTypes(t *testing.T) {
+	params := cloudformation.NewCfnStackParams(requiredParameters)
+	instanceTypes, err := getInstanceTypes(instanceTypesContext("--"+flags.InstanceTypesFlag, "m5.large, m5a.large,m6i.large"), params)
+	assert.NoError(t, err, "Unexpected error getting instance types")
+	assert.Equal(t, []string{"m5.large", "m5a.large", "m6i.large"}, instanceTypes)
+
+	param, err := params.GetParameter(ParameterKeyInstanceTypes)
+	assert.NoError(t, err, "Expected InstanceTypes parameter to be present")
+	assert.Equal(t, "m5.large,m5a.large,m6i.large", aws.StringValue(param.ParameterValue))
+	param, err = params.GetParameter(ParameterKeyInstanceType)
+	assert.NoError(t, err, "Expected EcsInstanceType parameter to be present")
+	assert.Equal(t, "m5.large", aws.StringValue(param.ParameterValue), "Expected the first instance type to be used for the AMI")
+}
+
+func TestGetInstanceTypesGPU(t *testing.T) {
+	params := cloudformation.NewCfnStackParams(requiredParameters)
+	instanceTypes, err := getInstanceTypes(instanceTypesContext("--"+flags.InstanceTypesFlag, "g4dn.xlarge,p3.2xlarge"), params)
+	assert.NoError(t, err, "Unexpected error getting GPU instance types")
+	assert.Equal(t, []string{"g4dn.xlarge", "p3.2xlarge"}, instanceTypes)
+}
+
+func TestGetInstanceTypesDefault(t *testing.T) {
+	params := cloudformation.NewCfnStackParams(requiredParameters)
+	instanceTypes, err := getInstanceTypes(instanceTypesContext(), params)
+	assert.NoError(t, err, "Unexpected error getting instance types")
+	assert.Equal(t, []string{cloudformation.DefaultECSInstanceType}, instanceTypes)
+	_, err = params.GetParameter(ParameterKeyInstanceTypes)
+	assert.Equal(t, cloudformation.ParameterNotFoundError, err, "Expected InstanceTypes parameter to be absent")
+}
+
+func TestGetInstanceTypesErrorCases(t *testing.T) {
+	for name, args := range map[string][]string{
+		"mixed architectures":         {"--" + flags.InstanceTypesFlag, "m6g.large,m5.large"},
+		"mixed GPU instances":         {"--" + flags.InstanceTypesFlag, "m5.large,g4dn.xlarge"},
+		"mixed Inferentia instances":  {"--" + flags.InstanceTypesFlag, "inf1.xlarge,m5.large"},
+		"both instance type flags":    {"--" + flags.InstanceTypesFlag, "m5.large", "--" + flags.InstanceTypeFlag, "m5.large"},
+		"on-demand base without list": {"--" + flags.OnDemandBaseFlag, "1"},
+		"strategy without list":       {"--" + flags.SpotAllocationStrategyFlag, "capacity-optimized"},
+		"empty list":                  {"--" + flags.InstanceTypesFlag, " , "},
+	} {
+		params := cloudformation.NewCfnStackParams(requiredParameters)
+		context := instanceTypesContext(args...)
+		if context.String(flags.InstanceTypeFlag) != "" {
+			params.Add(ParameterKeyInstanceType, context.String(flags.InstanceTypeFlag))
+		}
+		_, err := getInstanceTypes(context, params)
+		assert.Error(t, err, "Expected error for %s", name)
+	}
+}
+
+func TestUsesSpotInstances(t *testing.T) {
+	assert.False(t, usesSpotInstances(instanceTypesContext()))
+	assert.False(t, usesSpotInstances(instanceTypesContext("--"+flags.SpotPriceFlag, "0")))
+	assert.True(t, usesSpotInstances(instanceTypesContext("--"+flags.SpotPriceFlag, "0.05")))
+	assert.False(t, usesSpotInstances(instanceTypesContext("--"+flags.InstanceTypesFlag, "m5.large,m5a.large")))
+	assert.False(t, usesSpotInstances(instanceTypesContext("--"+flags.InstanceTypesFlag, "m5.large,m5a.large", "--"+flags.OnDemandPercentageFlag, "100")))
+	assert.True(t, usesSpotInstances(instanceTypesContext("--"+flags.InstanceTypesFlag, "m5.large,m5a.large", "--"+flags.OnDemandPercentageFlag, "20")))
+}
+
+func TestClusterUpWithInstanceTypes(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	oldNewUserDataBuilder := newUserDataBuilder
+	defer func() { newUserDataBuilder = oldNewUserDataBuilder }()
+	userdataMock := &mockUserDataBuilder{
+		userdata: mockedUserData,
+	}
+	newUserDataBuilder = func(clusterName string, tags []*ecs.Tag) userdata.UserDataBuilder {
+		return userdataMock
+	}
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"m6g.large", "m6gd.large"}, nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "m6g.large").Return(amiMetadata(armAMIID), nil),
+	)
+	gomock.InOrder(
+		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+	)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			template := v.(string)
+			cfnParams := y.(*cloudformation.CfnStackParams)
+			assert.Contains(t, template, `"Overrides": [{"InstanceType":"m6g.large"},{"InstanceType":"m6gd.large"}]`)
+			percentage, err := cfnParams.GetParameter(ParameterKeyOnDemandPercentage)
+			assert.NoError(t, err, "Expected OnDemandPercentage parameter to be present")
+			assert.Equal(t, "25", aws.StringValue(percentage.ParameterValue))
+			ami, err := cfnParams.GetParameter(ParameterKeyAmiId)
+			assert.NoError(t, err, "Expected image id parameter to be present")
+			assert.Equal(t, armAMIID, aws.StringValue(ami.ParameterValue), "Expected the arm64 AMI")
+		}).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.InstanceTypeFlag, "", "")
+	flagSet.String(flags.InstanceTypesFlag, "m6g.large,m6gd.large", "")
+	flagSet.String(flags.OnDemandPercentageFlag, "25", "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
+	assert.True(t, userdataMock.spot, "Expected Spot instance draining to be enabled")
+}
+
+func TestClusterUpWithBottlerocket(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	oldNewUserDataBuilder := newUserDataBuilder
+	defer func() { newUserDataBuilder = oldNewUserDataBuilder }()
+	userdataMock := &mockUserDataBuilder{
+		userdata: mockedUserData,
+	}
+	newUserDataBuilder = func(clusterName string, tags []*ecs.Tag) userdata.UserDataBuilder {
+		return userdataMock
+	}
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyBottlerocket, "t2.micro").Return(&amimetadata.AMIMetadata{ImageID: amiID, OsName: "Bottlerocket"}, nil),
+	)
+	gomock.InOrder(
+		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+	)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			cfnParams := y.(*cloudformation.CfnStackParams)
+			ami, err := cfnParams.GetParameter(ParameterKeyAmiId)
+			assert.NoError(t, err, "Expected image id parameter to be present")
+			assert.Equal(t, amiID, aws.StringValue(ami.ParameterValue), "Expected the Bottlerocket AMI")
+			device, err := cfnParams.GetParameter(ParameterKeyRootDeviceName)
+			assert.NoError(t, err, "Expected RootDeviceName parameter to be present")
+			assert.Equal(t, bottlerocketDataDeviceName, aws.StringValue(device.ParameterValue))
+			family, err := cfnParams.GetParameter(ParameterKeyAmiFamily)
+			assert.NoError(t, err, "Expected AmiFamily parameter to be present")
+			assert.Equal(t, amimetadata.AMIFamilyBottlerocket, aws.StringValue(family.ParameterValue))
+		}).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.AMIFamilyFlag, amimetadata.AMIFamilyBottlerocket, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
+	assert.True(t, userdataMock.bottlerocket, "Expected Bottlerocket settings user data")
+}
+
+func TestGetAMIFamily(t *testing.T) {
+	testCases := map[string]string{
+		"":                amimetadata.AMIFamilyAmazonLinux2,
+		"al2023":          amimetadata.AMIFamilyAmazonLinux2023,
+		"al2-kernel-5.10": amimetadata.AMIFamilyAmazonLinux2Kernel510,
+		"inferentia":      amimetadata.AMIFamilyInferentia,
+		"bottlerocket":    amimetadata.AMIFamilyBottlerocket,
+	}
+	for value, expected := range testCases {
+		flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+		flagSet.String(flags.AMIFamilyFlag, value, "")
+		amiFamily, err := getAMIFamily(cli.NewContext(nil, flagSet, nil))
+		assert.NoError(t, err, "Unexpected error for AMI family '%s'", value)
+		assert.Equal(t, expected, amiFamily)
+	}
+}
+
+func TestCliFlagsToCfnStackParamsWithInvalidAMIFamily(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.AMIFamilyFlag, "ubuntu", "")
+	context := cli.NewContext(nil, flagSet, nil)
+
+	_, err := cliFlagsToCfnStackParams(context, clusterName, config.LaunchTypeEC2, nil)
+	assert.Error(t, err, "Expected error for unsupported AMI family")
+}
+
+func privateSubnetsContext(args ...string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.PrivateSubnetsFlag, false, "")
+	flagSet.String(flags.NatGatewayFlag, "", "")
+	flagSet.Bool(flags.VpcEndpointsFlag, false, "")
+	flagSet.Bool(flags.NoAutoAssignPublicIPAddressFlag, false, "")
+	flagSet.String(flags.VpcIdFlag, "", "")
+	flagSet.Parse(args)
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func TestAddPrivateSubnetParams(t *testing.T) {
+	context := privateSubnetsContext("--"+flags.PrivateSubnetsFlag, "--"+flags.NatGatewayFlag, "per-az", "--"+flags.VpcEndpointsFlag)
+	params, err := cliFlagsToCfnStackParams(context, clusterName, config.LaunchTypeEC2, nil)
+	assert.NoError(t, err, "Unexpected error from call to cliFlagsToCfnStackParams")
+
+	err = addPrivateSubnetParams(context, params)
+	assert.NoError(t, err, "Unexpected error adding private subnet params")
+	for key, expected := range map[string]string{
+		ParameterKeyIsPrivateSubnets: "true",
+		ParameterKeyNatGateway:       "per-az",
+		ParameterKeyIsVpcEndpoints:   "true",
+	} {
+		param, err := params.GetParameter(key)
+		assert.NoError(t, err, "Expected %s parameter to be present", key)
+		assert.Equal(t, expected, aws.StringValue(param.ParameterValue), "Expected %s to match", key)
+	}
+}
+
+func TestAddPrivateSubnetParamsWithoutPrivateSubnets(t *testing.T) {
+	context := privateSubnetsContext("--" + flags.NoAutoAssignPublicIPAddressFlag)
+	params := cloudformation.NewCfnStackParams(requiredParameters)
+
+	err := addPrivateSubnetParams(context, params)
+	assert.NoError(t, err, "Unexpected error adding private subnet params")
+	_, err = params.GetParameter(ParameterKeyIsPrivateSubnets)
+	assert.Equal(t, cloudformation.ParameterNotFoundError, err, "Expected IsPrivateSubnets parameter to be absent")
+}
+
+func TestAddPrivateSubnetParamsErrorCases(t *testing.T) {
+	for name, args := range map[string][]string{
+		"existing VPC":                 {"--" + flags.PrivateSubnetsFlag, "--" + flags.VpcIdFlag, "vpc-1234abcd"},
+		"no NAT gateway nor endpoints": {"--" + flags.PrivateSubnetsFlag, "--" + flags.NatGatewayFlag, "none"},
+		"unknown NAT gateway":          {"--" + flags.PrivateSubnetsFlag, "--" + flags.NatGatewayFlag, "two"},
+		"NAT gateway without subnets":  {"--" + flags.NatGatewayFlag, "single"},
+		"endpoints without subnets":    {"--" + flags.VpcEndpointsFlag},
+	} {
+		err := addPrivateSubnetParams(privateSubnetsContext(args...), cloudformation.NewCfnStackParams(requiredParameters))
+		assert.Error(t, err, "Expected error for %s", name)
+	}
+}
+
 func TestClusterUpForImageIdInput_And_IMDSv2(t *testing.T) {
 	defer os.Clearenv()
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
@@ -675,7 +1272,7 @@ func TestClusterUpForImageIdInput_And_IMDSv2(t *testing.T) {
 	)
 
 	gomock.InOrder(
-		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("x86").Return(amiMetadata(imageID), nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "x86").Return(amiMetadata(imageID), nil),
 	)
 
 	gomock.InOrder(
@@ -756,7 +1353,7 @@ func TestClusterUpWithFargateLaunchTypeFlag(t *testing.T) {
 		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
 	)
 	gomock.InOrder(
-		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("x86").Return(amiMetadata(amiID), nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "x86").Return(amiMetadata(amiID), nil),
 	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
@@ -848,7 +1445,7 @@ func TestClusterUpWithFargateLaunchTypeFlagOverride(t *testing.T) {
 		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
 	)
 	gomock.InOrder(
-		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("x86").Return(amiMetadata(amiID), nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "x86").Return(amiMetadata(amiID), nil),
 	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
@@ -896,7 +1493,7 @@ func TestClusterUpWithEC2LaunchTypeFlagOverride(t *testing.T) {
 		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
 	)
 	gomock.InOrder(
-		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("x86").Return(amiMetadata(amiID), nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "x86").Return(amiMetadata(amiID), nil),
 	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
@@ -961,7 +1558,7 @@ func TestClusterUpWithEmptyCluster(t *testing.T) {
 		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
 	)
 	gomock.InOrder(
-		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("x86").Return(amiMetadata(amiID), nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "x86").Return(amiMetadata(amiID), nil),
 	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
@@ -986,7 +1583,7 @@ func TestClusterUpWithEmptyClusterWithExistingStack(t *testing.T) {
 		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
 	)
 	gomock.InOrder(
-		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("x86").Return(amiMetadata(amiID), nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "x86").Return(amiMetadata(amiID), nil),
 	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(nil),
@@ -1013,7 +1610,7 @@ func TestClusterUpARM64(t *testing.T) {
 	)
 
 	gomock.InOrder(
-		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("a1.medium").Return(amiMetadata(armAMIID), nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "a1.medium").Return(amiMetadata(armAMIID), nil),
 	)
 
 	gomock.InOrder(
@@ -1065,7 +1662,7 @@ func TestClusterUpWithUnsupportedInstanceType(t *testing.T) {
 	)
 
 	gomock.InOrder(
-		mockSSM.EXPECT().GetRecommendedECSLinuxAMI(instanceType).Return(amiMetadata(armAMIID), nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, instanceType).Return(amiMetadata(armAMIID), nil),
 	)
 
 	gomock.InOrder(
@@ -1143,7 +1740,7 @@ func TestClusterUpWithTags(t *testing.T) {
 		}),
 	)
 	gomock.InOrder(
-		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "t2.micro").Return(amiMetadata(amiID), nil),
 	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
@@ -1227,7 +1824,7 @@ func TestClusterUpWithTagsContainerInstanceTaggingEnabled(t *testing.T) {
 		}),
 	)
 	gomock.InOrder(
-		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "t2.micro").Return(amiMetadata(amiID), nil),
 	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
@@ -1389,6 +1986,50 @@ func TestClusterScale(t *testing.T) {
 	assert.NoError(t, err, "Unexpected error scaling cluster")
 }
 
+func TestClusterScaleWithCapacityProvider(t *testing.T) {
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+	defer os.Clearenv()
+
+	mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil)
+
+	existingParameters := []*sdkCFN.Parameter{
+		{
+			ParameterKey:   aws.String(ParameterKeyIsCapacityProvider),
+			ParameterValue: aws.String("true"),
+		},
+		{
+			ParameterKey:   aws.String(ParameterKeyAsgMaxSize),
+			ParameterValue: aws.String("2"),
+		},
+	}
+	assert.True(t, isCapacityProviderManaged(existingParameters))
+	assert.False(t, isCapacityProviderManaged(existingParameters[1:]))
+
+	mockCloudformation.EXPECT().GetStackParameters(stackName).Return(existingParameters, nil)
+	mockCloudformation.EXPECT().UpdateStack(stackName, gomock.Any()).Do(func(x, y interface{}) {
+		cfnParams := y.(*cloudformation.CfnStackParams)
+		param, err := cfnParams.GetParameter(ParameterKeyIsCapacityProvider)
+		assert.NoError(t, err, "Unexpected error on scale.")
+		assert.True(t, aws.BoolValue(param.UsePreviousValue), "Expected the capacity provider to be kept")
+		param, err = cfnParams.GetParameter(ParameterKeyAsgMaxSize)
+		assert.NoError(t, err, "Unexpected error on scale.")
+		assert.Equal(t, "10", aws.StringValue(param.ParameterValue))
+	}).Return("", nil)
+	mockCloudformation.EXPECT().WaitUntilUpdateComplete(stackName).Return(nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-scale", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.AsgMaxSizeFlag, "10", "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = scaleCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error scaling cluster")
+}
+
 func TestClusterScaleWithoutIamCapability(t *testing.T) {
 	defer os.Clearenv()
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
@@ -1423,6 +2064,226 @@ func TestClusterScaleWithoutSize(t *testing.T) {
 	assert.Error(t, err, "Expected error scaling cluster when size is not specified")
 }
 
+func TestClusterScaleWithPreview(t *testing.T) {
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+	defer os.Clearenv()
+
+	changeSet := &cloudformation.ChangeSet{
+		ID:        "changeSetID",
+		StackName: stackName,
+		Type:      sdkCFN.ChangeSetTypeUpdate,
+		Changes: []*sdkCFN.ResourceChange{
+			{Action: aws.String(sdkCFN.ChangeActionModify), LogicalResourceId: aws.String("EcsInstanceAsg"),
+				ResourceType: aws.String("AWS::AutoScaling::AutoScalingGroup"), Replacement: aws.String(sdkCFN.ReplacementFalse)},
+		},
+	}
+
+	mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().GetStackParameters(stackName).Return([]*sdkCFN.Parameter{}, nil),
+		mockCloudformation.EXPECT().CreateChangeSet("", stackName, gomock.Any(), gomock.Any(), sdkCFN.ChangeSetTypeUpdate).Do(func(v, w, x, y, z interface{}) {
+			cfnParams := x.(*cloudformation.CfnStackParams)
+			param, err := cfnParams.GetParameter(ParameterKeyAsgMaxSize)
+			assert.NoError(t, err, "Unexpected error on scale.")
+			assert.Equal(t, "3", aws.StringValue(param.ParameterValue))
+		}).Return(changeSet, nil),
+		mockCloudformation.EXPECT().ExecuteChangeSet("changeSetID").Return(nil),
+		mockCloudformation.EXPECT().WaitUntilUpdateComplete(stackName).Return(nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-scale", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.AsgMaxSizeFlag, "3", "")
+	flagSet.Bool(flags.PreviewFlag, true, "")
+	flagSet.Bool(flags.YesFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = scaleCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error scaling cluster")
+}
+
+func TestClusterScaleWithDryRunWithoutChanges(t *testing.T) {
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+	defer os.Clearenv()
+
+	changeSet := &cloudformation.ChangeSet{
+		ID:        "changeSetID",
+		StackName: stackName,
+		Type:      sdkCFN.ChangeSetTypeUpdate,
+	}
+
+	mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().GetStackParameters(stackName).Return([]*sdkCFN.Parameter{}, nil),
+		mockCloudformation.EXPECT().CreateChangeSet("", stackName, gomock.Any(), gomock.Any(), sdkCFN.ChangeSetTypeUpdate).Return(changeSet, nil),
+		mockCloudformation.EXPECT().DeleteChangeSet("changeSetID").Return(nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-scale", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.AsgMaxSizeFlag, "3", "")
+	flagSet.Bool(flags.DryRunFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = scaleCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error previewing cluster scale")
+}
+
+func TestClusterScaleWithRenderTemplate(t *testing.T) {
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+	defer os.Clearenv()
+
+	tempDir, err := ioutil.TempDir("", "render")
+	assert.NoError(t, err, "Unexpected error creating temp directory")
+	defer os.RemoveAll(tempDir)
+	renderPath := filepath.Join(tempDir, "cluster.json")
+
+	existingParameters := []*sdkCFN.Parameter{
+		{
+			ParameterKey:   aws.String(ParameterKeyInstanceType),
+			ParameterValue: aws.String("t3.large"),
+		},
+	}
+
+	mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().GetStackParameters(stackName).Return(existingParameters, nil),
+		mockCloudformation.EXPECT().GetTemplate(stackName).Return(`{"Resources": {}}`, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-scale", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.AsgMaxSizeFlag, "3", "")
+	flagSet.String(flags.RenderTemplateFlag, renderPath, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = scaleCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error rendering cluster template")
+
+	data, err := ioutil.ReadFile(renderPath)
+	assert.NoError(t, err, "Expected the template to be rendered")
+	assert.Contains(t, string(data), `"AsgMaxSize": "3"`)
+	assert.Contains(t, string(data), `"EcsInstanceType": "t3.large"`)
+}
+
+//////////////////////////////
+// Cluster Migrate Template //
+//////////////////////////////
+
+func migrateTemplateContext(capabilityIAM bool) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-migrate-template", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, capabilityIAM, "")
+	flagSet.Bool(flags.IMDSv2Flag, true, "")
+	flagSet.String(flags.MetadataHopLimitFlag, "2", "")
+	flagSet.String(flags.RootVolumeSizeFlag, "", "")
+	flagSet.String(flags.RootVolumeTypeFlag, "", "")
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func TestClusterMigrateTemplate(t *testing.T) {
+	defer os.Clearenv()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	awsClients := &AWSClients{CFNClient: mockCloudformation}
+	os.Setenv("AWS_REGION", "us-west-1")
+
+	existingStack := &sdkCFN.DescribeStacksOutput{
+		Stacks: []*sdkCFN.Stack{
+			{
+				Parameters: []*sdkCFN.Parameter{
+					{ParameterKey: aws.String(ParameterKeyCluster)},
+					{ParameterKey: aws.String(ParameterKeyAsgMaxSize)},
+				},
+				Tags: []*sdkCFN.Tag{
+					{Key: aws.String("team"), Value: aws.String("green")},
+				},
+			},
+		},
+	}
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().DescribeStacks(stackName).Return(existingStack, nil),
+		mockCloudformation.EXPECT().DescribeStackResource(stackName, cloudformation.LaunchConfigurationLogicalResourceId).Return(&sdkCFN.StackResource{}, nil),
+		mockCloudformation.EXPECT().UpdateStackTemplate(gomock.Any(), stackName, gomock.Any()).Do(func(x, y, z interface{}) {
+			template := x.(string)
+			cfnParams := z.(*cloudformation.CfnStackParams)
+			assert.Contains(t, template, "AWS::EC2::LaunchTemplate", "Expected template to use a launch template")
+			assert.Contains(t, template, `"Key":"team","Value":"green","PropagateAtLaunch":true`, "Expected stack tags to be propagated")
+
+			size, err := cfnParams.GetParameter(ParameterKeyAsgMaxSize)
+			assert.NoError(t, err, "Expected existing parameter to be kept")
+			assert.True(t, aws.BoolValue(size.UsePreviousValue), "Expected existing parameter to use its previous value")
+			hopLimit, err := cfnParams.GetParameter(ParameterKeyMetadataHopLimit)
+			assert.NoError(t, err, "Expected MetadataHopLimit parameter to be present")
+			assert.Equal(t, "2", aws.StringValue(hopLimit.ParameterValue))
+			isIMDSv2, err := cfnParams.GetParameter(ParameterKeyIsIMDSv2)
+			assert.NoError(t, err, "Expected IsIMDSv2 parameter to be present")
+			assert.Equal(t, "true", aws.StringValue(isIMDSv2.ParameterValue))
+		}).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilUpdateComplete(stackName).Return(nil),
+	)
+
+	context := migrateTemplateContext(true)
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = migrateClusterTemplate(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error migrating cluster template")
+}
+
+func TestClusterMigrateTemplateAlreadyMigrated(t *testing.T) {
+	defer os.Clearenv()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	awsClients := &AWSClients{CFNClient: mockCloudformation}
+	os.Setenv("AWS_REGION", "us-west-1")
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().DescribeStacks(stackName).Return(&sdkCFN.DescribeStacksOutput{Stacks: []*sdkCFN.Stack{{}}}, nil),
+		mockCloudformation.EXPECT().DescribeStackResource(stackName, cloudformation.LaunchConfigurationLogicalResourceId).Return(nil, nil),
+	)
+
+	context := migrateTemplateContext(true)
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = migrateClusterTemplate(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error migrating a stack without a launch configuration")
+}
+
+func TestClusterMigrateTemplateWithoutIamCapability(t *testing.T) {
+	defer os.Clearenv()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	awsClients := &AWSClients{CFNClient: mockCloudformation}
+	os.Setenv("AWS_REGION", "us-west-1")
+
+	context := migrateTemplateContext(false)
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = migrateClusterTemplate(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error migrating cluster template when iam capability is not specified")
+}
+
 /////////////////
 // Cluster PS //
 ////////////////
@@ -1431,6 +2292,8 @@ func TestClusterPSTaskGetInfoFail(t *testing.T) {
 	testSession, err := session.NewSession()
 	assert.NoError(t, err, "Unexpected error in creating session")
 
+	oldNewCommandConfig := newCommandConfig
+	defer func() { newCommandConfig = oldNewCommandConfig }()
 	newCommandConfig = func(context *cli.Context, rdwr config.ReadWriter) (*config.CommandConfig, error) {
 		return &config.CommandConfig{
 			Cluster: clusterName,
@@ -1469,7 +2332,7 @@ func mocksForSuccessfulClusterUp(mockECS *mock_ecs.MockECSClient, mockCloudforma
 		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
 	)
 	gomock.InOrder(
-		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+		mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "t2.micro").Return(amiMetadata(amiID), nil),
 	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
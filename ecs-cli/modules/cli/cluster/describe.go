@@ -0,0 +1,349 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// const symbols and widths of the describe tables
+const (
+	minWidth    = 10
+	tabWidth    = 1
+	padding     = 3
+	paddingChar = ' '
+	numOfFlags  = 0
+)
+
+// Container instance attributes which the ECS agent registers
+const (
+	availabilityZoneAttribute = "ecs.availability-zone"
+	instanceTypeAttribute     = "ecs.instance-type"
+)
+
+// Names of the resources of container instances
+const (
+	cpuResource    = "CPU"
+	memoryResource = "MEMORY"
+)
+
+// clusterDescription is the state of a cluster printed by 'describe'.
+type clusterDescription struct {
+	Name                            string                         `json:"name"`
+	ARN                             string                         `json:"arn"`
+	Status                          string                         `json:"status"`
+	RegisteredContainerInstances    int64                          `json:"registeredContainerInstancesCount"`
+	RunningTasks                    int64                          `json:"runningTasksCount"`
+	PendingTasks                    int64                          `json:"pendingTasksCount"`
+	ActiveServices                  int64                          `json:"activeServicesCount"`
+	CapacityProviders               []string                       `json:"capacityProviders"`
+	DefaultCapacityProviderStrategy []capacityProviderStrategyItem `json:"defaultCapacityProviderStrategy"`
+	Stack                           *stackDescription              `json:"stack,omitempty"`
+	ContainerInstances              []containerInstanceDescription `json:"containerInstances"`
+	Services                        []serviceDescription           `json:"services"`
+}
+
+type capacityProviderStrategyItem struct {
+	CapacityProvider string `json:"capacityProvider"`
+	Weight           int64  `json:"weight"`
+	Base             int64  `json:"base"`
+}
+
+// stackDescription is the CloudFormation stack created by 'up' for a cluster.
+type stackDescription struct {
+	Name       string            `json:"name"`
+	Status     string            `json:"status"`
+	Parameters map[string]string `json:"parameters"`
+}
+
+type containerInstanceDescription struct {
+	EC2InstanceID    string `json:"ec2InstanceId"`
+	Status           string `json:"status"`
+	AgentConnected   bool   `json:"agentConnected"`
+	AgentVersion     string `json:"agentVersion"`
+	AvailabilityZone string `json:"availabilityZone"`
+	InstanceType     string `json:"instanceType"`
+	RunningTasks     int64  `json:"runningTasksCount"`
+	PendingTasks     int64  `json:"pendingTasksCount"`
+	RemainingCPU     int64  `json:"remainingCpu"`
+	RegisteredCPU    int64  `json:"registeredCpu"`
+	RemainingMemory  int64  `json:"remainingMemory"`
+	RegisteredMemory int64  `json:"registeredMemory"`
+}
+
+type serviceDescription struct {
+	Name           string `json:"name"`
+	Status         string `json:"status"`
+	DesiredCount   int64  `json:"desiredCount"`
+	RunningCount   int64  `json:"runningCount"`
+	PendingCount   int64  `json:"pendingCount"`
+	LaunchType     string `json:"launchType,omitempty"`
+	TaskDefinition string `json:"taskDefinition"`
+}
+
+func describeCluster(context *cli.Context, awsClients *AWSClients, commandConfig *config.CommandConfig, out io.Writer) error {
+	if commandConfig.Cluster == "" {
+		return clusterNotSetError()
+	}
+
+	cluster, err := awsClients.ECSClient.DescribeCluster(commandConfig.Cluster)
+	if err != nil {
+		return err
+	}
+	description := newClusterDescription(cluster)
+
+	if description.Stack, err = describeClusterStack(awsClients, commandConfig.CFNStackName); err != nil {
+		return err
+	}
+
+	containerInstances, err := awsClients.ECSClient.GetContainerInstances()
+	if err != nil {
+		return err
+	}
+	for _, containerInstance := range containerInstances {
+		description.ContainerInstances = append(description.ContainerInstances, newContainerInstanceDescription(containerInstance))
+	}
+	sort.Slice(description.ContainerInstances, func(i, j int) bool {
+		return description.ContainerInstances[i].EC2InstanceID < description.ContainerInstances[j].EC2InstanceID
+	})
+
+	services, err := awsClients.ECSClient.GetServices()
+	if err != nil {
+		return err
+	}
+	for _, service := range services {
+		description.Services = append(description.Services, newServiceDescription(service))
+	}
+	sort.Slice(description.Services, func(i, j int) bool {
+		return description.Services[i].Name < description.Services[j].Name
+	})
+
+	if context.Bool(flags.JSON) {
+		output, err := json.MarshalIndent(description, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(output))
+		return nil
+	}
+	printClusterDescription(out, description)
+	return nil
+}
+
+func newClusterDescription(cluster *ecs.Cluster) *clusterDescription {
+	description := &clusterDescription{
+		Name:                            aws.StringValue(cluster.ClusterName),
+		ARN:                             aws.StringValue(cluster.ClusterArn),
+		Status:                          aws.StringValue(cluster.Status),
+		RegisteredContainerInstances:    aws.Int64Value(cluster.RegisteredContainerInstancesCount),
+		RunningTasks:                    aws.Int64Value(cluster.RunningTasksCount),
+		PendingTasks:                    aws.Int64Value(cluster.PendingTasksCount),
+		ActiveServices:                  aws.Int64Value(cluster.ActiveServicesCount),
+		CapacityProviders:               aws.StringValueSlice(cluster.CapacityProviders),
+		DefaultCapacityProviderStrategy: []capacityProviderStrategyItem{},
+		ContainerInstances:              []containerInstanceDescription{},
+		Services:                        []serviceDescription{},
+	}
+	for _, item := range cluster.DefaultCapacityProviderStrategy {
+		description.DefaultCapacityProviderStrategy = append(description.DefaultCapacityProviderStrategy, capacityProviderStrategyItem{
+			CapacityProvider: aws.StringValue(item.CapacityProvider),
+			Weight:           aws.Int64Value(item.Weight),
+			Base:             aws.Int64Value(item.Base),
+		})
+	}
+	return description
+}
+
+// describeClusterStack returns the stack of the cluster, or nil if the cluster
+// was not created by 'up'.
+func describeClusterStack(awsClients *AWSClients, stackName string) (*stackDescription, error) {
+	output, err := awsClients.CFNClient.DescribeStacks(stackName)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && strings.Contains(aerr.Message(), "does not exist") {
+			logrus.Debugf("Stack %s does not exist: %v", stackName, err)
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(output.Stacks) == 0 {
+		return nil, nil
+	}
+
+	stack := output.Stacks[0]
+	description := &stackDescription{
+		Name:       aws.StringValue(stack.StackName),
+		Status:     aws.StringValue(stack.StackStatus),
+		Parameters: make(map[string]string),
+	}
+	for _, param := range stack.Parameters {
+		description.Parameters[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	return description, nil
+}
+
+func newContainerInstanceDescription(containerInstance *ecs.ContainerInstance) containerInstanceDescription {
+	description := containerInstanceDescription{
+		EC2InstanceID:  aws.StringValue(containerInstance.Ec2InstanceId),
+		Status:         aws.StringValue(containerInstance.Status),
+		AgentConnected: aws.BoolValue(containerInstance.AgentConnected),
+		RunningTasks:   aws.Int64Value(containerInstance.RunningTasksCount),
+		PendingTasks:   aws.Int64Value(containerInstance.PendingTasksCount),
+	}
+	if containerInstance.VersionInfo != nil {
+		description.AgentVersion = aws.StringValue(containerInstance.VersionInfo.AgentVersion)
+	}
+	for _, attribute := range containerInstance.Attributes {
+		switch aws.StringValue(attribute.Name) {
+		case availabilityZoneAttribute:
+			description.AvailabilityZone = aws.StringValue(attribute.Value)
+		case instanceTypeAttribute:
+			description.InstanceType = aws.StringValue(attribute.Value)
+		}
+	}
+	description.RemainingCPU = resourceValue(containerInstance.RemainingResources, cpuResource)
+	description.RegisteredCPU = resourceValue(containerInstance.RegisteredResources, cpuResource)
+	description.RemainingMemory = resourceValue(containerInstance.RemainingResources, memoryResource)
+	description.RegisteredMemory = resourceValue(containerInstance.RegisteredResources, memoryResource)
+	return description
+}
+
+func resourceValue(resources []*ecs.Resource, name string) int64 {
+	for _, resource := range resources {
+		if aws.StringValue(resource.Name) == name {
+			return aws.Int64Value(resource.IntegerValue)
+		}
+	}
+	return 0
+}
+
+func newServiceDescription(service *ecs.Service) serviceDescription {
+	description := serviceDescription{
+		Name:         aws.StringValue(service.ServiceName),
+		Status:       aws.StringValue(service.Status),
+		DesiredCount: aws.Int64Value(service.DesiredCount),
+		RunningCount: aws.Int64Value(service.RunningCount),
+		PendingCount: aws.Int64Value(service.PendingCount),
+		LaunchType:   aws.StringValue(service.LaunchType),
+	}
+	// the task definition is shown as family:revision rather than its ARN
+	taskDefinition := aws.StringValue(service.TaskDefinition)
+	description.TaskDefinition = taskDefinition[strings.LastIndex(taskDefinition, "/")+1:]
+	return description
+}
+
+func printClusterDescription(out io.Writer, description *clusterDescription) {
+	w := tabwriter.NewWriter(out, minWidth, tabWidth, padding, paddingChar, numOfFlags)
+	fmt.Fprintf(w, "Cluster:\t%s\n", description.Name)
+	fmt.Fprintf(w, "ARN:\t%s\n", description.ARN)
+	fmt.Fprintf(w, "Status:\t%s\n", description.Status)
+	fmt.Fprintf(w, "Container instances:\t%d\n", description.RegisteredContainerInstances)
+	fmt.Fprintf(w, "Running tasks:\t%d\n", description.RunningTasks)
+	fmt.Fprintf(w, "Pending tasks:\t%d\n", description.PendingTasks)
+	fmt.Fprintf(w, "Active services:\t%d\n", description.ActiveServices)
+	fmt.Fprintf(w, "Capacity providers:\t%s\n", formatList(description.CapacityProviders))
+	fmt.Fprintf(w, "Default capacity provider strategy:\t%s\n", formatCapacityProviderStrategy(description.DefaultCapacityProviderStrategy))
+	if description.Stack == nil {
+		fmt.Fprintf(w, "CloudFormation stack:\t<none>\n")
+	} else {
+		fmt.Fprintf(w, "CloudFormation stack:\t%s\n", description.Stack.Name)
+		fmt.Fprintf(w, "Stack status:\t%s\n", description.Stack.Status)
+	}
+	w.Flush()
+
+	if description.Stack != nil && len(description.Stack.Parameters) > 0 {
+		fmt.Fprintln(out, "\nStack parameters:")
+		var keys []string
+		for key := range description.Stack.Parameters {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value := description.Stack.Parameters[key]
+			// user data is a multipart archive which does not fit in a table
+			if key == ParameterKeyUserData {
+				value = fmt.Sprintf("<%d bytes>", len(value))
+			}
+			fmt.Fprintf(w, "  %s\t%s\n", key, value)
+		}
+		w.Flush()
+	}
+
+	fmt.Fprintln(out, "\nContainer instances:")
+	if len(description.ContainerInstances) == 0 {
+		fmt.Fprintln(out, "  <none>")
+	} else {
+		fmt.Fprintln(w, "  EC2 INSTANCE\tSTATUS\tAGENT\tCONNECTED\tAZ\tINSTANCE TYPE\tTASKS\tCPU AVAILABLE\tMEMORY AVAILABLE")
+		for _, instance := range description.ContainerInstances {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%t\t%s\t%s\t%d\t%d/%d\t%d/%d\n",
+				instance.EC2InstanceID,
+				instance.Status,
+				instance.AgentVersion,
+				instance.AgentConnected,
+				instance.AvailabilityZone,
+				instance.InstanceType,
+				instance.RunningTasks+instance.PendingTasks,
+				instance.RemainingCPU,
+				instance.RegisteredCPU,
+				instance.RemainingMemory,
+				instance.RegisteredMemory)
+		}
+		w.Flush()
+	}
+
+	fmt.Fprintln(out, "\nServices:")
+	if len(description.Services) == 0 {
+		fmt.Fprintln(out, "  <none>")
+		return
+	}
+	fmt.Fprintln(w, "  NAME\tSTATUS\tDESIRED\tRUNNING\tPENDING\tLAUNCH TYPE\tTASK DEFINITION")
+	for _, service := range description.Services {
+		fmt.Fprintf(w, "  %s\t%s\t%d\t%d\t%d\t%s\t%s\n",
+			service.Name,
+			service.Status,
+			service.DesiredCount,
+			service.RunningCount,
+			service.PendingCount,
+			service.LaunchType,
+			service.TaskDefinition)
+	}
+	w.Flush()
+}
+
+func formatList(values []string) string {
+	if len(values) == 0 {
+		return "<none>"
+	}
+	return strings.Join(values, ", ")
+}
+
+func formatCapacityProviderStrategy(strategy []capacityProviderStrategyItem) string {
+	var items []string
+	for _, item := range strategy {
+		items = append(items, fmt.Sprintf("%s (weight %d, base %d)", item.CapacityProvider, item.Weight, item.Base))
+	}
+	return formatList(items)
+}
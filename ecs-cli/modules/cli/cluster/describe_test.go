@@ -0,0 +1,192 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	sdkCFN "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func describeTestCluster() *ecs.Cluster {
+	return &ecs.Cluster{
+		ClusterName:                       aws.String(clusterName),
+		ClusterArn:                        aws.String("arn:aws:ecs:us-west-1:123456789012:cluster/" + clusterName),
+		Status:                            aws.String("ACTIVE"),
+		RegisteredContainerInstancesCount: aws.Int64(2),
+		RunningTasksCount:                 aws.Int64(3),
+		PendingTasksCount:                 aws.Int64(1),
+		ActiveServicesCount:               aws.Int64(1),
+		CapacityProviders:                 aws.StringSlice([]string{"FARGATE", "my-asg-provider"}),
+		DefaultCapacityProviderStrategy: []*ecs.CapacityProviderStrategyItem{
+			{
+				CapacityProvider: aws.String("my-asg-provider"),
+				Weight:           aws.Int64(1),
+				Base:             aws.Int64(0),
+			},
+		},
+	}
+}
+
+func describeTestContainerInstances() []*ecs.ContainerInstance {
+	return []*ecs.ContainerInstance{
+		{
+			Ec2InstanceId:     aws.String("i-2"),
+			Status:            aws.String("ACTIVE"),
+			AgentConnected:    aws.Bool(true),
+			RunningTasksCount: aws.Int64(2),
+			VersionInfo:       &ecs.VersionInfo{AgentVersion: aws.String("1.51.0")},
+			Attributes: []*ecs.Attribute{
+				{Name: aws.String("ecs.availability-zone"), Value: aws.String("us-west-1b")},
+				{Name: aws.String("ecs.instance-type"), Value: aws.String("t3.medium")},
+			},
+			RemainingResources: []*ecs.Resource{
+				{Name: aws.String("CPU"), IntegerValue: aws.Int64(1024)},
+				{Name: aws.String("MEMORY"), IntegerValue: aws.Int64(1500)},
+			},
+			RegisteredResources: []*ecs.Resource{
+				{Name: aws.String("CPU"), IntegerValue: aws.Int64(2048)},
+				{Name: aws.String("MEMORY"), IntegerValue: aws.Int64(3904)},
+			},
+		},
+		{
+			Ec2InstanceId:  aws.String("i-1"),
+			Status:         aws.String("DRAINING"),
+			AgentConnected: aws.Bool(false),
+		},
+	}
+}
+
+func describeTestServices() []*ecs.Service {
+	return []*ecs.Service{
+		{
+			ServiceName:    aws.String("web"),
+			Status:         aws.String("ACTIVE"),
+			DesiredCount:   aws.Int64(3),
+			RunningCount:   aws.Int64(2),
+			PendingCount:   aws.Int64(1),
+			LaunchType:     aws.String("EC2"),
+			TaskDefinition: aws.String("arn:aws:ecs:us-west-1:123456789012:task-definition/web:7"),
+		},
+	}
+}
+
+func describeContext(json bool) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-describe", 0)
+	flagSet.Bool(flags.JSON, json, "")
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func TestDescribeCluster(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	mockECS.EXPECT().DescribeCluster(clusterName).Return(describeTestCluster(), nil)
+	mockCloudformation.EXPECT().DescribeStacks(stackName).Return(&sdkCFN.DescribeStacksOutput{
+		Stacks: []*sdkCFN.Stack{
+			{
+				StackName:   aws.String(stackName),
+				StackStatus: aws.String(sdkCFN.StackStatusCreateComplete),
+				Parameters: []*sdkCFN.Parameter{
+					{ParameterKey: aws.String(ParameterKeyAsgMaxSize), ParameterValue: aws.String("2")},
+					{ParameterKey: aws.String(ParameterKeyUserData), ParameterValue: aws.String(mockedUserData)},
+				},
+			},
+		},
+	}, nil)
+	mockECS.EXPECT().GetContainerInstances().Return(describeTestContainerInstances(), nil)
+	mockECS.EXPECT().GetServices().Return(describeTestServices(), nil)
+
+	context := describeContext(false)
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	out := &bytes.Buffer{}
+	err = describeCluster(context, awsClients, commandConfig, out)
+	assert.NoError(t, err, "Unexpected error describing cluster")
+
+	output := out.String()
+	assert.Contains(t, output, "my-asg-provider (weight 1, base 0)")
+	assert.Contains(t, output, sdkCFN.StackStatusCreateComplete)
+	assert.Contains(t, output, "<14 bytes>", "Expected user data to be summarized")
+	assert.NotContains(t, output, mockedUserData)
+	assert.Regexp(t, `i-2\s+ACTIVE\s+1\.51\.0\s+true\s+us-west-1b\s+t3\.medium\s+2\s+1024/2048\s+1500/3904`, output)
+	assert.Regexp(t, `web\s+ACTIVE\s+3\s+2\s+1\s+EC2\s+web:7`, output)
+	assert.True(t, bytes.Index(out.Bytes(), []byte("i-1")) < bytes.Index(out.Bytes(), []byte("i-2")), "Expected container instances to be sorted")
+}
+
+func TestDescribeClusterJSONWithoutStack(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	mockECS.EXPECT().DescribeCluster(clusterName).Return(describeTestCluster(), nil)
+	mockCloudformation.EXPECT().DescribeStacks(stackName).Return(nil,
+		awserr.New("ValidationError", "Stack with id "+stackName+" does not exist", nil))
+	mockECS.EXPECT().GetContainerInstances().Return(describeTestContainerInstances(), nil)
+	mockECS.EXPECT().GetServices().Return(describeTestServices(), nil)
+
+	context := describeContext(true)
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	out := &bytes.Buffer{}
+	err = describeCluster(context, awsClients, commandConfig, out)
+	assert.NoError(t, err, "Unexpected error describing cluster")
+
+	description := clusterDescription{}
+	err = json.Unmarshal(out.Bytes(), &description)
+	assert.NoError(t, err, "Expected JSON output")
+	assert.Nil(t, description.Stack, "Expected no stack for a cluster not created by up")
+	assert.Equal(t, int64(3), description.RunningTasks)
+	if assert.Len(t, description.ContainerInstances, 2) {
+		assert.Equal(t, "i-1", description.ContainerInstances[0].EC2InstanceID)
+		assert.Equal(t, "t3.medium", description.ContainerInstances[1].InstanceType)
+		assert.Equal(t, int64(1500), description.ContainerInstances[1].RemainingMemory)
+	}
+	if assert.Len(t, description.Services, 1) {
+		assert.Equal(t, int64(3), description.Services[0].DesiredCount)
+	}
+}
+
+func TestDescribeClusterErrorCases(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	context := describeContext(false)
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	mockECS.EXPECT().DescribeCluster(clusterName).Return(nil, errors.New("cluster not found"))
+	err = describeCluster(context, awsClients, commandConfig, &bytes.Buffer{})
+	assert.Error(t, err, "Expected error when the cluster can not be described")
+
+	mockECS.EXPECT().DescribeCluster(clusterName).Return(describeTestCluster(), nil)
+	mockCloudformation.EXPECT().DescribeStacks(stackName).Return(nil, awserr.New("AccessDenied", "not authorized", nil))
+	err = describeCluster(context, awsClients, commandConfig, &bytes.Buffer{})
+	assert.Error(t, err, "Expected error when the stack can not be described")
+}
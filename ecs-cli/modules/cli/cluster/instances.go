@@ -0,0 +1,467 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	ec2client "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2"
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	sdkCFN "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// InstanceIDsFormat is the argument of the 'instances drain' command
+const InstanceIDsFormat = "CONTAINER_INSTANCE_ID|EC2_INSTANCE_ID [CONTAINER_INSTANCE_ID|EC2_INSTANCE_ID...]"
+
+// amiIDAttribute is the container instance attribute with the ID of its AMI
+const amiIDAttribute = "ecs.ami-id"
+
+// serviceTaskGroupPrefix prefixes the group of the tasks started by services
+const serviceTaskGroupPrefix = "service:"
+
+// ecsServiceStatusActive is the status of services which have not been deleted
+const ecsServiceStatusActive = "ACTIVE"
+
+// Polling settings of the waits for container instances and services; they
+// are variables so that tests can shorten them.
+var (
+	instancePollInterval = 15 * time.Second
+	instanceWaitTimeout  = 30 * time.Minute
+)
+
+// clusterInstancesAPI is the subset of the ECS and EC2 clients used to drain and replace
+// the container instances of a cluster.
+type clusterInstancesAPI interface {
+	GetContainerInstances() ([]*ecs.ContainerInstance, error)
+	DrainContainerInstances(containerInstanceArns []*string) error
+	GetTasksPages(listTasksInput *ecs.ListTasksInput, fn ecsclient.ProcessTasksAction) error
+	GetServices() ([]*ecs.Service, error)
+	TerminateInstances(ec2InstanceIds []*string) error
+}
+
+// awsClusterInstances implements clusterInstancesAPI with the ECS and EC2 clients.
+type awsClusterInstances struct {
+	ecsclient.ECSClient
+	ec2client.EC2Client
+}
+
+func newClusterInstancesAPI(awsClients *AWSClients) clusterInstancesAPI {
+	return &awsClusterInstances{
+		ECSClient: awsClients.ECSClient,
+		EC2Client: awsClients.EC2Client,
+	}
+}
+
+// drainInstances executes the 'instances drain' command.
+func drainInstances(context *cli.Context, api clusterInstancesAPI, commandConfig *config.CommandConfig) error {
+	if commandConfig.Cluster == "" {
+		return clusterNotSetError()
+	}
+	ids := context.Args()
+	if len(ids) == 0 {
+		return fmt.Errorf("Specify the container instance IDs or EC2 instance IDs of the instances to drain")
+	}
+
+	containerInstances, err := api.GetContainerInstances()
+	if err != nil {
+		return err
+	}
+	var toDrain []*ecs.ContainerInstance
+	for _, id := range ids {
+		containerInstance := findContainerInstance(containerInstances, id)
+		if containerInstance == nil {
+			return fmt.Errorf("Container instance '%s' not found in cluster '%s'", id, commandConfig.Cluster)
+		}
+		toDrain = append(toDrain, containerInstance)
+	}
+	return drainAndWait(api, toDrain)
+}
+
+// upgradeCluster executes the 'upgrade' command. It updates the AMI and the
+// instance type of the launch template of the cluster, then replaces the
+// container instances which do not use them yet.
+func upgradeCluster(context *cli.Context, awsClients *AWSClients, api clusterInstancesAPI, commandConfig *config.CommandConfig) error {
+	if !isIAMAcknowledged(context) {
+		return fmt.Errorf("Please acknowledge that this command may create IAM resources with the '--%s' flag", flags.CapabilityIAMFlag)
+	}
+	settings, err := getInstanceReplacement(context)
+	if err != nil {
+		return err
+	}
+
+	if err := validateCluster(commandConfig.Cluster, awsClients.ECSClient); err != nil {
+		return err
+	}
+
+	cfnClient := awsClients.CFNClient
+	stackName := commandConfig.CFNStackName
+	existingParameters, err := cfnClient.GetStackParameters(stackName)
+	if err != nil {
+		return fmt.Errorf("CloudFormation stack not found for cluster '%s'", commandConfig.Cluster)
+	}
+	if stackParameterValue(existingParameters, ParameterKeyIsFargate) == "true" {
+		return fmt.Errorf("Cluster '%s' was created for launch type FARGATE and has no container instances to upgrade", commandConfig.Cluster)
+	}
+	isMixedInstances := stackParameterValue(existingParameters, ParameterKeyInstanceTypes) != ""
+
+	cfnParams, err := cloudformation.NewCfnStackParamsForUpdate(requiredParameters, existingParameters)
+	if err != nil {
+		return err
+	}
+	instanceType := stackParameterValue(existingParameters, ParameterKeyInstanceType)
+	if value := context.String(flags.InstanceTypeFlag); value != "" {
+		if isMixedInstances {
+			return fmt.Errorf("Cannot change the instance type of cluster '%s', which was created with '--%s'", commandConfig.Cluster, flags.InstanceTypesFlag)
+		}
+		instanceType = value
+	}
+	cfnParams.Add(ParameterKeyInstanceType, instanceType)
+	if err := addUpgradeAMIID(context, cfnParams, awsClients.AMIMetadataClient, existingParameters); err != nil {
+		return err
+	}
+	param, err := cfnParams.GetParameter(ParameterKeyAmiId)
+	if err != nil {
+		return err
+	}
+	amiID := aws.StringValue(param.ParameterValue)
+
+	if amiID == stackParameterValue(existingParameters, ParameterKeyAmiId) && instanceType == stackParameterValue(existingParameters, ParameterKeyInstanceType) {
+		logrus.Infof("The launch template of cluster '%s' already uses AMI %s and instance type %s", commandConfig.Cluster, amiID, instanceType)
+	} else {
+		if _, err := cfnClient.UpdateStack(stackName, cfnParams); err != nil {
+			return err
+		}
+		logrus.Info("Waiting for your cluster resources to be updated...")
+		if err := cfnClient.WaitUntilUpdateComplete(stackName); err != nil {
+			return err
+		}
+	}
+
+	containerInstances, err := api.GetContainerInstances()
+	if err != nil {
+		return err
+	}
+	var toReplace []*ecs.ContainerInstance
+	for _, containerInstance := range containerInstances {
+		status := aws.StringValue(containerInstance.Status)
+		if status != ecs.ContainerInstanceStatusActive && status != ecs.ContainerInstanceStatusDraining {
+			continue
+		}
+		// the instance types of mixed instances clusters vary, so only their AMI is compared
+		if containerInstanceAttribute(containerInstance, amiIDAttribute) != amiID ||
+			(!isMixedInstances && containerInstanceAttribute(containerInstance, instanceTypeAttribute) != instanceType) {
+			toReplace = append(toReplace, containerInstance)
+		}
+	}
+	if len(toReplace) == 0 {
+		logrus.Infof("All the container instances of cluster '%s' are up to date", commandConfig.Cluster)
+		return nil
+	}
+
+	logrus.Infof("Replacing %d container instances, %d at a time", len(toReplace), settings.batchSize)
+	if err := replaceInstances(api, toReplace, settings); err != nil {
+		return err
+	}
+	logrus.Infof("Replaced %d container instances", len(toReplace))
+	return nil
+}
+
+// getInstanceReplacement returns the settings given with --batch-size and
+// --max-unavailable. The maximum number of unavailable instances defaults to
+// the batch size.
+func getInstanceReplacement(context *cli.Context) (instanceReplacement, error) {
+	settings := instanceReplacement{
+		batchSize:      context.Int(flags.BatchSizeFlag),
+		maxUnavailable: context.Int(flags.MaxUnavailableFlag),
+	}
+	if settings.batchSize < 1 {
+		return settings, fmt.Errorf("'--%s' must be at least 1", flags.BatchSizeFlag)
+	}
+	if settings.maxUnavailable == 0 {
+		settings.maxUnavailable = settings.batchSize
+	}
+	if settings.maxUnavailable < settings.batchSize {
+		return settings, fmt.Errorf("'--%s' must be at least '--%s'", flags.MaxUnavailableFlag, flags.BatchSizeFlag)
+	}
+	return settings, nil
+}
+
+// addUpgradeAMIID adds the AMI given with --image-id, or else the recommended
+// AMI of the cluster's AMI family, to the stack parameters. The AMI family is
+// the one the cluster was created with, unless --ami-family is given with
+// --change-ami-family. Bottlerocket clusters can not change to another AMI
+// family, nor the other way around, since their user data is not written in
+// the same format.
+func addUpgradeAMIID(context *cli.Context, cfnParams *cloudformation.CfnStackParams, client amimetadata.Client, existingParameters []*sdkCFN.Parameter) error {
+	if imageID := context.String(flags.ImageIdFlag); imageID != "" {
+		if context.String(flags.AMIFamilyFlag) != "" {
+			return fmt.Errorf("You can only specify '--%s' or '--%s'", flags.ImageIdFlag, flags.AMIFamilyFlag)
+		}
+		return cfnParams.Add(ParameterKeyAmiId, imageID)
+	}
+
+	// stacks created before the AMI family was recorded could only use the
+	// al2 family
+	clusterFamily := stackParameterValue(existingParameters, ParameterKeyAmiFamily)
+	isRecorded := clusterFamily != ""
+	if !isRecorded {
+		clusterFamily = amimetadata.AMIFamilyAmazonLinux2
+	}
+
+	amiFamily := clusterFamily
+	if context.String(flags.AMIFamilyFlag) != "" {
+		family, err := getAMIFamily(context)
+		if err != nil {
+			return err
+		}
+		amiFamily = family
+	}
+	if amiFamily != clusterFamily {
+		if !context.Bool(flags.ChangeAMIFamilyFlag) {
+			return fmt.Errorf("The cluster uses AMI family %s. Specify '--%s' to change it to %s", clusterFamily, flags.ChangeAMIFamilyFlag, amiFamily)
+		}
+		if (amiFamily == amimetadata.AMIFamilyBottlerocket) != (clusterFamily == amimetadata.AMIFamilyBottlerocket) {
+			return fmt.Errorf("Cannot change the AMI family of the cluster from or to %s, whose user data is not compatible with the other families", amimetadata.AMIFamilyBottlerocket)
+		}
+		if !isRecorded {
+			return fmt.Errorf("Cannot change the AMI family of a cluster created by an earlier version of the ECS CLI, which does not record its AMI family")
+		}
+		if err := cfnParams.Add(ParameterKeyAmiFamily, amiFamily); err != nil {
+			return err
+		}
+	}
+	return populateAMIID(cfnParams, client, amiFamily)
+}
+
+// findContainerInstance returns the container instance with the given ARN, ID or EC2 instance ID.
+func findContainerInstance(containerInstances []*ecs.ContainerInstance, id string) *ecs.ContainerInstance {
+	for _, containerInstance := range containerInstances {
+		arn := aws.StringValue(containerInstance.ContainerInstanceArn)
+		if arn == id || strings.HasSuffix(arn, "/"+id) || aws.StringValue(containerInstance.Ec2InstanceId) == id {
+			return containerInstance
+		}
+	}
+	return nil
+}
+
+// drainAndWait sets the container instances to DRAINING and waits until the
+// tasks of services have been moved off them. Tasks which were not started by
+// a service are not stopped by ECS when an instance is drained, so they are
+// not waited for.
+func drainAndWait(api clusterInstancesAPI, containerInstances []*ecs.ContainerInstance) error {
+	var arns []*string
+	for _, containerInstance := range containerInstances {
+		arns = append(arns, containerInstance.ContainerInstanceArn)
+	}
+	if err := api.DrainContainerInstances(arns); err != nil {
+		return err
+	}
+
+	logrus.Info("Waiting for the tasks of services to move off the draining container instances...")
+	return waitFor(fmt.Sprintf("%d container instances to drain", len(arns)), func() (bool, error) {
+		for _, arn := range arns {
+			serviceTasks, otherTasks, err := countInstanceTasks(api, arn)
+			if err != nil {
+				return false, err
+			}
+			if serviceTasks > 0 {
+				logrus.Debugf("Container instance %s still runs %d service tasks", aws.StringValue(arn), serviceTasks)
+				return false, nil
+			}
+			if otherTasks > 0 {
+				logrus.Warnf("Container instance %s still runs %d tasks which were not started by a service; draining does not stop them", aws.StringValue(arn), otherTasks)
+			}
+		}
+		return true, nil
+	})
+}
+
+// countInstanceTasks returns the number of service tasks and other tasks which
+// run or will run on the container instance.
+func countInstanceTasks(api clusterInstancesAPI, containerInstanceArn *string) (serviceTasks, otherTasks int, err error) {
+	err = api.GetTasksPages(&ecs.ListTasksInput{
+		ContainerInstance: containerInstanceArn,
+	}, func(tasks []*ecs.Task) error {
+		for _, task := range tasks {
+			if aws.StringValue(task.LastStatus) == ecs.DesiredStatusStopped {
+				continue
+			}
+			if strings.HasPrefix(aws.StringValue(task.Group), serviceTaskGroupPrefix) {
+				serviceTasks++
+			} else {
+				otherTasks++
+			}
+		}
+		return nil
+	})
+	return serviceTasks, otherTasks, err
+}
+
+// waitFor polls the condition until it is true, it fails, or the wait times out.
+func waitFor(description string, condition func() (bool, error)) error {
+	deadline := time.Now().Add(instanceWaitTimeout)
+	for {
+		done, err := condition()
+		if err != nil || done {
+			return err
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("Timed out after %s waiting for %s", instanceWaitTimeout, description)
+		}
+		time.Sleep(instancePollInterval)
+	}
+}
+
+// isHealthyInstance returns whether the container instance can run tasks.
+func isHealthyInstance(containerInstance *ecs.ContainerInstance) bool {
+	return aws.StringValue(containerInstance.Status) == ecs.ContainerInstanceStatusActive && aws.BoolValue(containerInstance.AgentConnected)
+}
+
+func containerInstanceAttribute(containerInstance *ecs.ContainerInstance, name string) string {
+	for _, attribute := range containerInstance.Attributes {
+		if aws.StringValue(attribute.Name) == name {
+			return aws.StringValue(attribute.Value)
+		}
+	}
+	return ""
+}
+
+// waitForStableServices waits until every service of the cluster runs its
+// desired number of tasks and has finished its deployments.
+func waitForStableServices(api clusterInstancesAPI) error {
+	return waitFor("the services of the cluster to become stable", func() (bool, error) {
+		services, err := api.GetServices()
+		if err != nil {
+			return false, err
+		}
+		for _, service := range services {
+			if aws.StringValue(service.Status) != ecsServiceStatusActive {
+				continue
+			}
+			if len(service.Deployments) > 1 || aws.Int64Value(service.RunningCount) != aws.Int64Value(service.DesiredCount) {
+				logrus.Debugf("Service %s runs %d of %d tasks with %d deployments", aws.StringValue(service.ServiceName),
+					aws.Int64Value(service.RunningCount), aws.Int64Value(service.DesiredCount), len(service.Deployments))
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// instanceReplacement holds the settings of a rolling replacement of container instances.
+type instanceReplacement struct {
+	// batchSize is the number of instances to drain and terminate together
+	batchSize int
+	// maxUnavailable is the maximum number of instances, compared to the
+	// number of healthy instances when the replacement started, which may be
+	// draining or waiting for their replacement to register at the same time
+	maxUnavailable int
+}
+
+// replaceInstances drains and terminates the given container instances in
+// batches, relying on the Auto Scaling group of the cluster to launch their
+// replacements. A batch is started once the services are stable and enough
+// healthy instances are available; the replacement is complete when as many
+// healthy instances as before have registered.
+func replaceInstances(api clusterInstancesAPI, toReplace []*ecs.ContainerInstance, settings instanceReplacement) error {
+	containerInstances, err := api.GetContainerInstances()
+	if err != nil {
+		return err
+	}
+	baseline := 0
+	for _, containerInstance := range containerInstances {
+		if isHealthyInstance(containerInstance) {
+			baseline++
+		}
+	}
+	if settings.maxUnavailable >= baseline {
+		logrus.Warnf("Replacing %d of the %d healthy container instances at a time; tasks may not be able to run until the replacements register", settings.maxUnavailable, baseline)
+	}
+
+	terminated := make(map[string]bool)
+	unavailable := func() (int, error) {
+		containerInstances, err := api.GetContainerInstances()
+		if err != nil {
+			return 0, err
+		}
+		healthy := 0
+		for _, containerInstance := range containerInstances {
+			if isHealthyInstance(containerInstance) && !terminated[aws.StringValue(containerInstance.ContainerInstanceArn)] {
+				healthy++
+			}
+		}
+		return baseline - healthy, nil
+	}
+
+	replaced := 0
+	for len(toReplace) > 0 {
+		var room int
+		err := waitFor("replacement container instances to register", func() (bool, error) {
+			count, err := unavailable()
+			room = settings.maxUnavailable - count
+			return room > 0, err
+		})
+		if err != nil {
+			return err
+		}
+		if err := waitForStableServices(api); err != nil {
+			return err
+		}
+
+		size := settings.batchSize
+		if size > room {
+			size = room
+		}
+		if size > len(toReplace) {
+			size = len(toReplace)
+		}
+		batch := toReplace[:size]
+		toReplace = toReplace[size:]
+
+		if err := drainAndWait(api, batch); err != nil {
+			return err
+		}
+		var ec2InstanceIDs []*string
+		for _, containerInstance := range batch {
+			ec2InstanceIDs = append(ec2InstanceIDs, containerInstance.Ec2InstanceId)
+			terminated[aws.StringValue(containerInstance.ContainerInstanceArn)] = true
+		}
+		if err := api.TerminateInstances(ec2InstanceIDs); err != nil {
+			return err
+		}
+		replaced += size
+		logrus.Infof("Terminated %s; %d container instances replaced, %d remaining",
+			strings.Join(aws.StringValueSlice(ec2InstanceIDs), ", "), replaced, len(toReplace))
+	}
+
+	logrus.Info("Waiting for the last replacement container instances to register...")
+	err = waitFor("replacement container instances to register", func() (bool, error) {
+		count, err := unavailable()
+		return count <= 0, err
+	})
+	if err != nil {
+		return err
+	}
+	return waitForStableServices(api)
+}
@@ -0,0 +1,525 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	sdkCFN "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const (
+	oldAMIID = "ami-old"
+	newAMIID = "ami-new"
+)
+
+// fakeCluster stands in for ECS and the Auto Scaling group of a cluster. The
+// service scheduler moves the tasks of draining instances to the other active
+// instances, and the Auto Scaling group launches a replacement for each
+// terminated instance, which registers after launchPolls polls of the
+// container instances.
+type fakeCluster struct {
+	instances    []*ecs.ContainerInstance
+	tasks        map[string][]*ecs.Task
+	services     []*ecs.Service
+	amiID        string
+	instanceType string
+	launchPolls  int
+	launching    []int
+	launched     int
+
+	// baseline is the number of instances the Auto Scaling group maintains
+	baseline       int
+	maxUnavailable int
+	drained        []string
+	terminated     []string
+}
+
+func newFakeCluster(count int, amiID string) *fakeCluster {
+	fake := &fakeCluster{
+		tasks:        make(map[string][]*ecs.Task),
+		amiID:        amiID,
+		instanceType: "t3.medium",
+		services: []*ecs.Service{
+			{
+				ServiceName:  aws.String("web"),
+				Status:       aws.String("ACTIVE"),
+				DesiredCount: aws.Int64(int64(count)),
+				RunningCount: aws.Int64(int64(count)),
+				Deployments:  []*ecs.Deployment{{Status: aws.String("PRIMARY")}},
+			},
+		},
+	}
+	for i := 0; i < count; i++ {
+		arn := fake.launch()
+		fake.tasks[arn] = []*ecs.Task{{Group: aws.String("service:web"), LastStatus: aws.String("RUNNING")}}
+	}
+	fake.baseline = count
+	return fake
+}
+
+func (f *fakeCluster) launch() string {
+	f.launched++
+	arn := fmt.Sprintf("arn:aws:ecs:us-west-1:123456789012:container-instance/%s/ci-%d", clusterName, f.launched)
+	f.instances = append(f.instances, &ecs.ContainerInstance{
+		ContainerInstanceArn: aws.String(arn),
+		Ec2InstanceId:        aws.String(fmt.Sprintf("i-%d", f.launched)),
+		Status:               aws.String(ecs.ContainerInstanceStatusActive),
+		AgentConnected:       aws.Bool(true),
+		Attributes: []*ecs.Attribute{
+			{Name: aws.String(amiIDAttribute), Value: aws.String(f.amiID)},
+			{Name: aws.String(instanceTypeAttribute), Value: aws.String(f.instanceType)},
+		},
+	})
+	return arn
+}
+
+func (f *fakeCluster) instance(arn string) *ecs.ContainerInstance {
+	for _, containerInstance := range f.instances {
+		if aws.StringValue(containerInstance.ContainerInstanceArn) == arn {
+			return containerInstance
+		}
+	}
+	return nil
+}
+
+func (f *fakeCluster) GetContainerInstances() ([]*ecs.ContainerInstance, error) {
+	var launching []int
+	for _, polls := range f.launching {
+		if polls <= 0 {
+			f.launch()
+		} else {
+			launching = append(launching, polls-1)
+		}
+	}
+	f.launching = launching
+
+	healthy := 0
+	for _, containerInstance := range f.instances {
+		if isHealthyInstance(containerInstance) {
+			healthy++
+		}
+	}
+	if unavailable := f.baseline - healthy; unavailable > f.maxUnavailable {
+		f.maxUnavailable = unavailable
+	}
+	return f.instances, nil
+}
+
+func (f *fakeCluster) DrainContainerInstances(containerInstanceArns []*string) error {
+	for _, arn := range aws.StringValueSlice(containerInstanceArns) {
+		f.instance(arn).Status = aws.String(ecs.ContainerInstanceStatusDraining)
+		f.drained = append(f.drained, aws.StringValue(f.instance(arn).Ec2InstanceId))
+
+		var remaining []*ecs.Task
+		for _, task := range f.tasks[arn] {
+			if aws.StringValue(task.Group) == "service:web" {
+				continue
+			}
+			remaining = append(remaining, task)
+		}
+		f.tasks[arn] = remaining
+	}
+	return nil
+}
+
+func (f *fakeCluster) GetTasksPages(listTasksInput *ecs.ListTasksInput, fn ecsclient.ProcessTasksAction) error {
+	return fn(f.tasks[aws.StringValue(listTasksInput.ContainerInstance)])
+}
+
+func (f *fakeCluster) GetServices() ([]*ecs.Service, error) {
+	return f.services, nil
+}
+
+func (f *fakeCluster) TerminateInstances(ec2InstanceIds []*string) error {
+	for _, id := range aws.StringValueSlice(ec2InstanceIds) {
+		var instances []*ecs.ContainerInstance
+		for _, containerInstance := range f.instances {
+			if aws.StringValue(containerInstance.Ec2InstanceId) != id {
+				instances = append(instances, containerInstance)
+			}
+		}
+		f.instances = instances
+		f.terminated = append(f.terminated, id)
+		f.launching = append(f.launching, f.launchPolls)
+	}
+	return nil
+}
+
+func setInstancePolling(t *testing.T) {
+	pollInterval, waitTimeout := instancePollInterval, instanceWaitTimeout
+	instancePollInterval, instanceWaitTimeout = time.Millisecond, time.Second
+	t.Cleanup(func() {
+		instancePollInterval, instanceWaitTimeout = pollInterval, waitTimeout
+	})
+}
+
+func upgradeContext(t *testing.T, values map[string]string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-upgrade", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.ImageIdFlag, "", "")
+	flagSet.String(flags.AMIFamilyFlag, "", "")
+	flagSet.Bool(flags.ChangeAMIFamilyFlag, false, "")
+	flagSet.String(flags.InstanceTypeFlag, "", "")
+	flagSet.Int(flags.BatchSizeFlag, 1, "")
+	flagSet.Int(flags.MaxUnavailableFlag, 0, "")
+	for name, value := range values {
+		assert.NoError(t, flagSet.Set(name, value))
+	}
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func upgradeStackParameters(values map[string]string) []*sdkCFN.Parameter {
+	params := []*sdkCFN.Parameter{
+		{ParameterKey: aws.String(ParameterKeyCluster), ParameterValue: aws.String(clusterName)},
+		{ParameterKey: aws.String(ParameterKeyAmiId), ParameterValue: aws.String(oldAMIID)},
+		{ParameterKey: aws.String(ParameterKeyInstanceType), ParameterValue: aws.String("t3.medium")},
+	}
+	for key, value := range values {
+		params = append(params, &sdkCFN.Parameter{ParameterKey: aws.String(key), ParameterValue: aws.String(value)})
+	}
+	return params
+}
+
+func TestUpgradeCluster(t *testing.T) {
+	defer os.Clearenv()
+	setInstancePolling(t)
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	fake := newFakeCluster(5, oldAMIID)
+	fake.instances[2].Attributes[0].Value = aws.String(newAMIID)
+	fake.launchPolls = 2
+
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockCloudformation.EXPECT().GetStackParameters(stackName).Return(upgradeStackParameters(nil), nil)
+	mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "t3.medium").Return(&amimetadata.AMIMetadata{ImageID: newAMIID}, nil)
+	mockCloudformation.EXPECT().UpdateStack(stackName, gomock.Any()).Do(func(_ string, cfnParams *cloudformation.CfnStackParams) {
+		param, err := cfnParams.GetParameter(ParameterKeyAmiId)
+		assert.NoError(t, err, "Expected AMI ID to be updated")
+		assert.Equal(t, newAMIID, aws.StringValue(param.ParameterValue))
+		param, err = cfnParams.GetParameter(ParameterKeyCluster)
+		assert.NoError(t, err, "Expected existing parameters to be kept")
+		assert.True(t, aws.BoolValue(param.UsePreviousValue))
+	}).Return("", nil)
+	mockCloudformation.EXPECT().WaitUntilUpdateComplete(stackName).Return(nil).Do(func(_ string) {
+		fake.amiID = newAMIID
+	})
+
+	context := upgradeContext(t, map[string]string{flags.BatchSizeFlag: "2"})
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = upgradeCluster(context, awsClients, fake, commandConfig)
+	assert.NoError(t, err, "Unexpected error upgrading cluster")
+
+	assert.Equal(t, []string{"i-1", "i-2", "i-4", "i-5"}, fake.terminated)
+	assert.Equal(t, fake.terminated, fake.drained)
+	assert.Equal(t, 2, fake.maxUnavailable, "Expected at most --batch-size instances to be unavailable")
+	assert.Len(t, fake.instances, 5)
+	for _, containerInstance := range fake.instances {
+		assert.Equal(t, newAMIID, containerInstanceAttribute(containerInstance, amiIDAttribute))
+		assert.True(t, isHealthyInstance(containerInstance))
+	}
+}
+
+func TestUpgradeClusterWithMaxUnavailable(t *testing.T) {
+	defer os.Clearenv()
+	setInstancePolling(t)
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	fake := newFakeCluster(4, oldAMIID)
+	fake.amiID = newAMIID
+	fake.launchPolls = 3
+
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockCloudformation.EXPECT().GetStackParameters(stackName).Return(upgradeStackParameters(nil), nil)
+	mockCloudformation.EXPECT().UpdateStack(stackName, gomock.Any()).Return("", nil)
+	mockCloudformation.EXPECT().WaitUntilUpdateComplete(stackName).Return(nil)
+
+	context := upgradeContext(t, map[string]string{
+		flags.ImageIdFlag:        newAMIID,
+		flags.BatchSizeFlag:      "1",
+		flags.MaxUnavailableFlag: "3",
+	})
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = upgradeCluster(context, awsClients, fake, commandConfig)
+	assert.NoError(t, err, "Unexpected error upgrading cluster")
+
+	assert.Len(t, fake.terminated, 4)
+	assert.Equal(t, 3, fake.maxUnavailable, "Expected batches to overlap up to --max-unavailable")
+	for _, containerInstance := range fake.instances {
+		assert.Equal(t, newAMIID, containerInstanceAttribute(containerInstance, amiIDAttribute))
+	}
+}
+
+func TestUpgradeClusterWithInstanceType(t *testing.T) {
+	defer os.Clearenv()
+	setInstancePolling(t)
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	fake := newFakeCluster(2, newAMIID)
+	fake.instanceType = "m5.large"
+
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockCloudformation.EXPECT().GetStackParameters(stackName).Return(upgradeStackParameters(map[string]string{
+		ParameterKeyAmiFamily: amimetadata.AMIFamilyBottlerocket,
+	}), nil)
+	mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyBottlerocket, "m5.large").Return(&amimetadata.AMIMetadata{ImageID: newAMIID}, nil)
+	mockCloudformation.EXPECT().UpdateStack(stackName, gomock.Any()).Do(func(_ string, cfnParams *cloudformation.CfnStackParams) {
+		param, err := cfnParams.GetParameter(ParameterKeyInstanceType)
+		assert.NoError(t, err, "Expected instance type to be updated")
+		assert.Equal(t, "m5.large", aws.StringValue(param.ParameterValue))
+	}).Return("", nil)
+	mockCloudformation.EXPECT().WaitUntilUpdateComplete(stackName).Return(nil)
+
+	context := upgradeContext(t, map[string]string{flags.InstanceTypeFlag: "m5.large"})
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = upgradeCluster(context, awsClients, fake, commandConfig)
+	assert.NoError(t, err, "Unexpected error upgrading cluster")
+	assert.Equal(t, []string{"i-1", "i-2"}, fake.terminated, "Expected instances of the previous instance type to be replaced")
+}
+
+func TestUpgradeClusterKeepsAMIFamily(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	fake := newFakeCluster(2, oldAMIID)
+
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockCloudformation.EXPECT().GetStackParameters(stackName).Return(upgradeStackParameters(map[string]string{
+		ParameterKeyAmiFamily: amimetadata.AMIFamilyAmazonLinux2023,
+	}), nil)
+	mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2023, "t3.medium").Return(&amimetadata.AMIMetadata{ImageID: oldAMIID}, nil)
+
+	context := upgradeContext(t, nil)
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = upgradeCluster(context, awsClients, fake, commandConfig)
+	assert.NoError(t, err, "Unexpected error upgrading cluster")
+	assert.Empty(t, fake.terminated)
+}
+
+func TestUpgradeClusterChangingAMIFamily(t *testing.T) {
+	defer os.Clearenv()
+	setInstancePolling(t)
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	fake := newFakeCluster(1, oldAMIID)
+
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockCloudformation.EXPECT().GetStackParameters(stackName).Return(upgradeStackParameters(map[string]string{
+		ParameterKeyAmiFamily: amimetadata.AMIFamilyAmazonLinux2,
+	}), nil)
+	mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2023, "t3.medium").Return(&amimetadata.AMIMetadata{ImageID: newAMIID}, nil)
+	mockCloudformation.EXPECT().UpdateStack(stackName, gomock.Any()).Do(func(_ string, cfnParams *cloudformation.CfnStackParams) {
+		param, err := cfnParams.GetParameter(ParameterKeyAmiFamily)
+		assert.NoError(t, err, "Expected AMI family to be updated")
+		assert.Equal(t, amimetadata.AMIFamilyAmazonLinux2023, aws.StringValue(param.ParameterValue))
+		assert.False(t, aws.BoolValue(param.UsePreviousValue))
+	}).Return("", nil)
+	mockCloudformation.EXPECT().WaitUntilUpdateComplete(stackName).Return(nil).Do(func(_ string) {
+		fake.amiID = newAMIID
+	})
+
+	context := upgradeContext(t, map[string]string{
+		flags.AMIFamilyFlag:       amimetadata.AMIFamilyAmazonLinux2023,
+		flags.ChangeAMIFamilyFlag: "true",
+	})
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = upgradeCluster(context, awsClients, fake, commandConfig)
+	assert.NoError(t, err, "Unexpected error upgrading cluster")
+	assert.Equal(t, []string{"i-1"}, fake.terminated)
+}
+
+func TestUpgradeClusterUpToDate(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	fake := newFakeCluster(2, oldAMIID)
+
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockCloudformation.EXPECT().GetStackParameters(stackName).Return(upgradeStackParameters(nil), nil)
+	mockSSM.EXPECT().GetRecommendedECSAMI(amimetadata.AMIFamilyAmazonLinux2, "t3.medium").Return(&amimetadata.AMIMetadata{ImageID: oldAMIID}, nil)
+
+	context := upgradeContext(t, nil)
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = upgradeCluster(context, awsClients, fake, commandConfig)
+	assert.NoError(t, err, "Unexpected error upgrading cluster")
+	assert.Empty(t, fake.drained)
+	assert.Empty(t, fake.terminated)
+}
+
+func TestUpgradeClusterErrorCases(t *testing.T) {
+	testCases := map[string]struct {
+		flags      map[string]string
+		parameters map[string]string
+	}{
+		"without capability-iam": {
+			flags: map[string]string{flags.CapabilityIAMFlag: "false"},
+		},
+		"batch size of 0": {
+			flags: map[string]string{flags.BatchSizeFlag: "0"},
+		},
+		"max unavailable below batch size": {
+			flags: map[string]string{flags.BatchSizeFlag: "3", flags.MaxUnavailableFlag: "2"},
+		},
+		"fargate cluster": {
+			parameters: map[string]string{ParameterKeyIsFargate: "true"},
+		},
+		"instance type of mixed instances cluster": {
+			flags:      map[string]string{flags.InstanceTypeFlag: "m5.large"},
+			parameters: map[string]string{ParameterKeyInstanceTypes: "t3.medium,t3a.medium"},
+		},
+		"image id and ami family": {
+			flags: map[string]string{flags.ImageIdFlag: newAMIID, flags.AMIFamilyFlag: amimetadata.AMIFamilyAmazonLinux2023},
+		},
+		"ami family without change-ami-family": {
+			flags:      map[string]string{flags.AMIFamilyFlag: amimetadata.AMIFamilyAmazonLinux2},
+			parameters: map[string]string{ParameterKeyAmiFamily: amimetadata.AMIFamilyAmazonLinux2023},
+		},
+		"ami family from bottlerocket": {
+			flags:      map[string]string{flags.AMIFamilyFlag: amimetadata.AMIFamilyAmazonLinux2023, flags.ChangeAMIFamilyFlag: "true"},
+			parameters: map[string]string{ParameterKeyAmiFamily: amimetadata.AMIFamilyBottlerocket},
+		},
+		"ami family to bottlerocket": {
+			flags:      map[string]string{flags.AMIFamilyFlag: amimetadata.AMIFamilyBottlerocket, flags.ChangeAMIFamilyFlag: "true"},
+			parameters: map[string]string{ParameterKeyAmiFamily: amimetadata.AMIFamilyAmazonLinux2},
+		},
+		"ami family of cluster which does not record it": {
+			flags: map[string]string{flags.AMIFamilyFlag: amimetadata.AMIFamilyAmazonLinux2023, flags.ChangeAMIFamilyFlag: "true"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer os.Clearenv()
+			mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+			awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+			fake := newFakeCluster(2, oldAMIID)
+
+			mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil).AnyTimes()
+			mockCloudformation.EXPECT().GetStackParameters(stackName).Return(upgradeStackParameters(tc.parameters), nil).AnyTimes()
+
+			context := upgradeContext(t, tc.flags)
+			commandConfig, err := newCommandConfig(context, newMockReadWriter())
+			assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+			err = upgradeCluster(context, awsClients, fake, commandConfig)
+			assert.Error(t, err, "Expected error upgrading cluster")
+			assert.Empty(t, fake.terminated)
+		})
+	}
+}
+
+func TestDrainInstances(t *testing.T) {
+	defer os.Clearenv()
+	setInstancePolling(t)
+	setupTest(t)
+	fake := newFakeCluster(3, oldAMIID)
+	standalone := &ecs.Task{Group: aws.String("family:batch"), LastStatus: aws.String("RUNNING")}
+	arn := aws.StringValue(fake.instances[1].ContainerInstanceArn)
+	fake.tasks[arn] = append(fake.tasks[arn], standalone)
+
+	flagSet := flag.NewFlagSet("ecs-cli-instances-drain", 0)
+	assert.NoError(t, flagSet.Parse([]string{"i-1", "ci-2"}))
+	context := cli.NewContext(nil, flagSet, nil)
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = drainInstances(context, fake, commandConfig)
+	assert.NoError(t, err, "Unexpected error draining instances")
+
+	assert.Equal(t, []string{"i-1", "i-2"}, fake.drained)
+	assert.Equal(t, ecs.ContainerInstanceStatusDraining, aws.StringValue(fake.instances[0].Status))
+	assert.Equal(t, ecs.ContainerInstanceStatusDraining, aws.StringValue(fake.instances[1].Status))
+	assert.Equal(t, ecs.ContainerInstanceStatusActive, aws.StringValue(fake.instances[2].Status))
+	assert.Equal(t, []*ecs.Task{standalone}, fake.tasks[arn], "Expected tasks not started by a service to keep running")
+	assert.Empty(t, fake.terminated)
+}
+
+func TestDrainInstancesErrorCases(t *testing.T) {
+	defer os.Clearenv()
+	setupTest(t)
+	fake := newFakeCluster(1, oldAMIID)
+
+	flagSet := flag.NewFlagSet("ecs-cli-instances-drain", 0)
+	context := cli.NewContext(nil, flagSet, nil)
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = drainInstances(context, fake, commandConfig)
+	assert.Error(t, err, "Expected error draining without instance IDs")
+
+	assert.NoError(t, flagSet.Parse([]string{"i-404"}))
+	err = drainInstances(context, fake, commandConfig)
+	assert.Error(t, err, "Expected error draining an instance which is not in the cluster")
+	assert.Empty(t, fake.drained)
+}
+
+func TestDrainInstancesTimeout(t *testing.T) {
+	pollInterval, waitTimeout := instancePollInterval, instanceWaitTimeout
+	instancePollInterval, instanceWaitTimeout = time.Millisecond, 10*time.Millisecond
+	defer func() {
+		instancePollInterval, instanceWaitTimeout = pollInterval, waitTimeout
+	}()
+
+	fake := newFakeCluster(1, oldAMIID)
+	arn := aws.StringValue(fake.instances[0].ContainerInstanceArn)
+	drainingAPI := &stuckDrainCluster{fakeCluster: fake}
+
+	err := drainAndWait(drainingAPI, fake.instances)
+	assert.Error(t, err, "Expected drain to time out")
+	assert.Equal(t, []string{"i-1"}, fake.drained)
+	assert.NotEmpty(t, fake.tasks[arn])
+}
+
+// stuckDrainCluster is a cluster whose service scheduler can not place the tasks of draining instances.
+type stuckDrainCluster struct {
+	*fakeCluster
+}
+
+func (s *stuckDrainCluster) DrainContainerInstances(containerInstanceArns []*string) error {
+	for _, arn := range aws.StringValueSlice(containerInstanceArns) {
+		s.instance(arn).Status = aws.String(ecs.ContainerInstanceStatusDraining)
+		s.drained = append(s.drained, aws.StringValue(s.instance(arn).Ec2InstanceId))
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package userdata
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Bottlerocket is configured with TOML settings instead of shell scripts.
+// See: https://github.com/bottlerocket-os/bottlerocket#settings
+var bottlerocketECSTable = []string{"settings", "ecs"}
+
+// tomlTable is a table of a TOML document. Its values are kept as raw TOML
+// so that they are written back exactly as they were read.
+type tomlTable struct {
+	// path holds the keys of the table, which are joined by dots in its name
+	path   []string
+	name   string
+	keys   []string
+	values map[string]string
+}
+
+func (t *tomlTable) set(key, value string) {
+	if _, ok := t.values[key]; !ok {
+		t.keys = append(t.keys, key)
+	}
+	t.values[key] = value
+}
+
+// tomlDocument merges the tables of TOML documents, keeping the order in which
+// tables and keys first appear. It only understands the subset of TOML used by
+// Bottlerocket settings: tables, comments, and key/value pairs, whose values may
+// be arrays or multi-line strings. Dotted keys are moved to the table they
+// belong to, so that each table is written once, whichever form it was
+// defined with.
+type tomlDocument struct {
+	tables []*tomlTable
+	byName map[string]*tomlTable
+}
+
+func newTOMLDocument() *tomlDocument {
+	return &tomlDocument{
+		byName: make(map[string]*tomlTable),
+	}
+}
+
+func (d *tomlDocument) table(path []string) *tomlTable {
+	name := strings.Join(path, ".")
+	if t, ok := d.byName[name]; ok {
+		return t
+	}
+	t := &tomlTable{
+		path:   path,
+		name:   name,
+		values: make(map[string]string),
+	}
+	d.tables = append(d.tables, t)
+	d.byName[name] = t
+	return t
+}
+
+// defineTable returns the table with the given path, after checking that
+// neither the path nor any of its parents is a key with a value.
+func (d *tomlDocument) defineTable(path []string) (*tomlTable, error) {
+	for i := range path {
+		if parent, ok := d.byName[strings.Join(path[:i], ".")]; ok {
+			if _, ok := parent.values[path[i]]; ok {
+				return nil, fmt.Errorf("%s is both a key and a table", strings.Join(path[:i+1], "."))
+			}
+		}
+	}
+	return d.table(path), nil
+}
+
+// set sets the value of the key at the given path, relative to the root of
+// the document.
+func (d *tomlDocument) set(path []string, value string) error {
+	tablePath, key := path[:len(path)-1], path[len(path)-1]
+	for _, t := range d.tables {
+		if isTOMLPathPrefix(path, t.path) {
+			return fmt.Errorf("%s is both a key and a table", strings.Join(path, "."))
+		}
+	}
+	t, err := d.defineTable(tablePath)
+	if err != nil {
+		return err
+	}
+	t.set(key, value)
+	return nil
+}
+
+// merge adds the tables and keys of data to the document. Values in data
+// replace the values of the same keys already in the document.
+func (d *tomlDocument) merge(data string) error {
+	lines := strings.Split(unixifyLineEndings(data), "\n")
+	var current []string
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "[["):
+			return fmt.Errorf("line %d: arrays of tables are not supported", i+1)
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			path, err := splitTOMLKey(line[1 : len(line)-1])
+			if err != nil {
+				return fmt.Errorf("line %d: %v", i+1, err)
+			}
+			if _, err := d.defineTable(path); err != nil {
+				return fmt.Errorf("line %d: %v", i+1, err)
+			}
+			current = path
+		case strings.Contains(line, "="):
+			rawKey, value := splitTOMLKeyValue(line)
+			if strings.TrimSpace(rawKey) == "" || value == "" {
+				return fmt.Errorf("line %d: invalid key/value pair %q", i+1, line)
+			}
+			key, err := splitTOMLKey(rawKey)
+			if err != nil {
+				return fmt.Errorf("line %d: %v", i+1, err)
+			}
+			// values may continue on the following lines
+			start := i
+			for !isCompleteTOMLValue(value) {
+				i++
+				if i == len(lines) {
+					return fmt.Errorf("line %d: unterminated value for key %s", start+1, rawKey)
+				}
+				value += "\n" + lines[i]
+			}
+			path := append(append([]string{}, current...), key...)
+			if err := d.set(path, value); err != nil {
+				return fmt.Errorf("line %d: %v", i+1, err)
+			}
+		default:
+			return fmt.Errorf("line %d: expected a table or a key/value pair, got %q", i+1, line)
+		}
+	}
+	return nil
+}
+
+// isTOMLPathPrefix returns whether path is the path of table or of one of its parents.
+func isTOMLPathPrefix(path, table []string) bool {
+	if len(path) > len(table) {
+		return false
+	}
+	for i := range path {
+		if path[i] != table[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// closingQuote returns the index of the quote which closes the quoted string
+// at the start of s, or -1. Basic strings, in double quotes, may escape quotes.
+func closingQuote(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[0] == '"' && s[i] == '\\':
+			i++
+		case s[i] == s[0]:
+			return i
+		}
+	}
+	return -1
+}
+
+// splitTOMLKeyValue splits a key/value pair at the first equals sign which is
+// not in a quoted key.
+func splitTOMLKeyValue(line string) (string, string) {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '"', '\'':
+			end := closingQuote(line[i:])
+			if end == -1 {
+				return line, ""
+			}
+			i += end
+		case '=':
+			return line[:i], strings.TrimSpace(line[i+1:])
+		}
+	}
+	return line, ""
+}
+
+var bareTOMLKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// splitTOMLKey splits a dotted key, or the name of a table, into its keys.
+// Quoted keys are kept quoted unless they are valid bare keys, so that the
+// same key is written the same way whichever form it was given in.
+func splitTOMLKey(dotted string) ([]string, error) {
+	var path []string
+	rest := strings.TrimSpace(dotted)
+	for {
+		var key string
+		switch {
+		case strings.HasPrefix(rest, `"`), strings.HasPrefix(rest, "'"):
+			end := closingQuote(rest)
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated quoted key in %q", dotted)
+			}
+			key = rest[:end+1]
+		default:
+			end := strings.IndexAny(rest, ". \t")
+			if end == -1 {
+				end = len(rest)
+			}
+			key = rest[:end]
+			if !bareTOMLKey.MatchString(key) {
+				return nil, fmt.Errorf("invalid key %q", dotted)
+			}
+		}
+		rest = strings.TrimSpace(rest[len(key):])
+		if (key[0] == '"' || key[0] == '\'') && bareTOMLKey.MatchString(key[1:len(key)-1]) {
+			key = key[1 : len(key)-1]
+		}
+		path = append(path, key)
+		if rest == "" {
+			return path, nil
+		}
+		if rest[0] != '.' {
+			return nil, fmt.Errorf("invalid key %q", dotted)
+		}
+		rest = strings.TrimSpace(rest[1:])
+	}
+}
+
+// isCompleteTOMLValue returns false if value is the start of a multi-line
+// string or array.
+func isCompleteTOMLValue(value string) bool {
+	for _, quote := range []string{`"""`, `'''`} {
+		if strings.HasPrefix(value, quote) {
+			return strings.Count(value, quote) >= 2
+		}
+	}
+	if strings.HasPrefix(value, "[") {
+		return strings.Count(value, "[") <= strings.Count(value, "]")
+	}
+	return true
+}
+
+// String writes the keys of the root table first, since keys after a table
+// header belong to that table.
+func (d *tomlDocument) String() string {
+	var buf bytes.Buffer
+	tables := d.tables
+	if root, ok := d.byName[""]; ok {
+		tables = []*tomlTable{root}
+		for _, t := range d.tables {
+			if t != root {
+				tables = append(tables, t)
+			}
+		}
+	}
+	for _, t := range tables {
+		if len(t.keys) == 0 {
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteString("\n")
+		}
+		if t.name != "" {
+			fmt.Fprintf(&buf, "[%s]\n", t.name)
+		}
+		for _, key := range t.keys {
+			fmt.Fprintf(&buf, "%s = %s\n", key, t.values[key])
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,218 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package userdata
+
+import (
+	"bytes"
+	"mime/multipart"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+var extraBottlerocketSettings = `# Settings for Bottlerocket
+[settings.ecs]
+cluster = "other-cluster"
+allow-privileged-containers = true
+
+[settings.host-containers.admin]
+enabled = true
+
+[settings.kernel.sysctl]
+"net.core.somaxconn" = "1024"
+"vm.max_map_count" = [
+  "262144",
+]
+`
+
+func newBottlerocketBuilderInTest(tags []*ecs.Tag) *Builder {
+	buf := new(bytes.Buffer)
+	builder := newBuilderInTest(buf, multipart.NewWriter(buf), tags)
+	builder.UseBottlerocketSettings()
+	return builder
+}
+
+func TestBuildBottlerocketSettings(t *testing.T) {
+	tags := []*ecs.Tag{
+		{
+			Key:   aws.String("key"),
+			Value: aws.String("value"),
+		},
+	}
+	builder := newBottlerocketBuilderInTest(tags)
+	builder.EnableSpotInstanceDraining()
+
+	actual, err := builder.Build()
+	assert.NoError(t, err, "Unexpected error calling Build()")
+
+	expected := `[settings.ecs]
+cluster = "cluster"
+enable-spot-instance-draining = true
+`
+	assert.Equal(t, expected, actual)
+}
+
+func TestBuildBottlerocketSettingsWithExtraSettings(t *testing.T) {
+	builder := newBottlerocketBuilderInTest(nil)
+	file := writeTempFile(t, "settings.toml", extraBottlerocketSettings)
+	defer os.Remove(file)
+
+	err := builder.AddFile(file)
+	assert.NoError(t, err, "Unexpected error calling AddFile()")
+	actual, err := builder.Build()
+	assert.NoError(t, err, "Unexpected error calling Build()")
+
+	expected := `[settings.ecs]
+cluster = "other-cluster"
+allow-privileged-containers = true
+
+[settings.host-containers.admin]
+enabled = true
+
+[settings.kernel.sysctl]
+"net.core.somaxconn" = "1024"
+"vm.max_map_count" = [
+  "262144",
+]
+`
+	assert.Equal(t, expected, actual)
+}
+
+func TestBuildBottlerocketSettingsWithDottedKeys(t *testing.T) {
+	testCases := map[string]string{
+		"before any table": `settings.ecs.cluster = "other-cluster"
+settings.host-containers.admin.enabled = true
+`,
+		"in a parent table": `[settings]
+ecs.cluster = "other-cluster"
+host-containers.admin.enabled = true
+`,
+	}
+	expected := `[settings.ecs]
+cluster = "other-cluster"
+
+[settings.host-containers.admin]
+enabled = true
+`
+	for name, settings := range testCases {
+		t.Run(name, func(t *testing.T) {
+			builder := newBottlerocketBuilderInTest(nil)
+			file := writeTempFile(t, "settings.toml", settings)
+			defer os.Remove(file)
+
+			err := builder.AddFile(file)
+			assert.NoError(t, err, "Unexpected error calling AddFile()")
+			actual, err := builder.Build()
+			assert.NoError(t, err, "Unexpected error calling Build()")
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
+
+func TestBuildBottlerocketSettingsWithConflictingSettings(t *testing.T) {
+	builder := newBottlerocketBuilderInTest(nil)
+	file := writeTempFile(t, "settings.toml", "settings.ecs = \"other-cluster\"\n")
+	defer os.Remove(file)
+
+	err := builder.AddFile(file)
+	assert.NoError(t, err, "Unexpected error calling AddFile()")
+	_, err = builder.Build()
+	assert.Error(t, err, "Expected error when settings.ecs is not a table")
+}
+
+func TestBuildBottlerocketSettingsWithShellScript(t *testing.T) {
+	builder := newBottlerocketBuilderInTest(nil)
+	file := writeTempFile(t, "script.sh", extraUserDataShellScript)
+	defer os.Remove(file)
+
+	err := builder.AddFile(file)
+	assert.Error(t, err, "Expected error adding a shell script to Bottlerocket settings")
+}
+
+func TestTOMLDocumentMerge(t *testing.T) {
+	testCases := map[string]struct {
+		documents []string
+		expected  string
+		err       bool
+	}{
+		"later values take precedence": {
+			documents: []string{"[a]\nx = 1\ny = 2\n", "[a]\ny = 3\n[b]\nz = 'z'\n"},
+			expected:  "[a]\nx = 1\ny = 3\n\n[b]\nz = 'z'\n",
+		},
+		"dotted keys before any table": {
+			documents: []string{"settings.ecs.cluster = \"c\"\n"},
+			expected:  "[settings.ecs]\ncluster = \"c\"\n",
+		},
+		"dotted keys in a table": {
+			documents: []string{"[settings.ecs]\ncluster = \"c\"\n", "[settings]\necs.cluster = \"d\"\nmotd = \"hi\"\n"},
+			expected:  "[settings.ecs]\ncluster = \"d\"\n\n[settings]\nmotd = \"hi\"\n",
+		},
+		"quoted keys": {
+			documents: []string{"[a . b]\nc = 1\n", "\"a\".'b'.d = 2\n[a.b]\n\"x.y\" = 3\n"},
+			expected:  "[a.b]\nc = 1\nd = 2\n\"x.y\" = 3\n",
+		},
+		"root keys first": {
+			documents: []string{"[a]\nx = 1\n", "y = 2\n"},
+			expected:  "y = 2\n\n[a]\nx = 1\n",
+		},
+		"key redefined as a table": {
+			documents: []string{"[a]\nb = 1\n", "[a.b]\nc = 2\n"},
+			err:       true,
+		},
+		"table redefined as a key": {
+			documents: []string{"[a.b]\nc = 2\n", "a.b = 1\n"},
+			err:       true,
+		},
+		"invalid keys": {
+			documents: []string{"[a]\nb c = 1\n"},
+			err:       true,
+		},
+		"multi-line strings": {
+			documents: []string{"[a]\nmotd = \"\"\"\nhello\n\"\"\"\n"},
+			expected:  "[a]\nmotd = \"\"\"\nhello\n\"\"\"\n",
+		},
+		"unterminated arrays": {
+			documents: []string{"[a]\nx = [\n1,\n"},
+			err:       true,
+		},
+		"arrays of tables": {
+			documents: []string{"[[a]]\nx = 1\n"},
+			err:       true,
+		},
+		"invalid lines": {
+			documents: []string{"[a]\nnot toml\n"},
+			err:       true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			doc := newTOMLDocument()
+			var err error
+			for _, data := range tc.documents {
+				if err = doc.merge(data); err != nil {
+					break
+				}
+			}
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, doc.String())
+		})
+	}
+}
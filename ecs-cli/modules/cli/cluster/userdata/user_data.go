@@ -27,11 +27,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
 )
 
 // UserDataBuilder contains functionality to create user data scripts for Container Instances
 type UserDataBuilder interface {
 	AddFile(fileName string) error
+	EnableSpotInstanceDraining()
+	UseBottlerocketSettings()
 	Build() (string, error)
 }
 
@@ -41,6 +44,12 @@ type Builder struct {
 	clusterName string
 	userdata    *bytes.Buffer
 	tags        []*ecs.Tag
+	spot        bool
+
+	// bottlerocket is set when the user data is built as Bottlerocket TOML
+	// settings, which merge the settings from the files added to the builder.
+	bottlerocket bool
+	settings     *tomlDocument
 }
 
 // NewBuilder creates a Builder object for a given clusterName
@@ -66,6 +75,13 @@ func (b *Builder) AddFile(fileName string) error {
 	}
 	extraUserData := string(data)
 
+	if b.bottlerocket {
+		if err = b.settings.merge(extraUserData); err != nil {
+			return errors.Wrapf(err, "%s is not valid Bottlerocket TOML settings", fileName)
+		}
+		return nil
+	}
+
 	if ok, headers, body := isMultipart(extraUserData); ok { // extraUserData is multipart
 		if err = b.processExistingMultipart(headers, body); err != nil {
 			return err
@@ -78,9 +94,25 @@ func (b *Builder) AddFile(fileName string) error {
 	return nil
 }
 
+// EnableSpotInstanceDraining configures the ECS agent to drain Spot instances
+// when they receive an interruption notice
+func (b *Builder) EnableSpotInstanceDraining() {
+	b.spot = true
+}
+
+// UseBottlerocketSettings builds the userdata as TOML settings for Bottlerocket
+// instead of a shell script. It must be called before files are added.
+func (b *Builder) UseBottlerocketSettings() {
+	b.bottlerocket = true
+	b.settings = newTOMLDocument()
+}
+
 // Build the userdata for the given cluster
 // Build() is not idempotent and can only be called once
 func (b *Builder) Build() (string, error) {
+	if b.bottlerocket {
+		return b.buildBottlerocketSettings()
+	}
 	// add user data for joining the ECS Cluster
 	if err := b.writeClusterUserDataMimePart(); err != nil {
 		return "", err
@@ -162,9 +194,36 @@ echo ECS_CLUSTER=%s >> /etc/ecs/ecs.config
 		}
 		joinClusterUserData += fmt.Sprintf("echo 'ECS_CONTAINER_INSTANCE_TAGS=%s' >> /etc/ecs/ecs.config", string(bits))
 	}
+	if b.spot {
+		if len(b.tags) > 0 {
+			joinClusterUserData += "\n"
+		}
+		joinClusterUserData += "echo ECS_ENABLE_SPOT_INSTANCE_DRAINING=true >> /etc/ecs/ecs.config\n"
+	}
 	return fmt.Sprintf(joinClusterUserData, b.clusterName), nil
 }
 
+// buildBottlerocketSettings returns the settings to join the ECS cluster merged
+// with the settings from the added files, which take precedence. Container
+// instance tags are not configurable on Bottlerocket, so they are not set.
+func (b *Builder) buildBottlerocketSettings() (string, error) {
+	settings := newTOMLDocument()
+	ecsSettings := settings.table(bottlerocketECSTable)
+	ecsSettings.set("cluster", fmt.Sprintf("%q", b.clusterName))
+	if b.spot {
+		ecsSettings.set("enable-spot-instance-draining", "true")
+	}
+	for _, t := range b.settings.tables {
+		for _, key := range t.keys {
+			path := append(append([]string{}, t.path...), key)
+			if err := settings.set(path, t.values[key]); err != nil {
+				return "", errors.Wrap(err, "Error merging Bottlerocket settings")
+			}
+		}
+	}
+	return settings.String(), nil
+}
+
 func convertTags(tags []*ecs.Tag) map[string]string {
 	converted := make(map[string]string)
 	for _, tag := range tags {
@@ -226,6 +226,42 @@ echo 'ECS_CONTAINER_INSTANCE_TAGS={"mitchell":"webb"}' >> /etc/ecs/ecs.config
 	assert.Equal(t, expected, actual, "Expected resulting mime multipart archive to match")
 }
 
+func TestBuildUserDataWithSpotInstanceDraining(t *testing.T) {
+	var expectedUserData = `Content-Type: multipart/mixed; boundary="========multipart-boundary=="
+MIME-Version: 1.0
+
+--========multipart-boundary==
+Content-Type: text/text/x-shellscript; charset="utf-8"
+Mime-Version: 1.0
+
+
+#!/bin/bash
+echo ECS_CLUSTER=cluster >> /etc/ecs/ecs.config
+echo 'ECS_CONTAINER_INSTANCE_TAGS={"mitchell":"webb"}' >> /etc/ecs/ecs.config
+echo ECS_ENABLE_SPOT_INSTANCE_DRAINING=true >> /etc/ecs/ecs.config
+
+--========multipart-boundary==--
+`
+	tags := []*ecs.Tag{
+		&ecs.Tag{
+			Key:   aws.String("mitchell"),
+			Value: aws.String("webb"),
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	writer := multipart.NewWriter(buf)
+	// set the boundary between parts so that output is deterministic
+	writer.SetBoundary(testBoundary)
+	builder := newBuilderInTest(buf, writer, tags)
+	builder.EnableSpotInstanceDraining()
+
+	actual, err := builder.Build()
+	assert.NoError(t, err, "Unexpected error calling Build()")
+	expected := unixifyLineEndings(expectedUserData)
+	assert.Equal(t, expected, actual, "Expected resulting mime multipart archive to match")
+}
+
 func writeTempFile(t *testing.T, name, content string) string {
 	tmpfile, err := ioutil.TempFile("", name)
 	assert.NoError(t, err, "Could not create tempfile")
@@ -6,6 +6,7 @@ import "github.com/aws/aws-sdk-go/service/ecs"
 type ContainerConfig struct {
 	Name string
 
+	Build                 *BuildConfig
 	CapAdd                []string
 	CapDrop               []string
 	Command               []string
@@ -45,3 +46,11 @@ type ContainerConfig struct {
 	User             string
 	WorkingDirectory string
 }
+
+// BuildConfig holds the fields of a compose service's build section which are
+// used to build its image
+type BuildConfig struct {
+	Context    string
+	Dockerfile string
+	Args       map[string]string
+}
@@ -17,6 +17,8 @@ package adapter
 import (
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"sort"
@@ -46,6 +48,34 @@ const (
 	volumeFromContainerKey    = "container"
 )
 
+// ConvertToBuildConfig converts the build section of a compose service,
+// resolving a relative build context against the compose file's directory.
+// Build args without a value are taken from the environment, and skipped if
+// they are not set there. It returns nil if the service has no build section.
+func ConvertToBuildConfig(workingDir, context, dockerfile string, args map[string]*string) *BuildConfig {
+	if context == "" {
+		return nil
+	}
+	if !filepath.IsAbs(context) {
+		context = filepath.Join(workingDir, context)
+	}
+
+	buildArgs := make(map[string]string)
+	for name, value := range args {
+		if value != nil {
+			buildArgs[name] = *value
+		} else if envValue, ok := os.LookupEnv(name); ok {
+			buildArgs[name] = envValue
+		}
+	}
+
+	return &BuildConfig{
+		Context:    context,
+		Dockerfile: dockerfile,
+		Args:       buildArgs,
+	}
+}
+
 // ConvertToDevices transforms a slice of device strings into a slice of ECS Device structs
 func ConvertToDevices(cfgDevices []string) ([]*ecs.Device, error) {
 	devices := []*ecs.Device{}
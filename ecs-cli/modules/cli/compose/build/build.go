@@ -0,0 +1,125 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package build builds the images of compose services which have a build
+// section and pushes them to ECR, so that they can be used in the task definition.
+package build
+
+import (
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	dockerclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker"
+	docker "github.com/fsouza/go-dockerclient"
+	log "github.com/sirupsen/logrus"
+)
+
+// Params contains the values needed to build and push the images of a compose project
+type Params struct {
+	ProjectName      string
+	RegistryID       string
+	ContainerConfigs []adapter.ContainerConfig
+}
+
+// Clients contains the clients used to build and push images
+type Clients struct {
+	Docker dockerclient.Client
+	ECR    ecrclient.Client
+}
+
+// BuildAndPushImages builds the image of each service with a build section,
+// tags it with the git commit or a hash of its build context, and pushes it
+// to an ECR repository, which is created if it does not exist. It returns the
+// URI of the pushed image of each service, by service name.
+func BuildAndPushImages(params Params, clients Clients) (map[string]string, error) {
+	images := make(map[string]string)
+	var ecrAuth *ecrclient.Auth
+	for _, containerConfig := range params.ContainerConfigs {
+		if containerConfig.Build == nil {
+			continue
+		}
+
+		if ecrAuth == nil {
+			auth, err := clients.ECR.GetAuthorizationTokenByID(params.RegistryID)
+			if err != nil {
+				return nil, err
+			}
+			ecrAuth = auth
+		}
+
+		image, err := buildAndPushImage(params.ProjectName, containerConfig, ecrAuth, clients)
+		if err != nil {
+			return nil, err
+		}
+		images[containerConfig.Name] = image
+	}
+	return images, nil
+}
+
+func buildAndPushImage(projectName string, containerConfig adapter.ContainerConfig, ecrAuth *ecrclient.Auth, clients Clients) (string, error) {
+	build := containerConfig.Build
+	repository := repositoryName(projectName, containerConfig)
+	repositoryURI := ecrAuth.Registry + "/" + repository
+
+	tag, err := imageTag(build)
+	if err != nil {
+		return "", err
+	}
+	log.WithFields(log.Fields{
+		"service": containerConfig.Name,
+		"image":   repositoryURI + ":" + tag,
+	}).Info("Building image for service")
+
+	if err = clients.Docker.BuildImage(build.Context, build.Dockerfile, repositoryURI+":"+tag, build.Args); err != nil {
+		return "", err
+	}
+
+	if !clients.ECR.RepositoryExists(repository) {
+		if _, err = clients.ECR.CreateRepository(repository); err != nil {
+			return "", err
+		}
+	}
+
+	dockerAuth := docker.AuthConfiguration{
+		Username:      ecrAuth.Username,
+		Password:      ecrAuth.Password,
+		ServerAddress: ecrAuth.ProxyEndpoint,
+	}
+	if err = clients.Docker.PushImage(repositoryURI, tag, ecrAuth.Registry, dockerAuth); err != nil {
+		return "", err
+	}
+	return repositoryURI + ":" + tag, nil
+}
+
+// repositoryName returns the ECR repository to push the image of a service
+// to. The repository of the service's image is used if it has one, otherwise
+// the repository is named after the project and the service.
+func repositoryName(projectName string, containerConfig adapter.ContainerConfig) string {
+	image := containerConfig.Image
+	if image == "" {
+		return strings.ToLower(projectName + "/" + containerConfig.Name)
+	}
+
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	// The first part of the name is a registry if it looks like a host name
+	if parts := strings.SplitN(image, "/", 2); len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		image = parts[1]
+	}
+	return image
+}
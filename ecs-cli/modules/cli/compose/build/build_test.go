@@ -0,0 +1,147 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package build
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker/mock"
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testRegistryID = "123456789012"
+	testRegistry   = "123456789012.dkr.ecr.us-west-2.amazonaws.com"
+	testCommit     = "0123456789abcdef0123456789abcdef01234567"
+)
+
+func TestBuildAndPushImages(t *testing.T) {
+	defer stubGitCommit(testCommit, nil)()
+
+	containerConfigs := []adapter.ContainerConfig{
+		{
+			Name:  "web",
+			Build: &adapter.BuildConfig{Context: "/src/web", Dockerfile: "Dockerfile.prod", Args: map[string]string{"ENV": "prod"}},
+		},
+		{
+			Name:  "worker",
+			Image: "myorg/worker:1.0",
+			Build: &adapter.BuildConfig{Context: "/src/worker"},
+		},
+		{
+			Name:  "proxy",
+			Image: "nginx",
+		},
+	}
+	auth := &ecrclient.Auth{
+		Registry:      testRegistry,
+		ProxyEndpoint: "https://" + testRegistry,
+		Username:      "AWS",
+		Password:      "password",
+	}
+	dockerAuth := docker.AuthConfiguration{
+		Username:      "AWS",
+		Password:      "password",
+		ServerAddress: "https://" + testRegistry,
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDocker := mock_docker.NewMockClient(ctrl)
+	mockECR := mock_ecr.NewMockClient(ctrl)
+	gomock.InOrder(
+		mockECR.EXPECT().GetAuthorizationTokenByID(testRegistryID).Return(auth, nil),
+		mockDocker.EXPECT().BuildImage("/src/web", "Dockerfile.prod", testRegistry+"/hello/web:0123456789ab", map[string]string{"ENV": "prod"}).Return(nil),
+		mockECR.EXPECT().RepositoryExists("hello/web").Return(false),
+		mockECR.EXPECT().CreateRepository("hello/web").Return("hello/web", nil),
+		mockDocker.EXPECT().PushImage(testRegistry+"/hello/web", "0123456789ab", testRegistry, dockerAuth).Return(nil),
+		mockDocker.EXPECT().BuildImage("/src/worker", "", testRegistry+"/myorg/worker:0123456789ab", nil).Return(nil),
+		mockECR.EXPECT().RepositoryExists("myorg/worker").Return(true),
+		mockDocker.EXPECT().PushImage(testRegistry+"/myorg/worker", "0123456789ab", testRegistry, dockerAuth).Return(nil),
+	)
+
+	images, err := BuildAndPushImages(Params{
+		ProjectName:      "hello",
+		RegistryID:       testRegistryID,
+		ContainerConfigs: containerConfigs,
+	}, Clients{Docker: mockDocker, ECR: mockECR})
+	assert.NoError(t, err, "Unexpected error when building images")
+	assert.Equal(t, map[string]string{
+		"web":    testRegistry + "/hello/web:0123456789ab",
+		"worker": testRegistry + "/myorg/worker:0123456789ab",
+	}, images)
+}
+
+func TestBuildAndPushImages_NothingToBuild(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	images, err := BuildAndPushImages(Params{
+		ProjectName:      "hello",
+		RegistryID:       testRegistryID,
+		ContainerConfigs: []adapter.ContainerConfig{{Name: "proxy", Image: "nginx"}},
+	}, Clients{Docker: mock_docker.NewMockClient(ctrl), ECR: mock_ecr.NewMockClient(ctrl)})
+	assert.NoError(t, err, "Unexpected error when there are no images to build")
+	assert.Empty(t, images)
+}
+
+func TestBuildAndPushImages_BuildFails(t *testing.T) {
+	defer stubGitCommit(testCommit, nil)()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDocker := mock_docker.NewMockClient(ctrl)
+	mockECR := mock_ecr.NewMockClient(ctrl)
+	mockECR.EXPECT().GetAuthorizationTokenByID(testRegistryID).Return(&ecrclient.Auth{Registry: testRegistry}, nil)
+	mockDocker.EXPECT().BuildImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("something went wrong"))
+
+	_, err := BuildAndPushImages(Params{
+		ProjectName:      "hello",
+		RegistryID:       testRegistryID,
+		ContainerConfigs: []adapter.ContainerConfig{{Name: "web", Build: &adapter.BuildConfig{Context: "/src/web"}}},
+	}, Clients{Docker: mockDocker, ECR: mockECR})
+	assert.Error(t, err, "Expected error when the image can not be built")
+}
+
+func TestRepositoryName(t *testing.T) {
+	testCases := map[string]string{
+		"":                            "hello/web",
+		"web":                         "web",
+		"myorg/web:1.0":               "myorg/web",
+		"myorg/web@sha256:abcdef":     "myorg/web",
+		"localhost:5000/myorg/web:v1": "myorg/web",
+		testRegistry + "/web:latest":  "web",
+	}
+	for image, expected := range testCases {
+		t.Run(image, func(t *testing.T) {
+			assert.Equal(t, expected, repositoryName("Hello", adapter.ContainerConfig{Name: "web", Image: image}))
+		})
+	}
+}
+
+func stubGitCommit(commit string, err error) func() {
+	original := gitCommit
+	gitCommit = func(string) (string, error) {
+		return commit, err
+	}
+	return func() {
+		gitCommit = original
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package build
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/docker/docker/pkg/fileutils"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	dockerignoreFileName = ".dockerignore"
+	imageTagLength       = 12
+)
+
+// gitCommit returns the commit checked out in dir, or an error if dir is not
+// in a git work tree or has uncommitted changes
+var gitCommit = func(dir string) (string, error) {
+	status, err := exec.Command("git", "-C", dir, "status", "--porcelain", "--", ".").Output()
+	if err != nil {
+		return "", err
+	}
+	if len(strings.TrimSpace(string(status))) > 0 {
+		return "", errors.Errorf("%s has uncommitted changes", dir)
+	}
+	commit, err := exec.Command("git", "-C", dir, "rev-parse", "HEAD").Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(commit)), nil
+}
+
+// imageTag returns the tag for an image built from the build config: the git
+// commit of the build context if it is a clean git work tree, and otherwise
+// a hash of the files in the build context and the build options
+func imageTag(build *adapter.BuildConfig) (string, error) {
+	commit, err := gitCommit(build.Context)
+	if err == nil {
+		return commit[:imageTagLength], nil
+	}
+	log.WithField("context", build.Context).Debugf("Not tagging image with git commit: %v", err)
+
+	hash, err := contextHash(build)
+	if err != nil {
+		return "", err
+	}
+	return hash[:imageTagLength], nil
+}
+
+// contextHash returns a hash of the build options and the files in the build
+// context which are not excluded by its .dockerignore file
+func contextHash(build *adapter.BuildConfig) (string, error) {
+	excludes, err := readDockerignore(build.Context)
+	if err != nil {
+		return "", err
+	}
+	matcher, err := fileutils.NewPatternMatcher(excludes)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid pattern in %s", dockerignoreFileName)
+	}
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "dockerfile=%s\n", build.Dockerfile)
+	argNames := make([]string, 0, len(build.Args))
+	for name := range build.Args {
+		argNames = append(argNames, name)
+	}
+	sort.Strings(argNames)
+	for _, name := range argNames {
+		fmt.Fprintf(hash, "arg=%s=%s\n", name, build.Args[name])
+	}
+
+	err = filepath.Walk(build.Context, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(build.Context, path)
+		if err != nil || relPath == "." {
+			return err
+		}
+		excluded, err := matcher.Matches(relPath)
+		if err != nil {
+			return err
+		}
+		if excluded {
+			if info.IsDir() && !matcher.Exclusions() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		fmt.Fprintf(hash, "%s %s\n", filepath.ToSlash(relPath), info.Mode())
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.Copy(hash, file)
+		return err
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to read build context %s", build.Context)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// readDockerignore returns the patterns in the .dockerignore file of the
+// build context, if it has one
+func readDockerignore(contextDir string) ([]string, error) {
+	file, err := os.Open(filepath.Join(contextDir, dockerignoreFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var patterns []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		pattern := strings.TrimSpace(scanner.Text())
+		if pattern == "" || strings.HasPrefix(pattern, "#") {
+			continue
+		}
+		exclusion := strings.HasPrefix(pattern, "!")
+		pattern = strings.TrimPrefix(filepath.Clean(strings.TrimPrefix(pattern, "!")), "/")
+		if exclusion {
+			pattern = "!" + pattern
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, scanner.Err()
+}
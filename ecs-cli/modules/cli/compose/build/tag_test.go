@@ -0,0 +1,90 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package build
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageTag_GitCommit(t *testing.T) {
+	defer stubGitCommit(testCommit, nil)()
+
+	tag, err := imageTag(&adapter.BuildConfig{Context: "/src/web"})
+	assert.NoError(t, err, "Unexpected error when getting image tag")
+	assert.Equal(t, "0123456789ab", tag)
+}
+
+func TestImageTag_ContentHash(t *testing.T) {
+	defer stubGitCommit("", errors.New("not a git repository"))()
+
+	contextDir := writeBuildContext(t, map[string]string{
+		"Dockerfile":      "FROM scratch\n",
+		"app/main.go":     "package main\n",
+		"logs/debug.log":  "lots of logs",
+		"docs/readme.md":  "read me",
+		".dockerignore":   "# ignored files\nlogs\n/docs/*.md\n",
+		"docs/keep.md.in": "kept",
+	})
+	defer os.RemoveAll(contextDir)
+	build := &adapter.BuildConfig{Context: contextDir}
+
+	tag, err := imageTag(build)
+	assert.NoError(t, err, "Unexpected error when getting image tag")
+	assert.Len(t, tag, imageTagLength)
+
+	// Changes to ignored files do not change the tag
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(contextDir, "logs", "debug.log"), []byte("more logs"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(contextDir, "docs", "readme.md"), []byte("read me again"), 0644))
+	unchangedTag, err := imageTag(build)
+	assert.NoError(t, err)
+	assert.Equal(t, tag, unchangedTag, "Expected ignored files not to change the tag")
+
+	// Changes to build args do
+	build.Args = map[string]string{"ENV": "prod"}
+	argsTag, err := imageTag(build)
+	assert.NoError(t, err)
+	assert.NotEqual(t, tag, argsTag, "Expected build args to change the tag")
+	build.Args = nil
+
+	// And so do changes to other files
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(contextDir, "app", "main.go"), []byte("package main\n\nfunc main() {}\n"), 0644))
+	changedTag, err := imageTag(build)
+	assert.NoError(t, err)
+	assert.NotEqual(t, tag, changedTag, "Expected a changed file to change the tag")
+}
+
+func TestImageTag_MissingContext(t *testing.T) {
+	defer stubGitCommit("", errors.New("not a git repository"))()
+
+	_, err := imageTag(&adapter.BuildConfig{Context: filepath.Join(os.TempDir(), "ecs-cli-no-such-context")})
+	assert.Error(t, err, "Expected error when the build context does not exist")
+}
+
+func writeBuildContext(t *testing.T, files map[string]string) string {
+	contextDir, err := ioutil.TempDir("", "build-context")
+	assert.NoError(t, err, "Could not create build context")
+	for name, content := range files {
+		path := filepath.Join(contextDir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	}
+	return contextDir
+}
@@ -14,13 +14,17 @@
 package compose
 
 import (
+	"fmt"
 	"os"
 	"strconv"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/container"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	composeFactory "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/factory"
 	ecscompose "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/project"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/docker/libcompose/cli/command"
 	"github.com/flynn/go-shlex"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -143,3 +147,43 @@ func ProjectDown(p ecscompose.Project, c *cli.Context) {
 		log.Fatal(err)
 	}
 }
+
+// ProjectValidate checks the project files for problems. Unlike the other
+// project actions it does not load the ECS CLI configuration or create AWS
+// clients, so that it can run without credentials.
+func ProjectValidate(c *cli.Context) {
+	ecsContext := &context.ECSContext{CLIContext: c}
+	command.Populate(&ecsContext.Context, c)
+	resourceLookup, err := composeutils.GetDefaultResourceLookup()
+	if err != nil {
+		log.Fatal(err)
+	}
+	ecsContext.ResourceLookup = resourceLookup
+
+	err = ecscompose.Validate(ecsContext, ecscompose.ValidateInput{
+		LaunchType:      c.String(flags.LaunchTypeFlag),
+		PlatformVersion: c.String(flags.PlatformVersionFlag),
+	})
+	if validationErrs, ok := err.(composeutils.ValidationErrors); ok {
+		for _, validationErr := range validationErrs {
+			log.Error(validationErr)
+		}
+		log.Fatalf("Found %d problem(s) in the compose project", len(validationErrs))
+	} else if err != nil {
+		log.Fatal(err)
+	}
+	log.Info("No problems found in the compose project")
+}
+
+// ProjectParams prints the ecs-params of the compose project with variables
+// interpolated and the environment overlay merged
+func ProjectParams(c *cli.Context) {
+	ecsContext := &context.ECSContext{CLIContext: c}
+	command.Populate(&ecsContext.Context, c)
+
+	ecsParams, err := ecscompose.RenderECSParams(ecsContext)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Print(string(ecsParams))
+}
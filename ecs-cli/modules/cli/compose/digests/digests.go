@@ -0,0 +1,95 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package digests pins the images of a task definition to their digests, so
+// that every task runs exactly the image that was tagged when it was registered.
+package digests
+
+import (
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	registryclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/registry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/docker/distribution/reference"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// OriginalImageLabel is the docker label which records the image that a
+// container's pinned image was resolved from
+const OriginalImageLabel = "com.amazonaws.ecs-cli.original-image"
+
+// Clients contains the clients used to resolve digests. ECR returns the ECR
+// client for a region, since images may be in a different region than the task.
+type Clients struct {
+	ECR      func(region string) ecrclient.Client
+	Registry registryclient.Client
+}
+
+// PinImages replaces the image of each container in the task definition with
+// its digest, and records the original image in a docker label. Images which
+// already have a digest are left alone. It fails if any digest can not be
+// resolved, rather than registering an image which is not pinned.
+func PinImages(taskDefinition *ecs.TaskDefinition, clients Clients) error {
+	resolved := make(map[string]string)
+	for _, container := range taskDefinition.ContainerDefinitions {
+		image := aws.StringValue(container.Image)
+		pinned, ok := resolved[image]
+		if !ok {
+			var err error
+			if pinned, err = pinImage(image, clients); err != nil {
+				return errors.Wrapf(err, "unable to pin the image of container %s to a digest", aws.StringValue(container.Name))
+			}
+			resolved[image] = pinned
+		}
+		if pinned == image {
+			continue
+		}
+
+		log.WithFields(log.Fields{
+			"container": aws.StringValue(container.Name),
+			"image":     pinned,
+		}).Info("Pinned image to digest")
+		container.Image = aws.String(pinned)
+		if container.DockerLabels == nil {
+			container.DockerLabels = make(map[string]*string)
+		}
+		container.DockerLabels[OriginalImageLabel] = aws.String(image)
+	}
+	return nil
+}
+
+// pinImage returns the image with its tag replaced by its digest
+func pinImage(image string, clients Clients) (string, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := named.(reference.Digested); ok {
+		return image, nil
+	}
+	named = reference.TagNameOnly(named)
+	tag := named.(reference.Tagged).Tag()
+	domain, path := reference.Domain(named), reference.Path(named)
+
+	var digest string
+	if registryID, region, ok := ecrclient.ParseRegistryDomain(domain); ok {
+		digest, err = clients.ECR(region).GetImageDigest(registryID, path, tag)
+	} else {
+		digest, err = clients.Registry.GetImageDigest(domain, path, tag)
+	}
+	if err != nil {
+		return "", err
+	}
+	return reference.FamiliarName(named) + "@" + digest, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package digests
+
+import (
+	"errors"
+	"testing"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/registry/mock"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPinImages(t *testing.T) {
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:         aws.String("web"),
+				Image:        aws.String("123456789012.dkr.ecr.eu-west-1.amazonaws.com/tools/web:v1"),
+				DockerLabels: map[string]*string{"team": aws.String("green")},
+			},
+			{
+				Name:  aws.String("proxy"),
+				Image: aws.String("nginx"),
+			},
+			{
+				Name:  aws.String("sidecar"),
+				Image: aws.String("nginx"),
+			},
+			{
+				Name:  aws.String("agent"),
+				Image: aws.String("quay.io/org/agent:2.0"),
+			},
+			{
+				Name:  aws.String("pinned"),
+				Image: aws.String("busybox@sha256:0123456789012345678901234567890123456789012345678901234567890123"),
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECR := mock_ecr.NewMockClient(ctrl)
+	mockRegistry := mock_registry.NewMockClient(ctrl)
+	mockECR.EXPECT().GetImageDigest("123456789012", "tools/web", "v1").Return("sha256:aaa", nil)
+	mockRegistry.EXPECT().GetImageDigest("docker.io", "library/nginx", "latest").Return("sha256:bbb", nil)
+	mockRegistry.EXPECT().GetImageDigest("quay.io", "org/agent", "2.0").Return("sha256:ccc", nil)
+
+	var ecrRegion string
+	err := PinImages(taskDefinition, Clients{
+		ECR: func(region string) ecrclient.Client {
+			ecrRegion = region
+			return mockECR
+		},
+		Registry: mockRegistry,
+	})
+	assert.NoError(t, err, "Unexpected error when pinning images")
+	assert.Equal(t, "eu-west-1", ecrRegion, "Expected ECR client for the region of the image")
+
+	containers := taskDefinition.ContainerDefinitions
+	assert.Equal(t, "123456789012.dkr.ecr.eu-west-1.amazonaws.com/tools/web@sha256:aaa", aws.StringValue(containers[0].Image))
+	assert.Equal(t, "123456789012.dkr.ecr.eu-west-1.amazonaws.com/tools/web:v1", aws.StringValue(containers[0].DockerLabels[OriginalImageLabel]))
+	assert.Equal(t, "green", aws.StringValue(containers[0].DockerLabels["team"]), "Expected existing labels to be kept")
+	assert.Equal(t, "nginx@sha256:bbb", aws.StringValue(containers[1].Image))
+	assert.Equal(t, "nginx", aws.StringValue(containers[1].DockerLabels[OriginalImageLabel]))
+	assert.Equal(t, "nginx@sha256:bbb", aws.StringValue(containers[2].Image))
+	assert.Equal(t, "quay.io/org/agent@sha256:ccc", aws.StringValue(containers[3].Image))
+	assert.Equal(t, "busybox@sha256:0123456789012345678901234567890123456789012345678901234567890123", aws.StringValue(containers[4].Image))
+	assert.Nil(t, containers[4].DockerLabels, "Expected no label on an image which was already pinned")
+}
+
+func TestPinImages_ErrorCase(t *testing.T) {
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:  aws.String("web"),
+				Image: aws.String("nginx:1.19"),
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRegistry := mock_registry.NewMockClient(ctrl)
+	mockRegistry.EXPECT().GetImageDigest("docker.io", "library/nginx", "1.19").Return("", errors.New("something went wrong"))
+
+	err := PinImages(taskDefinition, Clients{Registry: mockRegistry})
+	assert.Error(t, err, "Expected error when digest can not be resolved")
+	assert.Contains(t, err.Error(), "container web")
+	assert.Equal(t, "nginx:1.19", aws.StringValue(taskDefinition.ContainerDefinitions[0].Image), "Expected image to be unchanged")
+}
+
+func TestPinImages_InvalidImage(t *testing.T) {
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:  aws.String("web"),
+				Image: aws.String("Invalid:Image:Name"),
+			},
+		},
+	}
+
+	err := PinImages(taskDefinition, Clients{})
+	assert.Error(t, err, "Expected error for an invalid image")
+}
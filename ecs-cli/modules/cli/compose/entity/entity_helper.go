@@ -17,9 +17,19 @@ import (
 	"fmt"
 
 	composecontainer "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/container"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/digests"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/types"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/roles"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/scans"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/logs"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/iam"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/kms"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/secretsmanager"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ssm"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/registry"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/cache"
@@ -93,6 +103,18 @@ func GetOrCreateTaskDefinition(entity ProjectEntity) (*ecs.TaskDefinition, error
 		"TaskDefinition": taskDefinition,
 	}).Debug("Finding task definition in cache or creating if needed")
 
+	if err := syncTaskRole(entity); err != nil {
+		return nil, err
+	}
+
+	if err := pinDigests(entity); err != nil {
+		return nil, err
+	}
+
+	if err := checkImageScans(entity); err != nil {
+		return nil, err
+	}
+
 	tags, err := entity.GetTags()
 	if err != nil {
 		return nil, err
@@ -512,3 +534,128 @@ func OptionallyCreateLogs(entity ProjectEntity) error {
 
 	return nil
 }
+
+// OptionallyCreateExecutionRole creates or updates the task execution role of the
+// project if the --create-execution-role flag is present, and uses it in the task definition.
+func OptionallyCreateExecutionRole(entity ProjectEntity) error {
+	if !entity.Context().CLIContext.Bool(flags.CreateExecutionRoleFlag) {
+		return nil
+	}
+
+	taskDefinition := entity.TaskDefinition()
+	if executionRole := aws.StringValue(taskDefinition.ExecutionRoleArn); executionRole != "" {
+		log.WithFields(log.Fields{
+			"option name": "task_execution_role",
+		}).Warnf("Replacing execution role %s with the role created by --%s", executionRole, flags.CreateExecutionRoleFlag)
+	}
+
+	commandConfig := entity.Context().CommandConfig
+	accountID, err := sts.NewClient(commandConfig).GetAWSAccountID()
+	if err != nil {
+		return err
+	}
+	tags, err := entity.GetTags()
+	if err != nil {
+		return err
+	}
+
+	roleARN, err := roles.CreateOrUpdateExecutionRole(roles.ExecutionRoleParams{
+		ProjectName:    entity.Context().ProjectName,
+		Region:         commandConfig.Region(),
+		AccountID:      accountID,
+		TaskDefinition: taskDefinition,
+		Tags:           tags,
+	}, roles.ExecutionRoleClients{
+		IAM:            iam.NewIAMClient(commandConfig),
+		KMS:            kms.NewKMSClient(commandConfig),
+		SecretsManager: secretsmanager.NewSecretsManagerClient(commandConfig),
+		SSM:            ssm.NewSSMClient(commandConfig),
+	})
+	if err != nil {
+		return err
+	}
+
+	if roleARN != "" {
+		taskDefinition.ExecutionRoleArn = aws.String(roleARN)
+	}
+	return nil
+}
+
+// syncTaskRole creates or updates the task role of the project if the
+// ecs-params file specifies task_role_policies, and uses it in place of any
+// other task role
+func syncTaskRole(entity ProjectEntity) error {
+	ecsParams := entity.Context().ECSParams
+	if ecsParams == nil || ecsParams.TaskDefinition.TaskRolePolicies == nil {
+		return nil
+	}
+
+	taskDefinition := entity.TaskDefinition()
+	if taskRole := aws.StringValue(taskDefinition.TaskRoleArn); taskRole != "" {
+		log.WithFields(log.Fields{
+			"option name": "task_role_policies",
+		}).Warnf("Replacing task role %s with the role created for task_role_policies", taskRole)
+	}
+
+	tags, err := entity.GetTags()
+	if err != nil {
+		return err
+	}
+
+	roleARN, err := roles.CreateOrUpdateTaskRole(roles.TaskRoleParams{
+		ProjectName: entity.Context().ProjectName,
+		Policies:    ecsParams.TaskDefinition.TaskRolePolicies,
+		Tags:        tags,
+	}, iam.NewIAMClient(entity.Context().CommandConfig))
+	if err != nil {
+		return err
+	}
+
+	taskDefinition.TaskRoleArn = aws.String(roleARN)
+	return nil
+}
+
+// pinDigests replaces the images of the task definition with their digests
+// if the pin digests flag is set
+func pinDigests(entity ProjectEntity) error {
+	if !entity.Context().CLIContext.GlobalBool(flags.PinDigestsFlag) {
+		return nil
+	}
+
+	return digests.PinImages(entity.TaskDefinition(), digests.Clients{
+		ECR:      ecrClientFactory(entity.Context().CommandConfig),
+		Registry: registry.NewClient(),
+	})
+}
+
+// checkImageScans checks the scan findings of the images of the task
+// definition if a maximum severity is set
+func checkImageScans(entity ProjectEntity) error {
+	maxSeverity := entity.Context().CLIContext.String(flags.MaxSeverityFlag)
+	if maxSeverity == "" {
+		return nil
+	}
+	return scans.CheckImages(entity.TaskDefinition(), maxSeverity, ecrClientFactory(entity.Context().CommandConfig))
+}
+
+// ecrClientFactory returns a function which creates an ECR client for a
+// region, since images may be in a different region than the task
+func ecrClientFactory(commandConfig *config.CommandConfig) func(region string) ecr.Client {
+	return func(region string) ecr.Client {
+		if region == commandConfig.Region() {
+			return ecr.NewClient(commandConfig)
+		}
+		regionConfig := *commandConfig
+		regionConfig.Session = commandConfig.Session.Copy(aws.NewConfig().WithRegion(region))
+		return ecr.NewClient(&regionConfig)
+	}
+}
+
+// OptionallyDeleteRoles deletes the roles created for the project if the
+// delete roles flag is set
+func OptionallyDeleteRoles(entity ProjectEntity) error {
+	if !entity.Context().CLIContext.Bool(flags.DeleteRolesFlag) {
+		return nil
+	}
+	return roles.DeleteRoles(entity.Context().ProjectName, iam.NewIAMClient(entity.Context().CommandConfig))
+}
@@ -63,6 +63,9 @@ var servicediscoveryCreate servicediscovery.CreateFunc = servicediscovery.Create
 // make servicediscovery.Update easily mockable in tests
 var servicediscoveryUpdate servicediscovery.UpdateFunc = servicediscovery.Update
 
+// make servicediscovery.ReviewUpdate easily mockable in tests
+var servicediscoveryReviewUpdate servicediscovery.ReviewUpdateFunc = servicediscovery.ReviewUpdate
+
 // make servicediscovery.Delete easily mockable in tests
 var servicediscoveryDelete servicediscovery.DeleteFunc = servicediscovery.Delete
 
@@ -243,6 +246,19 @@ func (s *Service) Up() error {
 			return err
 		}
 	}
+	serviceExists := !missingServiceErr && aws.StringValue(ecsService.Status) == ecsActiveResourceCode
+
+	// Changes to Service Discovery are reviewed before anything else is
+	// changed, so that a dry run or declined changes leave the service as it is
+	sdUpdate, err := s.reviewServiceDiscoveryUpdate(serviceExists)
+	if err != nil || (sdUpdate != nil && sdUpdate.Stop) {
+		return err
+	}
+
+	err = entity.OptionallyCreateExecutionRole(s)
+	if err != nil {
+		return err
+	}
 
 	// get the current snapshot of compose yml
 	// and update this instance with the latest task definition
@@ -257,7 +273,7 @@ func (s *Service) Up() error {
 	}
 
 	// if ECS service was not created before, or is inactive, create and start the ECS Service
-	if missingServiceErr || aws.StringValue(ecsService.Status) != ecsActiveResourceCode {
+	if !serviceExists {
 		// uses the latest task definition to create the service
 		return s.createService(1)
 	}
@@ -268,6 +284,12 @@ func (s *Service) Up() error {
 	}
 
 	// Update Service Discovery
+	if sdUpdate != nil {
+		if sdUpdate.Execute == nil {
+			return nil
+		}
+		return sdUpdate.Execute()
+	}
 	if s.Context().CLIContext.Bool(flags.UpdateServiceDiscoveryFlag) {
 		return servicediscoveryUpdate(aws.StringValue(newTaskDefinition.NetworkMode), entity.GetServiceName(s), s.Context())
 	}
@@ -284,6 +306,29 @@ func (s *Service) Up() error {
 	return nil
 }
 
+// reviewServiceDiscoveryUpdate reviews the changes to Service Discovery if
+// they are to be previewed, returning nil otherwise. The network mode is
+// taken from the task definition converted from the compose file, as the new
+// task definition is not registered yet.
+func (s *Service) reviewServiceDiscoveryUpdate(serviceExists bool) (*servicediscovery.ReviewedUpdate, error) {
+	cliContext := s.Context().CLIContext
+	if !servicediscovery.IsReviewed(cliContext) {
+		return nil, nil
+	}
+	if !cliContext.Bool(flags.UpdateServiceDiscoveryFlag) {
+		return nil, fmt.Errorf("You can only specify '--%s', '--%s' or '--%s' with '--%s'", flags.PreviewServiceDiscoveryFlag, flags.DryRunFlag, flags.RenderTemplateFlag, flags.UpdateServiceDiscoveryFlag)
+	}
+	if !serviceExists {
+		return nil, fmt.Errorf("Service Discovery can only be updated for an existing service, but service %s does not exist", entity.GetServiceName(s))
+	}
+
+	var networkMode string
+	if taskDefinition := s.TaskDefinition(); taskDefinition != nil {
+		networkMode = aws.StringValue(taskDefinition.NetworkMode)
+	}
+	return servicediscoveryReviewUpdate(networkMode, entity.GetServiceName(s), s.Context())
+}
+
 // TODO: Refactor this if we use the stand alone tagging client in more places in the future
 var newTaggingClient = tagging.NewTaggingClient
 
@@ -421,7 +466,7 @@ func (s *Service) Stop() error {
 }
 
 // Down stops any running containers(tasks) by calling Stop() and deletes an active ECS Service
-// NoOp if the service is inactive
+// NoOp if the service is inactive, apart from deleting the project's roles if requested
 func (s *Service) Down() error {
 	// describe the service
 	ecsService, err := s.describeService()
@@ -435,7 +480,7 @@ func (s *Service) Down() error {
 		log.WithFields(log.Fields{
 			"serviceName": ecsServiceName,
 		}).Info("ECS Service is already deleted")
-		return nil
+		return entity.OptionallyDeleteRoles(s)
 	}
 
 	// DeleteService will ignore desiredCount being non-zero by making use
@@ -457,7 +502,7 @@ func (s *Service) Down() error {
 		}
 	}
 
-	return nil
+	return entity.OptionallyDeleteRoles(s)
 }
 
 func (s *Service) deleteServiceDiscoveryResources(registryArn, ecsServiceName string) error {
@@ -22,6 +22,7 @@ import (
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/servicediscovery"
 	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/tagging"
 	mock_tagging "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/tagging/mock"
@@ -1819,3 +1820,114 @@ func verifyTaskDefinitionInput(t *testing.T,
 	regInput *ecs.RegisterTaskDefinitionInput) {
 	assert.Equal(t, aws.StringValue(taskDef.Family), aws.StringValue(regInput.Family), "Task Definition family should match")
 }
+
+func TestUpdateExistingServiceWithServiceDiscoveryPreview(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.UpdateServiceDiscoveryFlag, true, "")
+	flagSet.Bool(flags.PreviewServiceDiscoveryFlag, true, "")
+
+	serviceName := "test-service"
+	existingService := &ecs.Service{
+		TaskDefinition: aws.String("arn/test-task-def"),
+		Status:         aws.String("ACTIVE"),
+		ServiceName:    aws.String(serviceName),
+	}
+
+	reviewed, executed := false, false
+	defer func(original servicediscovery.ReviewUpdateFunc) { servicediscoveryReviewUpdate = original }(servicediscoveryReviewUpdate)
+	servicediscoveryReviewUpdate = func(networkMode, name string, c *context.ECSContext) (*servicediscovery.ReviewedUpdate, error) {
+		reviewed = true
+		return &servicediscovery.ReviewedUpdate{
+			Execute: func() error {
+				executed = true
+				return nil
+			},
+		}, nil
+	}
+
+	expectedInput := getDefaultUpdateInput()
+	expectedInput.serviceName = serviceName
+	updateServiceTest(t, flagSet, &config.CommandConfig{}, &utils.ECSParams{}, expectedInput, existingService, true)
+	assert.True(t, reviewed, "Expected Service Discovery changes to be reviewed")
+	assert.True(t, executed, "Expected Service Discovery changes to be executed after the service is updated")
+}
+
+func TestUpdateExistingServiceWithServiceDiscoveryDryRun(t *testing.T) {
+	testCases := map[string]func(networkMode, name string, c *context.ECSContext) (*servicediscovery.ReviewedUpdate, error){
+		"dry run": func(networkMode, name string, c *context.ECSContext) (*servicediscovery.ReviewedUpdate, error) {
+			return &servicediscovery.ReviewedUpdate{Stop: true}, nil
+		},
+		"declined": func(networkMode, name string, c *context.ECSContext) (*servicediscovery.ReviewedUpdate, error) {
+			return nil, fmt.Errorf("Aborted")
+		},
+	}
+	for name, reviewUpdate := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			defer func(original servicediscovery.ReviewUpdateFunc) { servicediscoveryReviewUpdate = original }(servicediscoveryReviewUpdate)
+			servicediscoveryReviewUpdate = reviewUpdate
+
+			existingService := &ecs.Service{
+				TaskDefinition: aws.String("arn/test-task-def"),
+				Status:         aws.String("ACTIVE"),
+				ServiceName:    aws.String("test-service"),
+			}
+			// Nothing is changed, so the service is only described
+			mockEcs := mock_ecs.NewMockECSClient(ctrl)
+			mockEcs.EXPECT().DescribeService(gomock.Any()).Return(getDescribeServiceTestResponse(existingService), nil)
+
+			flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+			flagSet.Bool(flags.UpdateServiceDiscoveryFlag, true, "")
+			flagSet.Bool(flags.DryRunFlag, true, "")
+			ecsContext := &context.ECSContext{
+				ECSClient:     mockEcs,
+				CommandConfig: &config.CommandConfig{},
+				CLIContext:    cli.NewContext(nil, flagSet, nil),
+				ECSParams:     &utils.ECSParams{},
+				ProjectName:   "test-service",
+			}
+			service := NewService(ecsContext)
+			assert.NoError(t, service.LoadContext(), "Unexpected error loading context")
+			_, taskDefinition, _ := getTestTaskDef("test-task-def")
+			service.SetTaskDefinition(&taskDefinition)
+
+			err := service.Up()
+			if name == "declined" {
+				assert.Error(t, err, "Expected error when Service Discovery changes are declined")
+			} else {
+				assert.NoError(t, err, "Unexpected error on dry run")
+			}
+		})
+	}
+}
+
+func TestUpServiceDiscoveryDryRunWithoutUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	existingService := &ecs.Service{
+		TaskDefinition: aws.String("arn/test-task-def"),
+		Status:         aws.String("ACTIVE"),
+		ServiceName:    aws.String("test-service"),
+	}
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	mockEcs.EXPECT().DescribeService(gomock.Any()).Return(getDescribeServiceTestResponse(existingService), nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.UpdateServiceDiscoveryFlag, false, "")
+	flagSet.Bool(flags.DryRunFlag, true, "")
+	ecsContext := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+		ECSParams:     &utils.ECSParams{},
+		ProjectName:   "test-service",
+	}
+	service := NewService(ecsContext)
+	assert.NoError(t, service.LoadContext(), "Unexpected error loading context")
+
+	err := service.Up()
+	assert.Error(t, err, "Expected error for --dry-run without --update-service-discovery")
+}
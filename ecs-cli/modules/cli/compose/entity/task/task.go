@@ -430,6 +430,12 @@ func (t *Task) up(forceUpdate bool) error {
 	if err != nil {
 		return err
 	}
+
+	err = entity.OptionallyCreateExecutionRole(t)
+	if err != nil {
+		return err
+	}
+
 	_, err = entity.GetOrCreateTaskDefinition(t)
 	if err != nil {
 		return err
@@ -30,6 +30,7 @@ import (
 // because certain fields were introduced after v1. However, due to the lack of popularity of v1,
 // for now we combine the 2 versions.
 var supportedComposeV1V2YamlOptions = []string{
+	"build",
 	"cap_add",
 	"cap_drop",
 	"command",
@@ -68,6 +69,7 @@ var supportedComposeV1V2YamlOptions = []string{
 
 // supported fields/options from compose 3 YAML file
 var supportedFieldsInV3 = map[string]bool{
+	"Build":           true,
 	"CapAdd":          true,
 	"CapDrop":         true,
 	"Command":         true,
@@ -169,7 +169,11 @@ func (p *ecsProject) parseCompose() error {
 func (p *ecsProject) parseECSParams() error {
 	logrus.Debug("Parsing the ecs-params yaml...")
 	ecsParamsFileName := p.ecsContext.CLIContext.GlobalString(flags.ECSParamsFileNameFlag)
-	ecsParams, err := composeutils.ReadECSParams(ecsParamsFileName)
+	opts, err := readECSParamsOptions(p.ecsContext)
+	if err != nil {
+		return err
+	}
+	ecsParams, err := composeutils.ReadECSParamsWithOptions(ecsParamsFileName, opts)
 
 	if err != nil {
 		return err
@@ -180,6 +184,33 @@ func (p *ecsProject) parseECSParams() error {
 	return nil
 }
 
+// readECSParamsOptions returns the environment overlay to merge over the
+// ecs-params file, and the variables to interpolate into it, which include
+// those in the .env file next to the compose file like docker compose
+func readECSParamsOptions(ecsContext *context.ECSContext) (composeutils.ReadECSParamsOptions, error) {
+	wrkDir := "."
+	if len(ecsContext.ComposeFiles) > 0 {
+		var err error
+		if wrkDir, err = getWorkingDir(ecsContext.ComposeFiles[0]); err != nil {
+			return composeutils.ReadECSParamsOptions{}, err
+		}
+	}
+	return composeutils.ReadECSParamsOptions{
+		Env:         ecsContext.CLIContext.GlobalString(flags.ECSParamsEnvFlag),
+		Environment: getEnvironment(wrkDir),
+	}, nil
+}
+
+// RenderECSParams returns the ecs-params yaml of a project after variables
+// have been interpolated and the environment overlay has been merged
+func RenderECSParams(ecsContext *context.ECSContext) ([]byte, error) {
+	opts, err := readECSParamsOptions(ecsContext)
+	if err != nil {
+		return nil, err
+	}
+	return composeutils.RenderECSParams(ecsContext.CLIContext.GlobalString(flags.ECSParamsFileNameFlag), opts)
+}
+
 func (p *ecsProject) parseECSRegistryCreds() error {
 	logrus.Debug("Parsing the ecs-registry-creds yaml...")
 	registryCredsFileName := p.ecsContext.CLIContext.GlobalString(flags.RegistryCredsFileNameFlag)
@@ -235,6 +266,9 @@ func (p *ecsProject) Start() error {
 }
 
 func (p *ecsProject) Up() error {
+	if err := p.buildImages(); err != nil {
+		return err
+	}
 	return p.entity.Up()
 }
 
@@ -0,0 +1,67 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/build"
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	stsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts"
+	dockerclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/sirupsen/logrus"
+)
+
+// buildImages builds and pushes the images of the services with a build
+// section if the build flag is set, and uses the pushed images in the task
+// definition in place of the images in the compose file
+func (p *ecsProject) buildImages() error {
+	if !p.ecsContext.CLIContext.Bool(flags.BuildFlag) {
+		for _, containerConfig := range p.ContainerConfigs() {
+			if containerConfig.Build != nil && containerConfig.Image == "" {
+				logrus.WithField("service name", containerConfig.Name).Warnf("Service has a build section but no image; use --%s to build and push its image", flags.BuildFlag)
+			}
+		}
+		return nil
+	}
+
+	commandConfig := p.ecsContext.CommandConfig
+	accountID, err := stsclient.NewClient(commandConfig).GetAWSAccountID()
+	if err != nil {
+		return err
+	}
+	dockerClient, err := dockerclient.NewClient()
+	if err != nil {
+		return err
+	}
+
+	images, err := build.BuildAndPushImages(build.Params{
+		ProjectName:      p.ecsContext.ProjectName,
+		RegistryID:       accountID,
+		ContainerConfigs: p.ContainerConfigs(),
+	}, build.Clients{
+		Docker: dockerClient,
+		ECR:    ecrclient.NewClient(commandConfig),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, containerDef := range p.entity.TaskDefinition().ContainerDefinitions {
+		if image, ok := images[aws.StringValue(containerDef.Name)]; ok {
+			containerDef.Image = aws.String(image)
+		}
+	}
+	return nil
+}
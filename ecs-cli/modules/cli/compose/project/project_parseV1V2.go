@@ -114,6 +114,7 @@ func convertV1V2ToContainerConfig(context *project.Context, serviceName string,
 
 	outputConfig := &adapter.ContainerConfig{
 		Name:                  serviceName,
+		Build:                 adapter.ConvertToBuildConfig("", service.Build.Context, service.Build.Dockerfile, service.Build.Args), // libcompose resolves the context against the compose file
 		CapAdd:                service.CapAdd,
 		CapDrop:               service.CapDrop,
 		Command:               service.Command,
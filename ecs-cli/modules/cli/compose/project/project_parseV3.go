@@ -36,6 +36,11 @@ func (p *ecsProject) parseV3() (*[]adapter.ContainerConfig, error) {
 	}
 	p.volumes = servVols
 
+	wrkDir, err := getWorkingDir(p.ecsContext.ComposeFiles[0])
+	if err != nil {
+		return nil, err
+	}
+
 	// convert ServiceConfigs to ContainerConfigs
 	conConfigs := []adapter.ContainerConfig{}
 	for _, service := range v3Config.Services {
@@ -43,6 +48,7 @@ func (p *ecsProject) parseV3() (*[]adapter.ContainerConfig, error) {
 		if err != nil {
 			return nil, err
 		}
+		cCon.Build = convertToBuildConfig(wrkDir, service)
 		conConfigs = append(conConfigs, *cCon)
 	}
 
@@ -228,6 +234,27 @@ func getWorkingDir(fileName string) (string, error) {
 	return filepath.Dir(pwd), nil
 }
 
+// convertToBuildConfig converts the build section of a service, warning about
+// the options which are not used when building its image
+func convertToBuildConfig(wrkDir string, serviceConfig types.ServiceConfig) *adapter.BuildConfig {
+	build := serviceConfig.Build
+	unsupportedOptions := map[string]bool{
+		"cache_from": len(build.CacheFrom) > 0,
+		"labels":     len(build.Labels) > 0,
+		"network":    build.Network != "",
+		"target":     build.Target != "",
+	}
+	for _, optionName := range []string{"cache_from", "labels", "network", "target"} {
+		if unsupportedOptions[optionName] {
+			log.WithFields(log.Fields{
+				"option name":  "build." + optionName,
+				"service name": serviceConfig.Name,
+			}).Warn("Skipping unsupported YAML option for service...")
+		}
+	}
+	return adapter.ConvertToBuildConfig(wrkDir, build.Context, build.Dockerfile, build.Args)
+}
+
 func convertPortConfigToECSMapping(portConfig types.ServicePortConfig) *ecs.PortMapping {
 	containerPort := int64(portConfig.Target)
 	hostPort := int64(portConfig.Published)
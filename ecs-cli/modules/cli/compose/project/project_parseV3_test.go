@@ -773,6 +773,57 @@ services:
 	verifyContainerConfig(t, wordpressCon, *wp)
 }
 
+func TestParseV3WithBuild(t *testing.T) {
+	os.Setenv("BUILD_VERSION", "1.2.3")
+	defer os.Unsetenv("BUILD_VERSION")
+
+	composeFileString := `version: '3'
+services:
+  web:
+    build: ./web
+  worker:
+    image: myorg/worker
+    build:
+      context: /src/worker
+      dockerfile: Dockerfile.prod
+      args:
+        - ENV=prod
+        - BUILD_VERSION
+        - UNSET_BUILD_ARG`
+
+	tmpfile, err := ioutil.TempFile("", "test")
+	assert.NoError(t, err, "Unexpected error in creating test file")
+
+	defer os.Remove(tmpfile.Name())
+
+	_, err = tmpfile.Write([]byte(composeFileString))
+	assert.NoError(t, err, "Unexpected error writing file")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Unexpected error closing file")
+
+	project := setupTestProject(t)
+	project.ecsContext.ComposeFiles = append(project.ecsContext.ComposeFiles, tmpfile.Name())
+
+	actualConfigs, err := project.parseV3()
+	assert.NoError(t, err, "Unexpected error parsing file")
+
+	web, err := getContainerConfigByName("web", actualConfigs)
+	assert.NoError(t, err, "Unexpected error retrieving web config")
+	expectedContext := filepath.Join(filepath.Dir(tmpfile.Name()), "web")
+	assert.Equal(t, &adapter.BuildConfig{Context: expectedContext, Args: map[string]string{}}, web.Build, "Expected build context to be relative to the compose file")
+
+	worker, err := getContainerConfigByName("worker", actualConfigs)
+	assert.NoError(t, err, "Unexpected error retrieving worker config")
+	expectedBuild := &adapter.BuildConfig{
+		Context:    "/src/worker",
+		Dockerfile: "Dockerfile.prod",
+		Args:       map[string]string{"ENV": "prod", "BUILD_VERSION": "1.2.3"},
+	}
+	assert.Equal(t, expectedBuild, worker.Build, "Expected build config to match")
+	assert.Equal(t, "myorg/worker", worker.Image, "Expected image to match")
+}
+
 // TODO: add check for fields not used by V3, use to also check V1V2 ContainerConfigs?
 func verifyContainerConfig(t *testing.T, expected, actual adapter.ContainerConfig) {
 	assert.ElementsMatch(t, expected.CapAdd, actual.CapAdd, "Expected CapAdd to match")
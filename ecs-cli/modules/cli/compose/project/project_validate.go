@@ -0,0 +1,95 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/sirupsen/logrus"
+)
+
+// ValidateInput contains the command line values that a project is validated against
+type ValidateInput struct {
+	LaunchType      string
+	PlatformVersion string
+}
+
+// Validate parses the compose, ecs-params and registry credentials files of
+// a project and checks them for problems without making any calls to AWS, so
+// that it can be used without credentials. Every problem found is returned
+// in a composeutils.ValidationErrors.
+func Validate(ecsContext *context.ECSContext, input ValidateInput) error {
+	p := &ecsProject{
+		ecsContext:       ecsContext,
+		containerConfigs: []adapter.ContainerConfig{},
+		volumes:          adapter.NewVolumes(),
+	}
+
+	var errs composeutils.ValidationErrors
+
+	// The remaining checks depend on the services in the compose file
+	if err := p.parseCompose(); err != nil {
+		return append(errs, err)
+	}
+
+	logrus.Debug("Validating the ecs-params yaml...")
+	ecsParamsFileName := ecsContext.CLIContext.GlobalString(flags.ECSParamsFileNameFlag)
+	opts, err := readECSParamsOptions(ecsContext)
+	if err != nil {
+		return append(errs, err)
+	}
+	ecsParams, err := composeutils.ReadECSParamsStrict(ecsParamsFileName, opts)
+	if yamlErrs, ok := err.(composeutils.ValidationErrors); ok {
+		errs = append(errs, yamlErrs...)
+	} else if err != nil {
+		return append(errs, err)
+	}
+	ecsContext.ECSParams = ecsParams
+
+	errs = append(errs, composeutils.ValidateECSParams(ecsParams, composeutils.ValidateECSParamsInput{
+		LaunchType:       input.LaunchType,
+		PlatformVersion:  input.PlatformVersion,
+		ContainerConfigs: p.ContainerConfigs(),
+	})...)
+
+	if err := p.parseECSRegistryCreds(); err != nil {
+		errs = append(errs, err)
+	} else {
+		errs = append(errs, composeutils.ValidateRegistryCreds(p.ecsRegistryCreds, p.ContainerConfigs())...)
+	}
+
+	// Converting the task definition finds the remaining problems, such as
+	// invalid health checks, but would repeat those already found
+	if len(errs) == 0 {
+		_, err := composeutils.ConvertToTaskDefinition(composeutils.ConvertTaskDefParams{
+			TaskDefName:            ecsContext.ProjectName,
+			TaskRoleArn:            ecsContext.CLIContext.GlobalString(flags.TaskRoleArnFlag),
+			RequiredCompatibilites: input.LaunchType,
+			Volumes:                p.VolumeConfigs(),
+			ContainerConfigs:       p.ContainerConfigs(),
+			ECSParams:              ecsParams,
+			ECSRegistryCreds:       p.ecsRegistryCreds,
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
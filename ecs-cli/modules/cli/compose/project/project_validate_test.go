@@ -0,0 +1,96 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	composeFileName := writeTempFileForTest(t, "docker-compose", `version: '3'
+services:
+  web:
+    image: nginx
+    ports:
+      - "80:80"`)
+	defer os.Remove(composeFileName)
+
+	ecsParamsFileName := writeTempFileForTest(t, "ecs-params", `version: 1
+task_definition:
+  ecs_network_mode: awsvpc
+  task_size:
+    cpu_limit: 256
+    mem_limit: 512
+run_params:
+  network_configuration:
+    awsvpc_configuration:
+      subnets:
+        - subnet-feedface
+      assign_public_ip: ENABLED`)
+	defer os.Remove(ecsParamsFileName)
+
+	project := setupTestProjectWithEcsParams(t, ecsParamsFileName)
+	project.ecsContext.ComposeFiles = []string{composeFileName}
+
+	err := Validate(project.ecsContext, ValidateInput{LaunchType: config.LaunchTypeFargate})
+	assert.NoError(t, err, "Expected project to be valid")
+}
+
+func TestValidate_ReportsAllProblems(t *testing.T) {
+	composeFileName := writeTempFileForTest(t, "docker-compose", `version: '3'
+services:
+  web:
+    image: nginx
+    ports:
+      - "8080:80"`)
+	defer os.Remove(composeFileName)
+
+	ecsParamsFileName := writeTempFileForTest(t, "ecs-params", `version: 1
+task_defintion:
+  ecs_network_mode: awsvpc
+task_definition:
+  ecs_network_mode: host
+  services:
+    worker:
+      essential: false`)
+	defer os.Remove(ecsParamsFileName)
+
+	project := setupTestProjectWithEcsParams(t, ecsParamsFileName)
+	project.ecsContext.ComposeFiles = []string{composeFileName}
+
+	err := Validate(project.ecsContext, ValidateInput{LaunchType: config.LaunchTypeEC2})
+	validationErrs, ok := err.(composeutils.ValidationErrors)
+	if assert.True(t, ok, "Expected ValidationErrors, got %v", err) {
+		// unknown key, unknown service and host port mismatch
+		assert.Len(t, validationErrs, 3, "Expected every problem to be reported: %v", validationErrs)
+	}
+}
+
+func writeTempFileForTest(t *testing.T, prefix, content string) string {
+	f, err := ioutil.TempFile("", prefix)
+	assert.NoError(t, err, "Could not create tempfile")
+
+	_, err = f.Write([]byte(content))
+	assert.NoError(t, err, "Could not write data to tempfile")
+
+	err = f.Close()
+	assert.NoError(t, err, "Could not close tempfile")
+	return f.Name()
+}
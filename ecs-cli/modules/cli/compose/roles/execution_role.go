@@ -0,0 +1,335 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package roles
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	iamClient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/iam"
+	kmsClient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/kms"
+	secretsClient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/secretsmanager"
+	ssmClient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ssm"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	executionRoleNameSuffix   = "execution-role"
+	executionRolePolicyName   = "ecs-cli-execution-policy"
+	executionRoleDescription  = "Task execution role generated by the ecs-cli for compose project %s"
+	awslogsDriver             = "awslogs"
+	awslogsGroupOption        = "awslogs-group"
+	awslogsRegionOption       = "awslogs-region"
+	awslogsCreateGroupOption  = "awslogs-create-group"
+	defaultSSMKeyAlias        = "alias/aws/ssm"
+	secretsManagerARNSections = 7 // arn:partition:secretsmanager:region:account:secret:name
+)
+
+// ecrImageRegexp matches images in ECR, capturing the account, region and repository
+var ecrImageRegexp = regexp.MustCompile(`^(\d{12})\.dkr\.ecr(?:-fips)?\.([a-z0-9-]+)\.amazonaws\.com(?:\.cn)?/([^:@]+)`)
+
+// ExecutionRoleParams contains the values needed to create the task execution role of a compose project
+type ExecutionRoleParams struct {
+	ProjectName    string
+	Region         string
+	AccountID      string
+	TaskDefinition *ecs.TaskDefinition
+	Tags           []*ecs.Tag
+}
+
+// ExecutionRoleClients contains the clients used to create the task execution
+// role and look up the KMS keys of the secrets that the task uses
+type ExecutionRoleClients struct {
+	IAM            iamClient.Client
+	KMS            kmsClient.Client
+	SecretsManager secretsClient.SMClient
+	SSM            ssmClient.Client
+}
+
+// CreateOrUpdateExecutionRole creates a task execution role for a compose
+// project, or updates the one created before, with only the permissions that
+// its task definition needs: pulling its ECR images, writing to its log
+// groups and reading its secrets. It returns the ARN of the role, or an empty
+// string if the task definition does not need an execution role.
+func CreateOrUpdateExecutionRole(params ExecutionRoleParams, clients ExecutionRoleClients) (string, error) {
+	policy, err := generateExecutionRolePolicy(params, clients)
+	if err != nil {
+		return "", err
+	}
+	if len(policy.Statement) == 0 {
+		log.Warn("No task execution role to create; the task definition does not use ECR images, awslogs or secrets")
+		return "", nil
+	}
+
+	return createOrUpdateRole(roleParams{
+		ProjectName:    params.ProjectName,
+		RoleName:       ExecutionRoleName(params.ProjectName),
+		Description:    fmt.Sprintf(executionRoleDescription, params.ProjectName),
+		PolicyName:     executionRolePolicyName,
+		PolicyDocument: *policy,
+		Tags:           roleTags(params.ProjectName, params.Tags),
+	}, clients.IAM)
+}
+
+// ExecutionRoleName returns the name of the task execution role created for a compose project
+func ExecutionRoleName(projectName string) string {
+	return roleName(projectName, executionRoleNameSuffix)
+}
+
+// executionPolicyGenerator collects the resources that a task definition uses
+type executionPolicyGenerator struct {
+	partition string
+	region    string
+	accountID string
+	clients   ExecutionRoleClients
+
+	ecrRepositories resourceSet
+	logGroups       resourceSet
+	newLogGroups    resourceSet
+	ssmParameters   resourceSet
+	secrets         resourceSet
+	kmsKeys         resourceSet
+}
+
+// resourceSet holds a set of resource ARNs
+type resourceSet map[string]bool
+
+// sorted returns the resources in a stable order, so that the same task
+// definition always generates the same policy
+func (r resourceSet) sorted() []string {
+	resources := make([]string, 0, len(r))
+	for resource := range r {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	return resources
+}
+
+func generateExecutionRolePolicy(params ExecutionRoleParams, clients ExecutionRoleClients) (*PolicyDocument, error) {
+	g := &executionPolicyGenerator{
+		partition:       utils.GetPartition(params.Region),
+		region:          params.Region,
+		accountID:       params.AccountID,
+		clients:         clients,
+		ecrRepositories: resourceSet{},
+		logGroups:       resourceSet{},
+		newLogGroups:    resourceSet{},
+		ssmParameters:   resourceSet{},
+		secrets:         resourceSet{},
+		kmsKeys:         resourceSet{},
+	}
+
+	for _, container := range params.TaskDefinition.ContainerDefinitions {
+		g.addImage(aws.StringValue(container.Image))
+
+		for _, secret := range container.Secrets {
+			if err := g.addSecret(aws.StringValue(secret.ValueFrom)); err != nil {
+				return nil, err
+			}
+		}
+
+		if logConfig := container.LogConfiguration; logConfig != nil {
+			if aws.StringValue(logConfig.LogDriver) == awslogsDriver {
+				g.addLogGroup(logConfig.Options)
+			}
+			for _, secret := range logConfig.SecretOptions {
+				if err := g.addSecret(aws.StringValue(secret.ValueFrom)); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if repoCreds := container.RepositoryCredentials; repoCreds != nil {
+			if err := g.addSecret(aws.StringValue(repoCreds.CredentialsParameter)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g.policy(), nil
+}
+
+func (g *executionPolicyGenerator) policy() *PolicyDocument {
+	var statements []StatementEntry
+	addStatement := func(actions []string, resources resourceSet) {
+		if len(resources) > 0 {
+			statements = append(statements, StatementEntry{
+				Effect:   "Allow",
+				Action:   actions,
+				Resource: resources.sorted(),
+			})
+		}
+	}
+
+	if len(g.ecrRepositories) > 0 {
+		// GetAuthorizationToken can not be limited to specific repositories
+		addStatement([]string{"ecr:GetAuthorizationToken"}, resourceSet{"*": true})
+	}
+	addStatement([]string{"ecr:BatchCheckLayerAvailability", "ecr:GetDownloadUrlForLayer", "ecr:BatchGetImage"}, g.ecrRepositories)
+	addStatement([]string{"logs:CreateLogGroup"}, g.newLogGroups)
+	addStatement([]string{"logs:CreateLogStream", "logs:PutLogEvents"}, g.logGroups)
+	addStatement([]string{"ssm:GetParameters"}, g.ssmParameters)
+	addStatement([]string{"secretsmanager:GetSecretValue"}, g.secrets)
+	addStatement([]string{"kms:Decrypt"}, g.kmsKeys)
+
+	return &PolicyDocument{
+		Version:   rolePolicyVersion,
+		Statement: statements,
+	}
+}
+
+func (g *executionPolicyGenerator) addImage(image string) {
+	matches := ecrImageRegexp.FindStringSubmatch(image)
+	if matches == nil {
+		return
+	}
+	accountID, region, repository := matches[1], matches[2], matches[3]
+	repositoryARN := arn.ARN{
+		Partition: utils.GetPartition(region),
+		Service:   "ecr",
+		Region:    region,
+		AccountID: accountID,
+		Resource:  "repository/" + repository,
+	}
+	g.ecrRepositories[repositoryARN.String()] = true
+}
+
+func (g *executionPolicyGenerator) addLogGroup(options map[string]*string) {
+	logGroup := aws.StringValue(options[awslogsGroupOption])
+	if logGroup == "" {
+		return
+	}
+	region := aws.StringValue(options[awslogsRegionOption])
+	if region == "" {
+		region = g.region
+	}
+	logGroupARN := arn.ARN{
+		Partition: utils.GetPartition(region),
+		Service:   "logs",
+		Region:    region,
+		AccountID: g.accountID,
+		Resource:  "log-group:" + logGroup + ":*",
+	}
+	g.logGroups[logGroupARN.String()] = true
+	if aws.StringValue(options[awslogsCreateGroupOption]) == "true" {
+		g.newLogGroups[logGroupARN.String()] = true
+	}
+}
+
+// addSecret adds a Secrets Manager secret or SSM parameter, and the KMS key
+// it is encrypted with if that is not the default key. Parameters may be
+// referred to by name if they are in the same region as the task.
+func (g *executionPolicyGenerator) addSecret(valueFrom string) error {
+	if valueFrom == "" {
+		return nil
+	}
+
+	secretARN, err := arn.Parse(valueFrom)
+	if err != nil {
+		parameterARN := arn.ARN{
+			Partition: g.partition,
+			Service:   "ssm",
+			Region:    g.region,
+			AccountID: g.accountID,
+			Resource:  "parameter/" + strings.TrimPrefix(valueFrom, "/"),
+		}
+		g.ssmParameters[parameterARN.String()] = true
+		return g.addParameterKey(valueFrom)
+	}
+
+	switch secretARN.Service {
+	case "ssm":
+		g.ssmParameters[valueFrom] = true
+		if !g.canLookUpKey(secretARN) {
+			return nil
+		}
+		return g.addParameterKey(parameterName(secretARN.Resource))
+	case "secretsmanager":
+		// A secret can be referenced with a JSON key, version stage or
+		// version ID after its ARN, which are not part of the resource
+		sections := strings.Split(valueFrom, ":")
+		if len(sections) > secretsManagerARNSections {
+			valueFrom = strings.Join(sections[:secretsManagerARNSections], ":")
+		}
+		g.secrets[valueFrom] = true
+		if !g.canLookUpKey(secretARN) {
+			return nil
+		}
+		return g.addSecretKey(valueFrom)
+	default:
+		return fmt.Errorf("Unable to create a task execution role for secret %s: only SSM parameters and Secrets Manager secrets are supported", valueFrom)
+	}
+}
+
+// canLookUpKey returns whether the KMS key of a secret can be found, which is
+// only possible for secrets in the same region and account as the task
+func (g *executionPolicyGenerator) canLookUpKey(secretARN arn.ARN) bool {
+	if secretARN.Region != g.region || secretARN.AccountID != g.accountID {
+		log.WithFields(log.Fields{
+			"secret": secretARN.String(),
+		}).Warn("Unable to find the KMS key of a secret in another region or account; if it is not encrypted with the default key, add kms:Decrypt permission for its key to the task execution role")
+		return false
+	}
+	return true
+}
+
+func (g *executionPolicyGenerator) addParameterKey(name string) error {
+	parameter, err := g.clients.SSM.DescribeParameter(name)
+	if err != nil {
+		return err
+	}
+	keyID := aws.StringValue(parameter.KeyId)
+	if aws.StringValue(parameter.Type) != ssm.ParameterTypeSecureString || keyID == "" || keyID == defaultSSMKeyAlias {
+		return nil
+	}
+	return g.addKey(keyID)
+}
+
+func (g *executionPolicyGenerator) addSecretKey(secretARN string) error {
+	secret, err := g.clients.SecretsManager.DescribeSecret(secretARN)
+	if err != nil {
+		return err
+	}
+	// secrets encrypted with the default key have no KmsKeyId
+	if keyID := aws.StringValue(secret.KmsKeyId); keyID != "" {
+		return g.addKey(keyID)
+	}
+	return nil
+}
+
+func (g *executionPolicyGenerator) addKey(keyID string) error {
+	keyARN, err := g.clients.KMS.GetValidKeyARN(keyID)
+	if err != nil {
+		return err
+	}
+	g.kmsKeys[keyARN] = true
+	return nil
+}
+
+// parameterName returns the name of a parameter from the resource section of
+// its ARN. Parameters in a hierarchy have names which start with a "/".
+func parameterName(resource string) string {
+	name := strings.TrimPrefix(resource, "parameter")
+	if strings.Count(name, "/") == 1 {
+		return strings.TrimPrefix(name, "/")
+	}
+	return name
+}
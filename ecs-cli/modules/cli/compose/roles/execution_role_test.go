@@ -0,0 +1,274 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package roles
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/iam/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/kms/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/secretsmanager/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ssm/mock"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testProjectName = "hello"
+	testRegion      = "us-west-2"
+	testAccountID   = "123456789012"
+)
+
+type mockClients struct {
+	IAM            *mock_iam.MockClient
+	KMS            *mock_kms.MockClient
+	SecretsManager *mock_secretsmanager.MockSMClient
+	SSM            *mock_ssm.MockClient
+}
+
+func (m *mockClients) clients() ExecutionRoleClients {
+	return ExecutionRoleClients{
+		IAM:            m.IAM,
+		KMS:            m.KMS,
+		SecretsManager: m.SecretsManager,
+		SSM:            m.SSM,
+	}
+}
+
+func TestGenerateExecutionRolePolicy(t *testing.T) {
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:  aws.String("web"),
+				Image: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/web:latest"),
+				LogConfiguration: &ecs.LogConfiguration{
+					LogDriver: aws.String("awslogs"),
+					Options: map[string]*string{
+						"awslogs-group":        aws.String("hello-web"),
+						"awslogs-create-group": aws.String("true"),
+					},
+				},
+				Secrets: []*ecs.Secret{
+					{Name: aws.String("DB_PASSWORD"), ValueFrom: aws.String("/prod/db_password")},
+					{Name: aws.String("API_KEY"), ValueFrom: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:api-AbCdEf:key::")},
+				},
+			},
+			{
+				Name:  aws.String("worker"),
+				Image: aws.String("210987654321.dkr.ecr.eu-west-1.amazonaws.com/tools/worker@sha256:abc"),
+				LogConfiguration: &ecs.LogConfiguration{
+					LogDriver: aws.String("awslogs"),
+					Options: map[string]*string{
+						"awslogs-group":  aws.String("hello-worker"),
+						"awslogs-region": aws.String("eu-west-1"),
+					},
+				},
+				Secrets: []*ecs.Secret{
+					{Name: aws.String("TOKEN"), ValueFrom: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/token")},
+				},
+				RepositoryCredentials: &ecs.RepositoryCredentials{
+					CredentialsParameter: aws.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:dockerhub-AbCdEf"),
+				},
+			},
+			{
+				Name:  aws.String("proxy"),
+				Image: aws.String("nginx"),
+			},
+		},
+	}
+
+	mocks := setupTestController(t)
+	mocks.SSM.EXPECT().DescribeParameter("/prod/db_password").Return(&ssm.ParameterMetadata{
+		Type:  aws.String(ssm.ParameterTypeSecureString),
+		KeyId: aws.String("alias/prod"),
+	}, nil)
+	mocks.SSM.EXPECT().DescribeParameter("token").Return(&ssm.ParameterMetadata{
+		Type:  aws.String(ssm.ParameterTypeSecureString),
+		KeyId: aws.String("alias/aws/ssm"),
+	}, nil)
+	mocks.SecretsManager.EXPECT().DescribeSecret("arn:aws:secretsmanager:us-west-2:123456789012:secret:api-AbCdEf").Return(&secretsmanager.DescribeSecretOutput{}, nil)
+	mocks.KMS.EXPECT().GetValidKeyARN("alias/prod").Return("arn:aws:kms:us-west-2:123456789012:key/prod", nil)
+
+	policy, err := generateExecutionRolePolicy(ExecutionRoleParams{
+		ProjectName:    testProjectName,
+		Region:         testRegion,
+		AccountID:      testAccountID,
+		TaskDefinition: taskDefinition,
+	}, mocks.clients())
+
+	expectedStatements := []StatementEntry{
+		{
+			Effect:   "Allow",
+			Action:   []string{"ecr:GetAuthorizationToken"},
+			Resource: []string{"*"},
+		},
+		{
+			Effect: "Allow",
+			Action: []string{"ecr:BatchCheckLayerAvailability", "ecr:GetDownloadUrlForLayer", "ecr:BatchGetImage"},
+			Resource: []string{
+				"arn:aws:ecr:eu-west-1:210987654321:repository/tools/worker",
+				"arn:aws:ecr:us-west-2:123456789012:repository/web",
+			},
+		},
+		{
+			Effect:   "Allow",
+			Action:   []string{"logs:CreateLogGroup"},
+			Resource: []string{"arn:aws:logs:us-west-2:123456789012:log-group:hello-web:*"},
+		},
+		{
+			Effect: "Allow",
+			Action: []string{"logs:CreateLogStream", "logs:PutLogEvents"},
+			Resource: []string{
+				"arn:aws:logs:eu-west-1:123456789012:log-group:hello-worker:*",
+				"arn:aws:logs:us-west-2:123456789012:log-group:hello-web:*",
+			},
+		},
+		{
+			Effect: "Allow",
+			Action: []string{"ssm:GetParameters"},
+			Resource: []string{
+				"arn:aws:ssm:us-west-2:123456789012:parameter/prod/db_password",
+				"arn:aws:ssm:us-west-2:123456789012:parameter/token",
+			},
+		},
+		{
+			Effect: "Allow",
+			Action: []string{"secretsmanager:GetSecretValue"},
+			Resource: []string{
+				"arn:aws:secretsmanager:us-east-1:123456789012:secret:dockerhub-AbCdEf",
+				"arn:aws:secretsmanager:us-west-2:123456789012:secret:api-AbCdEf",
+			},
+		},
+		{
+			Effect:   "Allow",
+			Action:   []string{"kms:Decrypt"},
+			Resource: []string{"arn:aws:kms:us-west-2:123456789012:key/prod"},
+		},
+	}
+	if assert.NoError(t, err, "Unexpected error when generating policy") {
+		assert.Equal(t, rolePolicyVersion, policy.Version)
+		assert.Equal(t, expectedStatements, policy.Statement)
+	}
+}
+
+func TestGenerateExecutionRolePolicy_UnsupportedSecret(t *testing.T) {
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:    aws.String("web"),
+				Image:   aws.String("nginx"),
+				Secrets: []*ecs.Secret{{Name: aws.String("CONFIG"), ValueFrom: aws.String("arn:aws:s3:::bucket/config")}},
+			},
+		},
+	}
+
+	mocks := setupTestController(t)
+	_, err := generateExecutionRolePolicy(ExecutionRoleParams{
+		Region:         testRegion,
+		AccountID:      testAccountID,
+		TaskDefinition: taskDefinition,
+	}, mocks.clients())
+	assert.Error(t, err, "Expected error for secret which is not in SSM or Secrets Manager")
+}
+
+func TestCreateOrUpdateExecutionRole_NewRole(t *testing.T) {
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:  aws.String("web"),
+				Image: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/web"),
+			},
+		},
+	}
+	roleName := ExecutionRoleName(testProjectName)
+	roleARN := "arn:aws:iam::123456789012:role/" + roleName
+	tags := []*ecs.Tag{{Key: aws.String("team"), Value: aws.String("green")}}
+	expectedTags := []*iam.Tag{
+		{Key: aws.String(ProjectTagKey), Value: aws.String(testProjectName)},
+		{Key: aws.String("team"), Value: aws.String("green")},
+	}
+
+	mocks := setupTestController(t)
+	gomock.InOrder(
+		mocks.IAM.EXPECT().CreateOrFindRole(roleName, gomock.Any(), assumeRolePolicyDocString, expectedTags).Return(roleARN, nil),
+		mocks.IAM.EXPECT().PutRolePolicy(gomock.Any()).Do(func(input iam.PutRolePolicyInput) {
+			assert.Equal(t, roleName, aws.StringValue(input.RoleName))
+			assert.Equal(t, executionRolePolicyName, aws.StringValue(input.PolicyName))
+			policy := PolicyDocument{}
+			assert.NoError(t, json.Unmarshal([]byte(aws.StringValue(input.PolicyDocument)), &policy))
+			assert.Len(t, policy.Statement, 2, "Expected statements for pulling the ECR image")
+		}).Return(&iam.PutRolePolicyOutput{}, nil),
+	)
+
+	actualARN, err := CreateOrUpdateExecutionRole(ExecutionRoleParams{
+		ProjectName:    testProjectName,
+		Region:         testRegion,
+		AccountID:      testAccountID,
+		TaskDefinition: taskDefinition,
+		Tags:           tags,
+	}, mocks.clients())
+	assert.NoError(t, err, "Unexpected error when creating execution role")
+	assert.Equal(t, roleARN, actualARN)
+}
+
+func TestCreateOrUpdateExecutionRole_NothingNeeded(t *testing.T) {
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:  aws.String("web"),
+				Image: aws.String("nginx"),
+			},
+		},
+	}
+
+	mocks := setupTestController(t)
+	roleARN, err := CreateOrUpdateExecutionRole(ExecutionRoleParams{
+		ProjectName:    testProjectName,
+		Region:         testRegion,
+		AccountID:      testAccountID,
+		TaskDefinition: taskDefinition,
+	}, mocks.clients())
+	assert.NoError(t, err, "Unexpected error when creating execution role")
+	assert.Empty(t, roleARN, "Expected no role to be created")
+}
+
+func TestExecutionRoleName(t *testing.T) {
+	assert.Equal(t, "amazon-ecs-cli-setup-hello-execution-role", ExecutionRoleName(testProjectName))
+
+	longName := ExecutionRoleName(strings.Repeat("a", 100))
+	assert.Len(t, longName, maxRoleNameLength, "Expected role name to be shortened")
+	assert.True(t, strings.HasSuffix(longName, "-execution-role"))
+}
+
+func TestParameterName(t *testing.T) {
+	assert.Equal(t, "token", parameterName("parameter/token"))
+	assert.Equal(t, "/prod/db_password", parameterName("parameter/prod/db_password"))
+}
+
+func setupTestController(t *testing.T) *mockClients {
+	ctrl := gomock.NewController(t)
+	return &mockClients{
+		IAM:            mock_iam.NewMockClient(ctrl),
+		KMS:            mock_kms.NewMockClient(ctrl),
+		SecretsManager: mock_secretsmanager.NewMockSMClient(ctrl),
+		SSM:            mock_ssm.NewMockClient(ctrl),
+	}
+}
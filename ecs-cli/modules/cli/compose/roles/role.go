@@ -0,0 +1,246 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package roles creates and updates the IAM roles used by the task definition
+// of a compose project, with policies generated from the project itself.
+package roles
+
+import (
+	"encoding/json"
+	"fmt"
+
+	iamClient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/iam"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/iam"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	assumeRolePolicyDocString = `{"Version":"2008-10-17","Statement":[{"Sid":"","Effect":"Allow","Principal":{"Service":"ecs-tasks.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+	rolePolicyVersion         = "2012-10-17"
+
+	// ProjectTagKey is the tag which records the compose project that a role was created for
+	ProjectTagKey = "ecs-cli:compose-project"
+
+	// maxRoleNameLength is the longest name IAM accepts for a role
+	maxRoleNameLength = 64
+)
+
+// PolicyDocument contains the statements that make up an IAM policy
+type PolicyDocument struct {
+	Version   string
+	Statement []StatementEntry
+}
+
+// StatementEntry contains a set of actions, the resources they apply to and
+// the conditions under which they apply
+type StatementEntry struct {
+	Effect    string
+	Action    []string
+	Resource  []string
+	Condition map[string]map[string][]string `json:",omitempty"`
+}
+
+type roleParams struct {
+	ProjectName    string
+	RoleName       string
+	Description    string
+	PolicyName     string
+	PolicyDocument PolicyDocument
+	Tags           []*iam.Tag
+}
+
+// createOrUpdateRole creates the role if it does not already exist, and
+// replaces its tags and inline policy, so that an existing role is brought up
+// to date with the current policy. An existing role is only updated if it is
+// tagged with the compose project. The inline policy is removed if it has no
+// statements. It returns the ARN of the role.
+func createOrUpdateRole(params roleParams, client iamClient.Client) (string, error) {
+	roleARN, err := client.CreateOrFindRole(params.RoleName, params.Description, assumeRolePolicyDocString, params.Tags)
+	if err != nil {
+		return "", err
+	}
+
+	if roleARN != "" {
+		log.Infof("Created new role %s", roleARN)
+	} else {
+		tags, err := client.ListRoleTags(params.RoleName)
+		if err != nil {
+			return "", err
+		}
+		if !hasProjectTag(tags, params.ProjectName) {
+			return "", fmt.Errorf("Role %s already exists and was not created by the ecs-cli for project %s; it must be deleted or renamed before the ecs-cli can manage it", params.RoleName, params.ProjectName)
+		}
+
+		roleResult, err := client.GetRole(params.RoleName)
+		if err != nil {
+			return "", err
+		}
+		roleARN = aws.StringValue(roleResult.Role.Arn)
+		log.Infof("Updating existing role %s", roleARN)
+
+		if _, err = client.TagRole(params.RoleName, params.Tags); err != nil {
+			return "", err
+		}
+	}
+
+	if len(params.PolicyDocument.Statement) == 0 {
+		if err = deleteInlinePolicy(params.RoleName, params.PolicyName, client); err != nil {
+			return "", err
+		}
+		return roleARN, nil
+	}
+
+	policyBytes, err := json.Marshal(&params.PolicyDocument)
+	if err != nil {
+		return "", err
+	}
+	_, err = client.PutRolePolicy(iam.PutRolePolicyInput{
+		RoleName:       aws.String(params.RoleName),
+		PolicyName:     aws.String(params.PolicyName),
+		PolicyDocument: aws.String(string(policyBytes)),
+	})
+	if err != nil {
+		return "", err
+	}
+	log.Infof("Updated policy %s of role %s", params.PolicyName, params.RoleName)
+
+	return roleARN, nil
+}
+
+// DeleteRoles deletes the task role and task execution role created for a
+// compose project, if they exist
+func DeleteRoles(projectName string, client iamClient.Client) error {
+	if err := deleteRole(TaskRoleName(projectName), taskRolePolicyName, projectName, client); err != nil {
+		return err
+	}
+	return deleteRole(ExecutionRoleName(projectName), executionRolePolicyName, projectName, client)
+}
+
+// syncManagedPolicies attaches the given managed policies to the role, and
+// detaches any others, so that the role has exactly the managed policies given
+func syncManagedPolicies(roleName string, policyARNs []string, client iamClient.Client) error {
+	attached, err := client.ListAttachedRolePolicies(roleName)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]bool)
+	for _, policyARN := range policyARNs {
+		wanted[policyARN] = true
+	}
+	for _, policy := range attached {
+		policyARN := aws.StringValue(policy.PolicyArn)
+		if wanted[policyARN] {
+			delete(wanted, policyARN)
+			continue
+		}
+		if _, err = client.DetachRolePolicy(policyARN, roleName); err != nil {
+			return err
+		}
+		log.Infof("Detached policy %s from role %s", policyARN, roleName)
+	}
+
+	for _, policyARN := range policyARNs {
+		if !wanted[policyARN] {
+			continue
+		}
+		if _, err = client.AttachRolePolicy(policyARN, roleName); err != nil {
+			return err
+		}
+		delete(wanted, policyARN)
+		log.Infof("Attached policy %s to role %s", policyARN, roleName)
+	}
+	return nil
+}
+
+// deleteRole deletes a role created for a compose project along with its
+// policies. Roles which do not exist, or which are not tagged with the
+// project, are left alone.
+func deleteRole(roleName, policyName, projectName string, client iamClient.Client) error {
+	tags, err := client.ListRoleTags(roleName)
+	if utils.NoSuchEntity(err) {
+		log.Infof("Role %s does not exist", roleName)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !hasProjectTag(tags, projectName) {
+		log.Warnf("Not deleting role %s; it was not created by the ecs-cli for project %s", roleName, projectName)
+		return nil
+	}
+
+	if err = syncManagedPolicies(roleName, nil, client); err != nil {
+		return err
+	}
+	if err = deleteInlinePolicy(roleName, policyName, client); err != nil {
+		return err
+	}
+	if _, err = client.DeleteRole(roleName); err != nil {
+		return err
+	}
+	log.Infof("Deleted role %s", roleName)
+	return nil
+}
+
+// deleteInlinePolicy deletes an inline policy of a role, if it exists
+func deleteInlinePolicy(roleName, policyName string, client iamClient.Client) error {
+	_, err := client.DeleteRolePolicy(roleName, policyName)
+	if err != nil && !utils.NoSuchEntity(err) {
+		return err
+	}
+	return nil
+}
+
+func hasProjectTag(tags []*iam.Tag, projectName string) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == ProjectTagKey {
+			return aws.StringValue(tag.Value) == projectName
+		}
+	}
+	return false
+}
+
+// roleName returns the name of a role created by the ecs-cli for a compose
+// project, shortening the project name if needed to fit in an IAM role name
+func roleName(projectName, suffix string) string {
+	maxProjectNameLength := maxRoleNameLength - len(utils.ECSCLIResourcePrefix) - len(suffix) - 1
+	if len(projectName) > maxProjectNameLength {
+		projectName = projectName[:maxProjectNameLength]
+	}
+	return utils.ECSCLIResourcePrefix + projectName + "-" + suffix
+}
+
+// roleTags returns the tags of a role created for a compose project, which
+// include the resource tags specified for the project
+func roleTags(projectName string, tags []*ecs.Tag) []*iam.Tag {
+	iamTags := []*iam.Tag{
+		{
+			Key:   aws.String(ProjectTagKey),
+			Value: aws.String(projectName),
+		},
+	}
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == ProjectTagKey {
+			continue
+		}
+		iamTags = append(iamTags, &iam.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	return iamTags
+}
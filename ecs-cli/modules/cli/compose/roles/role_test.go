@@ -0,0 +1,102 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package roles
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateOrUpdateRole_ExistingRole(t *testing.T) {
+	roleName := "amazon-ecs-cli-setup-hello-execution-role"
+	roleARN := "arn:aws:iam::123456789012:role/" + roleName
+	tags := roleTags(testProjectName, nil)
+
+	mocks := setupTestController(t)
+	gomock.InOrder(
+		mocks.IAM.EXPECT().CreateOrFindRole(roleName, "description", assumeRolePolicyDocString, tags).Return("", nil),
+		mocks.IAM.EXPECT().ListRoleTags(roleName).Return(tags, nil),
+		mocks.IAM.EXPECT().GetRole(roleName).Return(&iam.GetRoleOutput{Role: &iam.Role{Arn: aws.String(roleARN)}}, nil),
+		mocks.IAM.EXPECT().TagRole(roleName, tags).Return(&iam.TagRoleOutput{}, nil),
+		mocks.IAM.EXPECT().PutRolePolicy(iam.PutRolePolicyInput{
+			RoleName:       aws.String(roleName),
+			PolicyName:     aws.String("policy"),
+			PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}]}`),
+		}).Return(&iam.PutRolePolicyOutput{}, nil),
+	)
+
+	actualARN, err := createOrUpdateRole(roleParams{
+		ProjectName: testProjectName,
+		RoleName:    roleName,
+		Description: "description",
+		PolicyName:  "policy",
+		PolicyDocument: PolicyDocument{
+			Version: rolePolicyVersion,
+			Statement: []StatementEntry{
+				{Effect: "Allow", Action: []string{"s3:GetObject"}, Resource: []string{"*"}},
+			},
+		},
+		Tags: tags,
+	}, mocks.IAM)
+	assert.NoError(t, err, "Unexpected error when updating role")
+	assert.Equal(t, roleARN, actualARN, "Expected ARN of existing role")
+}
+
+func TestCreateOrUpdateRole_RoleOfOtherProject(t *testing.T) {
+	roleName := "amazon-ecs-cli-setup-hello-execution-role"
+
+	mocks := setupTestController(t)
+	gomock.InOrder(
+		mocks.IAM.EXPECT().CreateOrFindRole(roleName, gomock.Any(), assumeRolePolicyDocString, gomock.Any()).Return("", nil),
+		mocks.IAM.EXPECT().ListRoleTags(roleName).Return(roleTags("other", nil), nil),
+	)
+
+	_, err := createOrUpdateRole(roleParams{
+		ProjectName: testProjectName,
+		RoleName:    roleName,
+		Tags:        roleTags(testProjectName, nil),
+	}, mocks.IAM)
+	assert.Error(t, err, "Expected error when role belongs to another project")
+	assert.Contains(t, err.Error(), "was not created by the ecs-cli for project "+testProjectName)
+}
+
+func TestCreateOrUpdateRole_UntaggedRole(t *testing.T) {
+	roleName := "amazon-ecs-cli-setup-hello-execution-role"
+
+	mocks := setupTestController(t)
+	gomock.InOrder(
+		mocks.IAM.EXPECT().CreateOrFindRole(roleName, gomock.Any(), assumeRolePolicyDocString, gomock.Any()).Return("", nil),
+		mocks.IAM.EXPECT().ListRoleTags(roleName).Return(nil, nil),
+	)
+
+	_, err := createOrUpdateRole(roleParams{
+		ProjectName: testProjectName,
+		RoleName:    roleName,
+		Tags:        roleTags(testProjectName, nil),
+	}, mocks.IAM)
+	assert.Error(t, err, "Expected error when role is not tagged with the project")
+}
+
+func TestCreateOrUpdateRole_ErrorCase(t *testing.T) {
+	mocks := setupTestController(t)
+	mocks.IAM.EXPECT().CreateOrFindRole(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("something went wrong"))
+
+	_, err := createOrUpdateRole(roleParams{RoleName: "role"}, mocks.IAM)
+	assert.Error(t, err, "Expected error when role can not be created")
+}
@@ -0,0 +1,95 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package roles
+
+import (
+	"fmt"
+
+	iamClient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/iam"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+const (
+	taskRoleNameSuffix  = "task-role"
+	taskRolePolicyName  = "ecs-cli-task-role-policy"
+	taskRoleDescription = "Task role generated by the ecs-cli for compose project %s"
+	defaultPolicyEffect = "Allow"
+)
+
+// TaskRoleParams contains the values needed to create the task role of a compose project
+type TaskRoleParams struct {
+	ProjectName string
+	Policies    *composeutils.TaskRolePolicies
+	Tags        []*ecs.Tag
+}
+
+// CreateOrUpdateTaskRole creates a task role for a compose project, or updates
+// the one created before, so that its inline policy and attached managed
+// policies match the task_role_policies in the ecs-params file. It returns
+// the ARN of the role.
+func CreateOrUpdateTaskRole(params TaskRoleParams, client iamClient.Client) (string, error) {
+	roleName := TaskRoleName(params.ProjectName)
+	roleARN, err := createOrUpdateRole(roleParams{
+		ProjectName:    params.ProjectName,
+		RoleName:       roleName,
+		Description:    fmt.Sprintf(taskRoleDescription, params.ProjectName),
+		PolicyName:     taskRolePolicyName,
+		PolicyDocument: taskRolePolicy(params.Policies),
+		Tags:           roleTags(params.ProjectName, params.Tags),
+	}, client)
+	if err != nil {
+		return "", err
+	}
+
+	if err = syncManagedPolicies(roleName, params.Policies.ManagedPolicyArns, client); err != nil {
+		return "", err
+	}
+	return roleARN, nil
+}
+
+// TaskRoleName returns the name of the task role created for a compose project
+func TaskRoleName(projectName string) string {
+	return roleName(projectName, taskRoleNameSuffix)
+}
+
+// taskRolePolicy converts the statements in the ecs-params file into an IAM policy
+func taskRolePolicy(policies *composeutils.TaskRolePolicies) PolicyDocument {
+	var statements []StatementEntry
+	for _, statement := range policies.Statements {
+		effect := statement.Effect
+		if effect == "" {
+			effect = defaultPolicyEffect
+		}
+		entry := StatementEntry{
+			Effect:   effect,
+			Action:   statement.Actions,
+			Resource: statement.Resources,
+		}
+		if len(statement.Condition) > 0 {
+			entry.Condition = make(map[string]map[string][]string)
+			for operator, values := range statement.Condition {
+				entry.Condition[operator] = make(map[string][]string)
+				for key, value := range values {
+					entry.Condition[operator][key] = value
+				}
+			}
+		}
+		statements = append(statements, entry)
+	}
+	return PolicyDocument{
+		Version:   rolePolicyVersion,
+		Statement: statements,
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package roles
+
+import (
+	"encoding/json"
+	"testing"
+
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	libYaml "github.com/docker/libcompose/yaml"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	s3ReadOnlyPolicyARN = "arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"
+	sqsFullPolicyARN    = "arn:aws:iam::aws:policy/AmazonSQSFullAccess"
+	dynamoDBPolicyARN   = "arn:aws:iam::aws:policy/AmazonDynamoDBReadOnlyAccess"
+)
+
+func TestCreateOrUpdateTaskRole(t *testing.T) {
+	roleName := TaskRoleName(testProjectName)
+	roleARN := "arn:aws:iam::123456789012:role/" + roleName
+	policies := &composeutils.TaskRolePolicies{
+		ManagedPolicyArns: []string{s3ReadOnlyPolicyARN, sqsFullPolicyARN},
+		Statements: []composeutils.PolicyStatement{
+			{
+				Actions:   libYaml.Stringorslice{"sqs:SendMessage"},
+				Resources: libYaml.Stringorslice{"arn:aws:sqs:us-west-2:123456789012:jobs"},
+			},
+		},
+	}
+
+	mocks := setupTestController(t)
+	gomock.InOrder(
+		mocks.IAM.EXPECT().CreateOrFindRole(roleName, gomock.Any(), assumeRolePolicyDocString, roleTags(testProjectName, nil)).Return("", nil),
+		mocks.IAM.EXPECT().ListRoleTags(roleName).Return(roleTags(testProjectName, nil), nil),
+		mocks.IAM.EXPECT().GetRole(roleName).Return(&iam.GetRoleOutput{Role: &iam.Role{Arn: aws.String(roleARN)}}, nil),
+		mocks.IAM.EXPECT().TagRole(roleName, gomock.Any()).Return(&iam.TagRoleOutput{}, nil),
+		mocks.IAM.EXPECT().PutRolePolicy(iam.PutRolePolicyInput{
+			RoleName:       aws.String(roleName),
+			PolicyName:     aws.String(taskRolePolicyName),
+			PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sqs:SendMessage"],"Resource":["arn:aws:sqs:us-west-2:123456789012:jobs"]}]}`),
+		}).Return(&iam.PutRolePolicyOutput{}, nil),
+		mocks.IAM.EXPECT().ListAttachedRolePolicies(roleName).Return([]*iam.AttachedPolicy{
+			{PolicyArn: aws.String(dynamoDBPolicyARN)},
+			{PolicyArn: aws.String(s3ReadOnlyPolicyARN)},
+		}, nil),
+		mocks.IAM.EXPECT().DetachRolePolicy(dynamoDBPolicyARN, roleName).Return(&iam.DetachRolePolicyOutput{}, nil),
+		mocks.IAM.EXPECT().AttachRolePolicy(sqsFullPolicyARN, roleName).Return(&iam.AttachRolePolicyOutput{}, nil),
+	)
+
+	actualARN, err := CreateOrUpdateTaskRole(TaskRoleParams{
+		ProjectName: testProjectName,
+		Policies:    policies,
+	}, mocks.IAM)
+	assert.NoError(t, err, "Unexpected error when updating task role")
+	assert.Equal(t, roleARN, actualARN)
+}
+
+func TestCreateOrUpdateTaskRole_OnlyManagedPolicies(t *testing.T) {
+	roleName := TaskRoleName(testProjectName)
+	roleARN := "arn:aws:iam::123456789012:role/" + roleName
+
+	mocks := setupTestController(t)
+	gomock.InOrder(
+		mocks.IAM.EXPECT().CreateOrFindRole(roleName, gomock.Any(), assumeRolePolicyDocString, gomock.Any()).Return(roleARN, nil),
+		mocks.IAM.EXPECT().DeleteRolePolicy(roleName, taskRolePolicyName).Return(nil, awserr.New("NoSuchEntity", "not found", nil)),
+		mocks.IAM.EXPECT().ListAttachedRolePolicies(roleName).Return(nil, nil),
+		mocks.IAM.EXPECT().AttachRolePolicy(s3ReadOnlyPolicyARN, roleName).Return(&iam.AttachRolePolicyOutput{}, nil),
+	)
+
+	actualARN, err := CreateOrUpdateTaskRole(TaskRoleParams{
+		ProjectName: testProjectName,
+		Policies: &composeutils.TaskRolePolicies{
+			ManagedPolicyArns: []string{s3ReadOnlyPolicyARN},
+		},
+	}, mocks.IAM)
+	assert.NoError(t, err, "Unexpected error when creating task role")
+	assert.Equal(t, roleARN, actualARN)
+}
+
+func TestTaskRolePolicy(t *testing.T) {
+	policy := taskRolePolicy(&composeutils.TaskRolePolicies{
+		Statements: []composeutils.PolicyStatement{
+			{
+				Effect:    "Deny",
+				Actions:   libYaml.Stringorslice{"s3:DeleteObject"},
+				Resources: libYaml.Stringorslice{"*"},
+				Condition: map[string]map[string]libYaml.Stringorslice{
+					"StringNotEquals": {"aws:SourceVpc": {"vpc-1234"}},
+				},
+			},
+		},
+	})
+
+	policyBytes, err := json.Marshal(&policy)
+	assert.NoError(t, err, "Unexpected error when marshalling policy")
+	assert.Equal(t, `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":["s3:DeleteObject"],"Resource":["*"],"Condition":{"StringNotEquals":{"aws:SourceVpc":["vpc-1234"]}}}]}`, string(policyBytes))
+}
+
+func TestDeleteRoles(t *testing.T) {
+	taskRoleName := TaskRoleName(testProjectName)
+	executionRoleName := ExecutionRoleName(testProjectName)
+
+	mocks := setupTestController(t)
+	gomock.InOrder(
+		mocks.IAM.EXPECT().ListRoleTags(taskRoleName).Return(roleTags(testProjectName, nil), nil),
+		mocks.IAM.EXPECT().ListAttachedRolePolicies(taskRoleName).Return([]*iam.AttachedPolicy{
+			{PolicyArn: aws.String(s3ReadOnlyPolicyARN)},
+		}, nil),
+		mocks.IAM.EXPECT().DetachRolePolicy(s3ReadOnlyPolicyARN, taskRoleName).Return(&iam.DetachRolePolicyOutput{}, nil),
+		mocks.IAM.EXPECT().DeleteRolePolicy(taskRoleName, taskRolePolicyName).Return(&iam.DeleteRolePolicyOutput{}, nil),
+		mocks.IAM.EXPECT().DeleteRole(taskRoleName).Return(&iam.DeleteRoleOutput{}, nil),
+		mocks.IAM.EXPECT().ListRoleTags(executionRoleName).Return(nil, awserr.New("NoSuchEntity", "not found", nil)),
+	)
+
+	err := DeleteRoles(testProjectName, mocks.IAM)
+	assert.NoError(t, err, "Unexpected error when deleting roles")
+}
+
+func TestDeleteRoles_RoleOfOtherProject(t *testing.T) {
+	mocks := setupTestController(t)
+	gomock.InOrder(
+		mocks.IAM.EXPECT().ListRoleTags(TaskRoleName(testProjectName)).Return(roleTags("other", nil), nil),
+		mocks.IAM.EXPECT().ListRoleTags(ExecutionRoleName(testProjectName)).Return(nil, nil),
+	)
+
+	err := DeleteRoles(testProjectName, mocks.IAM)
+	assert.NoError(t, err, "Expected roles which are not tagged with the project to be skipped")
+}
@@ -0,0 +1,104 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package scans checks the vulnerability scan findings of the images of a
+// task definition before it is deployed.
+package scans
+
+import (
+	"fmt"
+	"strings"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/docker/distribution/reference"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// CheckImages checks the latest scan of the image of each container in the
+// task definition. It fails if any image has findings more severe than
+// maxSeverity, or has no completed scan. Since only images in ECR are
+// scanned, images from other registries fail the check too. ECR returns the
+// ECR client for a region, since images may be in a different region than
+// the task.
+func CheckImages(taskDefinition *ecs.TaskDefinition, maxSeverity string, ecr func(region string) ecrclient.Client) error {
+	maxSeverity, err := ecrclient.ParseSeverity(maxSeverity)
+	if err != nil {
+		return err
+	}
+
+	failures := []string{}
+	checked := make(map[string]bool)
+	for _, container := range taskDefinition.ContainerDefinitions {
+		image := aws.StringValue(container.Image)
+		if checked[image] {
+			continue
+		}
+		checked[image] = true
+
+		failure, err := checkImage(image, maxSeverity, ecr)
+		if err != nil {
+			return errors.Wrapf(err, "unable to check the scan findings of the image of container %s", aws.StringValue(container.Name))
+		}
+		if failure != "" {
+			failures = append(failures, failure)
+			continue
+		}
+		log.WithFields(log.Fields{
+			"image":        image,
+			"max-severity": maxSeverity,
+		}).Info("Image passed scan check")
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("refusing to deploy images which fail the scan check:\n  %s", strings.Join(failures, "\n  "))
+	}
+	return nil
+}
+
+// checkImage returns why an image fails the scan check, or an empty string if it passes
+func checkImage(image, maxSeverity string, ecrClient func(region string) ecrclient.Client) (string, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", err
+	}
+	registryID, region, ok := ecrclient.ParseRegistryDomain(reference.Domain(named))
+	if !ok {
+		return fmt.Sprintf("%s is not in Amazon ECR, so it has no scan findings", image), nil
+	}
+
+	imageID := &ecr.ImageIdentifier{}
+	if digested, ok := named.(reference.Digested); ok {
+		imageID.SetImageDigest(digested.Digest().String())
+	} else {
+		imageID.SetImageTag(reference.TagNameOnly(named).(reference.Tagged).Tag())
+	}
+
+	result, err := ecrClient(region).GetImageScanFindings(registryID, reference.Path(named), imageID)
+	if err != nil {
+		return "", err
+	}
+	if result == nil {
+		return fmt.Sprintf("%s has not been scanned", image), nil
+	}
+	if !result.Complete() {
+		return fmt.Sprintf("%s has no completed scan (scan status is %s)", image, result.Status), nil
+	}
+	if count := result.CountAbove(maxSeverity); count > 0 {
+		return fmt.Sprintf("%s has %d findings more severe than %s", image, count, maxSeverity), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package scans
+
+import (
+	"errors"
+	"testing"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	mock_ecr "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr/mock"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	ecrImage    = "123456789012.dkr.ecr.us-west-2.amazonaws.com/web:v1"
+	ecrDigest   = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+	otherRegion = "123456789012.dkr.ecr.eu-west-1.amazonaws.com/worker@" + ecrDigest
+)
+
+func taskDefinition(images ...string) *ecs.TaskDefinition {
+	taskDef := &ecs.TaskDefinition{}
+	for i, image := range images {
+		taskDef.ContainerDefinitions = append(taskDef.ContainerDefinitions, &ecs.ContainerDefinition{
+			Name:  aws.String(string(rune('a' + i))),
+			Image: aws.String(image),
+		})
+	}
+	return taskDef
+}
+
+func completeScan(counts map[string]int64) *ecrclient.ImageScanResult {
+	return &ecrclient.ImageScanResult{
+		Status:         ecr.ScanStatusComplete,
+		SeverityCounts: counts,
+	}
+}
+
+func TestCheckImages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	usWest2 := mock_ecr.NewMockClient(ctrl)
+	euWest1 := mock_ecr.NewMockClient(ctrl)
+
+	usWest2.EXPECT().GetImageScanFindings("123456789012", "web", &ecr.ImageIdentifier{ImageTag: aws.String("v1")}).
+		Return(completeScan(map[string]int64{ecr.FindingSeverityHigh: 3}), nil)
+	euWest1.EXPECT().GetImageScanFindings("123456789012", "worker", &ecr.ImageIdentifier{ImageDigest: aws.String(ecrDigest)}).
+		Return(completeScan(map[string]int64{ecr.FindingSeverityLow: 1}), nil)
+
+	clients := map[string]ecrclient.Client{"us-west-2": usWest2, "eu-west-1": euWest1}
+	err := CheckImages(taskDefinition(ecrImage, otherRegion, ecrImage), "high", func(region string) ecrclient.Client {
+		return clients[region]
+	})
+	assert.NoError(t, err, "Expected images to pass the scan check")
+}
+
+func TestCheckImages_Failures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECR := mock_ecr.NewMockClient(ctrl)
+
+	gomock.InOrder(
+		mockECR.EXPECT().GetImageScanFindings("123456789012", "web", gomock.Any()).
+			Return(completeScan(map[string]int64{ecr.FindingSeverityCritical: 2}), nil),
+		mockECR.EXPECT().GetImageScanFindings("123456789012", "api", gomock.Any()).
+			Return(&ecrclient.ImageScanResult{Status: ecr.ScanStatusInProgress}, nil),
+		mockECR.EXPECT().GetImageScanFindings("123456789012", "db", gomock.Any()).
+			Return(nil, nil),
+	)
+
+	err := CheckImages(taskDefinition(
+		ecrImage,
+		"123456789012.dkr.ecr.us-west-2.amazonaws.com/api:v1",
+		"123456789012.dkr.ecr.us-west-2.amazonaws.com/db",
+		"nginx:latest",
+	), "HIGH", func(string) ecrclient.Client { return mockECR })
+	if assert.Error(t, err, "Expected images to fail the scan check") {
+		assert.Contains(t, err.Error(), ecrImage+" has 2 findings more severe than HIGH")
+		assert.Contains(t, err.Error(), "api:v1 has no completed scan (scan status is IN_PROGRESS)")
+		assert.Contains(t, err.Error(), "db has not been scanned")
+		assert.Contains(t, err.Error(), "nginx:latest is not in Amazon ECR")
+	}
+}
+
+func TestCheckImages_InvalidSeverity(t *testing.T) {
+	err := CheckImages(taskDefinition(ecrImage), "severe", nil)
+	assert.Error(t, err, "Expected error for an invalid severity")
+}
+
+func TestCheckImages_ErrorCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECR := mock_ecr.NewMockClient(ctrl)
+
+	mockECR.EXPECT().GetImageScanFindings(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("something failed"))
+
+	err := CheckImages(taskDefinition(ecrImage), "HIGH", func(string) ecrclient.Client { return mockECR })
+	assert.Error(t, err, "Expected error when the scan findings can not be read")
+}
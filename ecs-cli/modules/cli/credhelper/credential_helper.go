@@ -0,0 +1,261 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package credhelper implements the Docker credential helper protocol, so
+// that Docker gets ECR credentials from the ECS CLI. See
+// https://docs.docker.com/engine/reference/commandline/login/#credential-helper-protocol
+package credhelper
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/cache"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+const (
+	// HelperName is the name of the credential helper in the Docker config
+	HelperName = "ecs-cli"
+	// BinaryName is the name which the ECS CLI acts as a credential helper under
+	BinaryName = "docker-credential-" + HelperName
+	// CommandName is the hidden command which implements the credential helper
+	CommandName = "docker-credential-helper"
+
+	// credentialsNotFound is the error message which tells Docker that a
+	// helper has no credentials for a registry
+	credentialsNotFound = "credentials not found in native keychain"
+
+	cacheName = "docker-credentials"
+
+	// refreshMargin is how long before it expires a cached token is replaced
+	refreshMargin = 10 * time.Minute
+)
+
+var newCache = cache.NewFSCache
+
+// Args returns the arguments to run the credential helper command with if
+// the ECS CLI was run as docker-credential-ecs-cli, for example through a
+// symlink, and otherwise returns the arguments unchanged
+func Args(args []string) []string {
+	if len(args) == 0 || strings.TrimSuffix(filepath.Base(args[0]), ".exe") != BinaryName {
+		return args
+	}
+	return append([]string{args[0], CommandName}, args[1:]...)
+}
+
+// credentials are the credentials of a registry in the credential helper protocol
+type credentials struct {
+	ServerURL string
+	Username  string
+	Secret    string
+}
+
+// cachedToken is an ECR authorization token kept in the cache
+type cachedToken struct {
+	Username  string
+	Password  string
+	ExpiresAt time.Time
+}
+
+type helper struct {
+	cache     cache.Cache
+	cacheKey  string
+	ecrClient func(region string) (ecrclient.Client, error)
+	now       func() time.Time
+}
+
+// Get prints the credentials of the registry whose server URL is read from stdin
+func Get(c *cli.Context) {
+	run(c, func(h *helper) error { return h.get(os.Stdin, os.Stdout) })
+}
+
+// Store accepts the credentials read from stdin; they are not kept, since
+// ECR credentials are fetched when they are needed
+func Store(c *cli.Context) {
+	run(c, func(h *helper) error { return h.store(os.Stdin) })
+}
+
+// Erase removes the cached credentials of the registry whose server URL is read from stdin
+func Erase(c *cli.Context) {
+	run(c, func(h *helper) error { return h.erase(os.Stdin) })
+}
+
+// List prints the registries which there are cached credentials for
+func List(c *cli.Context) {
+	run(c, func(h *helper) error { return h.list(os.Stdout) })
+}
+
+// run runs an operation of the credential helper. Docker reads the error of
+// a failed operation from stdout.
+func run(c *cli.Context, operation func(h *helper) error) {
+	h, err := newHelper(c)
+	if err == nil {
+		err = operation(h)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stdout, err)
+		os.Exit(1)
+	}
+}
+
+func newHelper(c *cli.Context) (*helper, error) {
+	tokenCache, err := newCache(cacheName)
+	if err != nil {
+		return nil, err
+	}
+	return &helper{
+		cache:    tokenCache,
+		cacheKey: cacheKey(c),
+		ecrClient: func(region string) (ecrclient.Client, error) {
+			rdwr, err := config.NewReadWriter()
+			if err != nil {
+				return nil, err
+			}
+			commandConfig, err := config.NewCommandConfigWithRegion(c, rdwr, region)
+			if err != nil {
+				return nil, err
+			}
+			return ecrclient.NewClient(commandConfig), nil
+		},
+		now: time.Now,
+	}, nil
+}
+
+// cacheKey returns the key of the tokens of the profile which the ECS CLI
+// uses, so that the tokens of different profiles are cached separately
+func cacheKey(c *cli.Context) string {
+	identity := strings.Join([]string{
+		config.RecursiveFlagSearch(c, flags.ECSProfileFlag),
+		config.RecursiveFlagSearch(c, flags.AWSProfileFlag),
+		os.Getenv(flags.AWSAccessKeyEnvVar),
+	}, "\x00")
+	return fmt.Sprintf("tokens-%x", sha256.Sum256([]byte(identity)))[:len("tokens-")+16]
+}
+
+func (h *helper) get(in io.Reader, out io.Writer) error {
+	serverURL, err := readServerURL(in)
+	if err != nil {
+		return err
+	}
+	registry := registryHost(serverURL)
+	registryID, region, ok := ecrclient.ParseRegistryDomain(registry)
+	if !ok {
+		return errors.New(credentialsNotFound)
+	}
+
+	tokens := h.tokens()
+	token, ok := tokens[registry]
+	if !ok || !h.now().Add(refreshMargin).Before(token.ExpiresAt) {
+		ecrClient, err := h.ecrClient(region)
+		if err != nil {
+			return err
+		}
+		auth, err := ecrClient.GetAuthorizationData(registryID)
+		if err != nil {
+			return err
+		}
+		token = cachedToken{
+			Username:  auth.Username,
+			Password:  auth.Password,
+			ExpiresAt: auth.ExpiresAt,
+		}
+		tokens[registry] = token
+		if err = h.cache.Put(h.cacheKey, tokens); err != nil {
+			log.Debugf("Unable to cache authorization token: %v", err)
+		}
+	}
+
+	return json.NewEncoder(out).Encode(credentials{
+		ServerURL: serverURL,
+		Username:  token.Username,
+		Secret:    token.Password,
+	})
+}
+
+func (h *helper) store(in io.Reader) error {
+	creds := credentials{}
+	if err := json.NewDecoder(in).Decode(&creds); err != nil {
+		return err
+	}
+	log.WithField("registry", creds.ServerURL).Debug("Credentials are not stored; ECR credentials are fetched when they are needed")
+	return nil
+}
+
+func (h *helper) erase(in io.Reader) error {
+	serverURL, err := readServerURL(in)
+	if err != nil {
+		return err
+	}
+	tokens := h.tokens()
+	registry := registryHost(serverURL)
+	if _, ok := tokens[registry]; !ok {
+		return nil
+	}
+	delete(tokens, registry)
+	return h.cache.Put(h.cacheKey, tokens)
+}
+
+func (h *helper) list(out io.Writer) error {
+	registries := make(map[string]string)
+	for registry, token := range h.tokens() {
+		registries[registry] = token.Username
+	}
+	return json.NewEncoder(out).Encode(registries)
+}
+
+// tokens returns the cached tokens, or no tokens if the cache can not be read
+func (h *helper) tokens() map[string]cachedToken {
+	tokens := make(map[string]cachedToken)
+	if err := h.cache.Get(h.cacheKey, &tokens); err != nil {
+		log.Debugf("No cached authorization tokens: %v", err)
+		return make(map[string]cachedToken)
+	}
+	return tokens
+}
+
+func readServerURL(in io.Reader) (string, error) {
+	data, err := ioutil.ReadAll(in)
+	if err != nil {
+		return "", err
+	}
+	serverURL := strings.TrimSpace(string(data))
+	if serverURL == "" {
+		return "", errors.New("no server URL given")
+	}
+	return serverURL, nil
+}
+
+// registryHost returns the host of a server URL, such as https://host/v2/
+func registryHost(serverURL string) string {
+	host := serverURL
+	if i := strings.Index(host, "://"); i >= 0 {
+		host = host[i+len("://"):]
+	}
+	if i := strings.Index(host, "/"); i >= 0 {
+		host = host[:i]
+	}
+	return host
+}
@@ -0,0 +1,183 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package credhelper
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	registry  = "123456789012.dkr.ecr.us-west-2.amazonaws.com"
+	serverURL = "https://" + registry
+)
+
+// memoryCache is a cache.Cache which keeps gob encoded values in memory
+type memoryCache map[string][]byte
+
+func (m memoryCache) Put(key string, value interface{}) error {
+	buf := bytes.Buffer{}
+	if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+		return err
+	}
+	m[key] = buf.Bytes()
+	return nil
+}
+
+func (m memoryCache) Get(key string, i interface{}) error {
+	data, ok := m[key]
+	if !ok {
+		return errors.New("not found")
+	}
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(i)
+}
+
+func setupHelper(t *testing.T, now time.Time) (*helper, *mock_ecr.MockClient, *string) {
+	ctrl := gomock.NewController(t)
+	mockECR := mock_ecr.NewMockClient(ctrl)
+	region := new(string)
+	return &helper{
+		cache:    memoryCache{},
+		cacheKey: "tokens",
+		ecrClient: func(r string) (ecrclient.Client, error) {
+			*region = r
+			return mockECR, nil
+		},
+		now: func() time.Time { return now },
+	}, mockECR, region
+}
+
+func TestArgs(t *testing.T) {
+	assert.Equal(t, []string{"/usr/local/bin/docker-credential-ecs-cli", CommandName, "get"},
+		Args([]string{"/usr/local/bin/docker-credential-ecs-cli", "get"}))
+	assert.Equal(t, []string{"/opt/bin/docker-credential-ecs-cli.exe", CommandName, "list"},
+		Args([]string{"/opt/bin/docker-credential-ecs-cli.exe", "list"}))
+	assert.Equal(t, []string{"ecs-cli", "images"}, Args([]string{"ecs-cli", "images"}))
+	assert.Empty(t, Args(nil))
+}
+
+func TestGet(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	h, mockECR, region := setupHelper(t, now)
+
+	mockECR.EXPECT().GetAuthorizationData("123456789012").Return(&ecrclient.Auth{
+		Username:  "AWS",
+		Password:  "secret",
+		ExpiresAt: now.Add(12 * time.Hour),
+	}, nil)
+
+	out := &bytes.Buffer{}
+	err := h.get(strings.NewReader(serverURL+"/v2/\n"), out)
+	require.NoError(t, err, "Unexpected error getting credentials")
+	assert.Equal(t, "us-west-2", *region, "Expected region of the registry")
+
+	creds := credentials{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &creds))
+	assert.Equal(t, credentials{ServerURL: serverURL + "/v2/", Username: "AWS", Secret: "secret"}, creds)
+
+	// the cached token is used the second time
+	out.Reset()
+	err = h.get(strings.NewReader(registry), out)
+	require.NoError(t, err, "Unexpected error getting cached credentials")
+	require.NoError(t, json.Unmarshal(out.Bytes(), &creds))
+	assert.Equal(t, "secret", creds.Secret)
+}
+
+func TestGetExpiringToken(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	h, mockECR, _ := setupHelper(t, now)
+	h.cache.Put(h.cacheKey, map[string]cachedToken{
+		registry: {Username: "AWS", Password: "old", ExpiresAt: now.Add(time.Minute)},
+	})
+
+	mockECR.EXPECT().GetAuthorizationData("123456789012").Return(&ecrclient.Auth{
+		Username:  "AWS",
+		Password:  "new",
+		ExpiresAt: now.Add(12 * time.Hour),
+	}, nil)
+
+	out := &bytes.Buffer{}
+	err := h.get(strings.NewReader(serverURL), out)
+	require.NoError(t, err, "Unexpected error getting credentials")
+
+	creds := credentials{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &creds))
+	assert.Equal(t, "new", creds.Secret, "Expected token close to expiry to be refreshed")
+}
+
+func TestGetNotECR(t *testing.T) {
+	h, _, _ := setupHelper(t, time.Now())
+
+	err := h.get(strings.NewReader("https://index.docker.io/v1/"), &bytes.Buffer{})
+	assert.EqualError(t, err, credentialsNotFound)
+}
+
+func TestGetErrorCase(t *testing.T) {
+	h, mockECR, _ := setupHelper(t, time.Now())
+	mockECR.EXPECT().GetAuthorizationData("123456789012").Return(nil, errors.New("something failed"))
+
+	err := h.get(strings.NewReader(serverURL), &bytes.Buffer{})
+	assert.Error(t, err, "Expected error getting credentials")
+}
+
+func TestStore(t *testing.T) {
+	h, _, _ := setupHelper(t, time.Now())
+
+	err := h.store(strings.NewReader(`{"ServerURL":"` + serverURL + `","Username":"AWS","Secret":"secret"}`))
+	assert.NoError(t, err, "Unexpected error storing credentials")
+
+	err = h.store(strings.NewReader("not json"))
+	assert.Error(t, err, "Expected error storing invalid credentials")
+}
+
+func TestEraseAndList(t *testing.T) {
+	now := time.Now()
+	h, _, _ := setupHelper(t, now)
+	other := "210987654321.dkr.ecr.eu-west-1.amazonaws.com"
+	h.cache.Put(h.cacheKey, map[string]cachedToken{
+		registry: {Username: "AWS", Password: "secret", ExpiresAt: now.Add(time.Hour)},
+		other:    {Username: "AWS", Password: "secret", ExpiresAt: now.Add(time.Hour)},
+	})
+
+	err := h.erase(strings.NewReader(serverURL))
+	require.NoError(t, err, "Unexpected error erasing credentials")
+
+	out := &bytes.Buffer{}
+	err = h.list(out)
+	require.NoError(t, err, "Unexpected error listing credentials")
+
+	registries := map[string]string{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &registries))
+	assert.Equal(t, map[string]string{other: "AWS"}, registries)
+}
+
+func TestListEmptyCache(t *testing.T) {
+	h, _, _ := setupHelper(t, time.Now())
+
+	out := &bytes.Buffer{}
+	err := h.list(out)
+	require.NoError(t, err, "Unexpected error listing credentials")
+	assert.Equal(t, "{}\n", out.String())
+}
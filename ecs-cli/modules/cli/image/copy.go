@@ -0,0 +1,358 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/tagging"
+	registryclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/registry"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/docker/distribution/reference"
+	units "github.com/docker/go-units"
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// CopyImageFormat is the arguments of images copy
+const CopyImageFormat = "SOURCE_IMAGE[:TAG|@DIGEST] DESTINATION_IMAGE[:TAG]"
+
+// copyClients creates the clients used to copy an image, and can be replaced in tests
+type copyClients struct {
+	// source creates an ECR client with the credentials for the source registry
+	source func(region string) (ecrclient.Client, error)
+	// destination creates the clients for the destination registry; an
+	// empty region is the configured region
+	destination func(region string) (ecrclient.Client, tagging.Client, error)
+	registry    func(auths map[string]docker.AuthConfiguration) registryclient.Client
+}
+
+// imageLocation is an image in a registry which is copied from or to
+type imageLocation struct {
+	registry   string // empty for a destination in the default ECR registry
+	repository string
+	reference  string // tag or digest
+	registryID string // only set for ECR registries
+	region     string // only set for ECR registries
+}
+
+func (l imageLocation) isECR() bool {
+	return l.region != ""
+}
+
+func (l imageLocation) String() string {
+	if strings.HasPrefix(l.reference, "sha256:") {
+		return l.registry + "/" + l.repository + "@" + l.reference
+	}
+	return l.registry + "/" + l.repository + ":" + l.reference
+}
+
+// ImageCopy copies an image from one registry to another
+func ImageCopy(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'images copy': ", err)
+	}
+
+	clients := copyClients{
+		source: func(region string) (ecrclient.Client, error) {
+			commandConfig, err := config.NewCommandConfigWithRegion(sourceContext(c), rdwr, region)
+			if err != nil {
+				return nil, err
+			}
+			return ecrclient.NewClient(commandConfig), nil
+		},
+		destination: func(region string) (ecrclient.Client, tagging.Client, error) {
+			var commandConfig *config.CommandConfig
+			if region == "" {
+				commandConfig, err = config.NewCommandConfig(c, rdwr)
+			} else {
+				commandConfig, err = config.NewCommandConfigWithRegion(c, rdwr, region)
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			return ecrclient.NewClient(commandConfig), tagging.NewTaggingClient(commandConfig), nil
+		},
+		registry: registryclient.NewClientWithAuths,
+	}
+
+	if err := copyImage(c, clients, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'images copy': ", err)
+	}
+}
+
+// sourceContext returns a context in which the profile flags are those given
+// for the source registry, which fall back to the profile flags of the
+// command if they are not set
+func sourceContext(c *cli.Context) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-images-copy-source", flag.ContinueOnError)
+	flagSet.String(flags.ECSProfileFlag, c.String(flags.SourceECSProfileFlag), "")
+	flagSet.String(flags.AWSProfileFlag, c.String(flags.SourceAWSProfileFlag), "")
+	return cli.NewContext(c.App, flagSet, c)
+}
+
+// copyImage copies the manifest of an image, and the layers which the
+// destination repository does not have yet. Since the manifests are copied
+// unchanged, the image keeps its digest, and manifest lists are copied
+// with the images for all of their platforms.
+func copyImage(c *cli.Context, clients copyClients, out io.Writer) error {
+	args := c.Args()
+	if len(args) != 2 {
+		return fmt.Errorf("ecs-cli images copy requires exactly 2 arguments")
+	}
+	src, err := parseCopySource(args[0])
+	if err != nil {
+		return err
+	}
+	dst, err := parseCopyDestination(args[1])
+	if err != nil {
+		return err
+	}
+	if dst.reference == "" {
+		dst.reference = src.reference
+	}
+
+	auths := make(map[string]docker.AuthConfiguration)
+	if src.isECR() {
+		ecrClient, err := clients.source(src.region)
+		if err != nil {
+			return err
+		}
+		auth, err := ecrClient.GetAuthorizationData(src.registryID)
+		if err != nil {
+			return err
+		}
+		auths[auth.Registry] = dockerAuth(auth)
+	}
+	if dst.registry == "" || dst.isECR() {
+		if err = prepareECRDestination(c, &dst, clients, auths); err != nil {
+			return err
+		}
+	}
+
+	copier := &imageCopier{
+		client: clients.registry(auths),
+		src:    src,
+		dst:    dst,
+		copied: make(map[string]bool),
+	}
+	digest, err := copier.copy()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Copied %s to %s@%s (%d layers copied, %d already present)\n",
+		src, dst.registry+"/"+dst.repository, digest, copier.layersCopied, copier.layersSkipped)
+	return nil
+}
+
+// prepareECRDestination gets the credentials for a destination in ECR, and
+// creates its repository if it is in the current account and does not exist
+func prepareECRDestination(c *cli.Context, dst *imageLocation, clients copyClients, auths map[string]docker.AuthConfiguration) error {
+	ecrClient, taggingClient, err := clients.destination(dst.region)
+	if err != nil {
+		return err
+	}
+	auth, err := ecrClient.GetAuthorizationData("")
+	if err != nil {
+		return err
+	}
+	accountRegistryID, region, ok := ecrclient.ParseRegistryDomain(auth.Registry)
+	if !ok {
+		return fmt.Errorf("unexpected ECR registry %s", auth.Registry)
+	}
+	if dst.registry == "" {
+		dst.registry, dst.registryID, dst.region = auth.Registry, accountRegistryID, region
+	}
+
+	if dst.registryID != accountRegistryID {
+		// repositories in other accounts must already exist
+		if auth, err = ecrClient.GetAuthorizationData(dst.registryID); err != nil {
+			return err
+		}
+	} else if !ecrClient.RepositoryExists(dst.repository) {
+		if _, err = ecrClient.CreateRepository(dst.repository); err != nil {
+			return err
+		}
+	}
+	auths[auth.Registry] = dockerAuth(auth)
+
+	if tagVal := c.String(flags.ResourceTagsFlag); tagVal != "" {
+		tags, err := utils.GetTagsMap(tagVal)
+		if err != nil {
+			return err
+		}
+		logrus.WithField("repository", dst.repository).Info("Tagging repository...")
+		return tagRepo(getRepoARN(dst.region, dst.registryID, dst.repository), tags, taggingClient)
+	}
+	return nil
+}
+
+func dockerAuth(auth *ecrclient.Auth) docker.AuthConfiguration {
+	return docker.AuthConfiguration{
+		Username:      auth.Username,
+		Password:      auth.Password,
+		ServerAddress: auth.ProxyEndpoint,
+	}
+}
+
+// parseCopySource parses the image to copy, which like in docker is on
+// Docker Hub unless its name starts with a registry
+func parseCopySource(image string) (imageLocation, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return imageLocation{}, fmt.Errorf("invalid source image %s: %v", image, err)
+	}
+	location := imageLocation{
+		registry:   reference.Domain(named),
+		repository: reference.Path(named),
+	}
+	if digested, ok := named.(reference.Digested); ok {
+		location.reference = digested.Digest().String()
+	} else {
+		location.reference = reference.TagNameOnly(named).(reference.Tagged).Tag()
+	}
+	location.registryID, location.region, _ = ecrclient.ParseRegistryDomain(location.registry)
+	return location, nil
+}
+
+// parseCopyDestination parses the image to copy to. Like the image given to
+// push, it is in the ECR registry of the current account unless its name
+// starts with a registry. Without a tag, it has the tag of the source image.
+func parseCopyDestination(image string) (imageLocation, error) {
+	if !hasRegistry(image) {
+		_, repository, tag, err := splitImageName(image, "[:]", CopyImageFormat)
+		if err != nil {
+			return imageLocation{}, err
+		}
+		return imageLocation{repository: repository, reference: tag}, nil
+	}
+
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return imageLocation{}, fmt.Errorf("invalid destination image %s: %v", image, err)
+	}
+	if _, ok := named.(reference.Digested); ok {
+		return imageLocation{}, fmt.Errorf("destination image %s can not have a digest, since the image keeps the digest of the source image", image)
+	}
+	location := imageLocation{
+		registry:   reference.Domain(named),
+		repository: reference.Path(named),
+	}
+	if tagged, ok := named.(reference.Tagged); ok {
+		location.reference = tagged.Tag()
+	}
+	location.registryID, location.region, _ = ecrclient.ParseRegistryDomain(location.registry)
+	return location, nil
+}
+
+// hasRegistry returns whether an image name starts with a registry, using
+// the same rule as docker
+func hasRegistry(image string) bool {
+	i := strings.Index(image, "/")
+	if i < 0 {
+		return false
+	}
+	domain := image[:i]
+	return strings.ContainsAny(domain, ".:") || domain == "localhost"
+}
+
+// imageCopier copies an image between registries
+type imageCopier struct {
+	client        registryclient.Client
+	src           imageLocation
+	dst           imageLocation
+	copied        map[string]bool // digests of the blobs which are in the destination
+	layersCopied  int
+	layersSkipped int
+}
+
+// copy copies the image and returns its digest
+func (i *imageCopier) copy() (string, error) {
+	manifest, err := i.client.GetManifest(i.src.registry, i.src.repository, i.src.reference)
+	if err != nil {
+		return "", err
+	}
+
+	if manifest.IsList() {
+		descriptors, err := manifest.Manifests()
+		if err != nil {
+			return "", err
+		}
+		for _, descriptor := range descriptors {
+			image, err := i.client.GetManifest(i.src.registry, i.src.repository, descriptor.Digest)
+			if err != nil {
+				return "", err
+			}
+			if err = i.copyBlobs(image); err != nil {
+				return "", err
+			}
+			if _, err = i.client.PutManifest(i.dst.registry, i.dst.repository, descriptor.Digest, image); err != nil {
+				return "", err
+			}
+		}
+	} else if err = i.copyBlobs(manifest); err != nil {
+		return "", err
+	}
+
+	digest, err := i.client.PutManifest(i.dst.registry, i.dst.repository, i.dst.reference, manifest)
+	if err != nil {
+		return "", err
+	}
+	if digest != manifest.Digest {
+		logrus.Warnf("The digest of the copied image is %s rather than %s; the destination registry may have converted its manifest", digest, manifest.Digest)
+	}
+	return digest, nil
+}
+
+// copyBlobs copies the image config and layers which the destination
+// repository does not have yet
+func (i *imageCopier) copyBlobs(manifest *registryclient.Manifest) error {
+	blobs, err := manifest.Blobs()
+	if err != nil {
+		return err
+	}
+	for _, blob := range blobs {
+		if i.copied[blob.Digest] {
+			continue
+		}
+		exists, err := i.client.BlobExists(i.dst.registry, i.dst.repository, blob.Digest)
+		if err != nil {
+			return err
+		}
+		if exists {
+			logrus.WithField("digest", blob.Digest).Debug("Layer already exists")
+			i.layersSkipped++
+		} else {
+			logrus.WithFields(logrus.Fields{
+				"digest": blob.Digest,
+				"size":   units.HumanSizeWithPrecision(float64(blob.Size), 3),
+			}).Info("Copying layer...")
+			if err = i.client.CopyBlob(i.src.registry, i.src.repository, i.dst.registry, i.dst.repository, blob); err != nil {
+				return err
+			}
+			i.layersCopied++
+		}
+		i.copied[blob.Digest] = true
+	}
+	return nil
+}
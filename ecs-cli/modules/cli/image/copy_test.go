@@ -0,0 +1,213 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/tagging"
+	registryclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/registry"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/registry/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	taggingSDK "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/fsouza/go-dockerclient"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+const (
+	buildRegistry = "111111111111.dkr.ecr.us-east-1.amazonaws.com"
+	prodRegistry  = "222222222222.dkr.ecr.eu-west-1.amazonaws.com"
+)
+
+func imageManifest(config string, layers ...string) *registryclient.Manifest {
+	blobs := make([]string, len(layers))
+	for i, layer := range layers {
+		blobs[i] = fmt.Sprintf(`{"digest":"%s","size":10}`, layer)
+	}
+	body := fmt.Sprintf(`{"schemaVersion":2,"config":{"digest":"%s","size":5},"layers":[%s]}`, config, strings.Join(blobs, ","))
+	return &registryclient.Manifest{MediaType: registryclient.MediaTypeManifest, Digest: "sha256:" + config, Body: []byte(body)}
+}
+
+func ecrAuth(registry string) *ecr.Auth {
+	return &ecr.Auth{
+		ProxyEndpoint: "https://" + registry,
+		Registry:      registry,
+		Username:      "AWS",
+		Password:      "secret-" + registry[:3],
+	}
+}
+
+// setupCopyClients returns clients which use the mocks, and records the
+// regions and credentials they were created with
+func setupCopyClients(t *testing.T, mockSource, mockDestination *mock_ecr.MockClient, mockTagging tagging.Client) (copyClients, *mock_registry.MockClient, map[string]string, map[string]docker.AuthConfiguration) {
+	mockRegistry := mock_registry.NewMockClient(gomock.NewController(t))
+	regions := map[string]string{}
+	auths := map[string]docker.AuthConfiguration{}
+	return copyClients{
+		source: func(region string) (ecr.Client, error) {
+			regions["source"] = region
+			return mockSource, nil
+		},
+		destination: func(region string) (ecr.Client, tagging.Client, error) {
+			regions["destination"] = region
+			return mockDestination, mockTagging, nil
+		},
+		registry: func(given map[string]docker.AuthConfiguration) registryclient.Client {
+			for registry, auth := range given {
+				auths[registry] = auth
+			}
+			return mockRegistry
+		},
+	}, mockRegistry, regions, auths
+}
+
+func copyContext(args []string, tags string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-images-copy", 0)
+	flagSet.String(flags.ResourceTagsFlag, tags, "")
+	flagSet.Parse(args)
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func TestCopyImage_DockerHubToECR(t *testing.T) {
+	mockECR, _, _, mockTagging := setupTestController(t)
+	clients, mockRegistry, regions, auths := setupCopyClients(t, nil, mockECR, mockTagging)
+	manifest := imageManifest("config", "layer1", "layer2")
+
+	gomock.InOrder(
+		mockECR.EXPECT().GetAuthorizationData("").Return(ecrAuth(prodRegistry), nil),
+		mockECR.EXPECT().RepositoryExists("nginx").Return(false),
+		mockECR.EXPECT().CreateRepository("nginx").Return("nginx", nil),
+	)
+	gomock.InOrder(
+		mockRegistry.EXPECT().GetManifest(registryclient.DockerHubRegistry, "library/nginx", "1.19").Return(manifest, nil),
+		mockRegistry.EXPECT().BlobExists(prodRegistry, "nginx", "config").Return(false, nil),
+		mockRegistry.EXPECT().CopyBlob(registryclient.DockerHubRegistry, "library/nginx", prodRegistry, "nginx", registryclient.Descriptor{Digest: "config", Size: 5}).Return(nil),
+		mockRegistry.EXPECT().BlobExists(prodRegistry, "nginx", "layer1").Return(true, nil),
+		mockRegistry.EXPECT().BlobExists(prodRegistry, "nginx", "layer2").Return(false, nil),
+		mockRegistry.EXPECT().CopyBlob(registryclient.DockerHubRegistry, "library/nginx", prodRegistry, "nginx", registryclient.Descriptor{Digest: "layer2", Size: 10}).Return(nil),
+		mockRegistry.EXPECT().PutManifest(prodRegistry, "nginx", "1.19", manifest).Return(manifest.Digest, nil),
+	)
+
+	out := &bytes.Buffer{}
+	err := copyImage(copyContext([]string{"nginx:1.19", "nginx"}, ""), clients, out)
+	assert.NoError(t, err, "Unexpected error copying image")
+	assert.Equal(t, "", regions["destination"], "Expected configured region for the destination")
+	assert.Equal(t, "AWS", auths[prodRegistry].Username, "Expected ECR credentials for the destination")
+	assert.Equal(t, fmt.Sprintf("Copied docker.io/library/nginx:1.19 to %s/nginx@%s (2 layers copied, 1 already present)\n", prodRegistry, manifest.Digest), out.String())
+}
+
+func TestCopyImage_ManifestListBetweenAccounts(t *testing.T) {
+	mockSource, _, _, _ := setupTestController(t)
+	mockDestination, _, _, mockTagging := setupTestController(t)
+	clients, mockRegistry, regions, auths := setupCopyClients(t, mockSource, mockDestination, mockTagging)
+
+	amd := imageManifest("amd-config", "shared", "amd-layer")
+	arm := imageManifest("arm-config", "shared")
+	list, err := registryclient.NewManifestList([]*registryclient.Manifest{amd, arm}, []registryclient.Platform{
+		{OS: "linux", Architecture: "amd64"},
+		{OS: "linux", Architecture: "arm64"},
+	})
+	require.NoError(t, err)
+
+	mockSource.EXPECT().GetAuthorizationData("111111111111").Return(ecrAuth(buildRegistry), nil)
+	gomock.InOrder(
+		mockDestination.EXPECT().GetAuthorizationData("").Return(ecrAuth(prodRegistry), nil),
+		mockDestination.EXPECT().RepositoryExists("web").Return(true),
+	)
+	mockRegistry.EXPECT().BlobExists(prodRegistry, "web", gomock.Any()).Return(false, nil).Times(4)
+	mockRegistry.EXPECT().CopyBlob(buildRegistry, "build/web", prodRegistry, "web", gomock.Any()).Return(nil).Times(4)
+	gomock.InOrder(
+		mockRegistry.EXPECT().GetManifest(buildRegistry, "build/web", list.Digest).Return(list, nil),
+		mockRegistry.EXPECT().GetManifest(buildRegistry, "build/web", amd.Digest).Return(amd, nil),
+		mockRegistry.EXPECT().PutManifest(prodRegistry, "web", amd.Digest, amd).Return(amd.Digest, nil),
+		mockRegistry.EXPECT().GetManifest(buildRegistry, "build/web", arm.Digest).Return(arm, nil),
+		mockRegistry.EXPECT().PutManifest(prodRegistry, "web", arm.Digest, arm).Return(arm.Digest, nil),
+		mockRegistry.EXPECT().PutManifest(prodRegistry, "web", "v1", list).Return(list.Digest, nil),
+	)
+
+	out := &bytes.Buffer{}
+	err = copyImage(copyContext([]string{buildRegistry + "/build/web@" + list.Digest, prodRegistry + "/web:v1"}, ""), clients, out)
+	assert.NoError(t, err, "Unexpected error copying image")
+	assert.Equal(t, map[string]string{"source": "us-east-1", "destination": "eu-west-1"}, regions, "Expected regions of the registries")
+	assert.Equal(t, "secret-111", auths[buildRegistry].Password, "Expected source credentials")
+	assert.Equal(t, "secret-222", auths[prodRegistry].Password, "Expected destination credentials")
+	assert.Contains(t, out.String(), "(4 layers copied, 0 already present)", "Expected shared layer to be copied once")
+}
+
+func TestCopyImage_RepositoryInOtherAccountWithTags(t *testing.T) {
+	mockECR, _, _, mockTagging := setupTestController(t)
+	clients, mockRegistry, _, auths := setupCopyClients(t, nil, mockECR, mockTagging)
+	manifest := imageManifest("config")
+
+	gomock.InOrder(
+		mockECR.EXPECT().GetAuthorizationData("").Return(ecrAuth(buildRegistry), nil),
+		mockECR.EXPECT().GetAuthorizationData("222222222222").Return(ecrAuth(prodRegistry), nil),
+	)
+	mockTagging.EXPECT().TagResources(gomock.Any()).Do(func(x interface{}) {
+		input := x.(*taggingSDK.TagResourcesInput)
+		assert.Equal(t, []string{"arn:aws:ecr:eu-west-1:222222222222:repository/web"}, aws.StringValueSlice(input.ResourceARNList))
+		assert.Equal(t, "prod", aws.StringValue(input.Tags["stage"]))
+	}).Return(&taggingSDK.TagResourcesOutput{}, nil)
+	gomock.InOrder(
+		mockRegistry.EXPECT().GetManifest("localhost:5000", "web", "latest").Return(manifest, nil),
+		mockRegistry.EXPECT().BlobExists(prodRegistry, "web", "config").Return(true, nil),
+		mockRegistry.EXPECT().PutManifest(prodRegistry, "web", "latest", manifest).Return(manifest.Digest, nil),
+	)
+
+	err := copyImage(copyContext([]string{"localhost:5000/web", prodRegistry + "/web"}, "stage=prod"), clients, &bytes.Buffer{})
+	assert.NoError(t, err, "Unexpected error copying image")
+	assert.Equal(t, "secret-222", auths[prodRegistry].Password, "Expected credentials for the other account")
+}
+
+func TestCopyImage_ErrorCases(t *testing.T) {
+	mockECR, _, _, mockTagging := setupTestController(t)
+	clients, mockRegistry, _, _ := setupCopyClients(t, nil, mockECR, mockTagging)
+
+	err := copyImage(copyContext([]string{"nginx"}, ""), clients, &bytes.Buffer{})
+	assert.Error(t, err, "Expected error for missing destination")
+
+	err = copyImage(copyContext([]string{"nginx", prodRegistry + "/nginx@sha256:" + strings.Repeat("a", 64)}, ""), clients, &bytes.Buffer{})
+	assert.Error(t, err, "Expected error for destination with digest")
+
+	mockECR.EXPECT().GetAuthorizationData("").Return(ecrAuth(prodRegistry), nil)
+	mockECR.EXPECT().RepositoryExists("nginx").Return(true)
+	mockRegistry.EXPECT().GetManifest(registryclient.DockerHubRegistry, "library/nginx", "latest").Return(nil, fmt.Errorf("not found"))
+	err = copyImage(copyContext([]string{"nginx", "nginx"}, ""), clients, &bytes.Buffer{})
+	assert.Error(t, err, "Expected error when the source image does not exist")
+}
+
+func TestParseCopyDestination(t *testing.T) {
+	testCases := map[string]imageLocation{
+		"web":                           {repository: "web"},
+		"team/web:v1":                   {repository: "team/web", reference: "v1"},
+		"localhost:5000/web:v1":         {registry: "localhost:5000", repository: "web", reference: "v1"},
+		"docker.io/library/web":         {registry: "docker.io", repository: "library/web"},
+		prodRegistry + "/team/web:v1.2": {registry: prodRegistry, repository: "team/web", reference: "v1.2", registryID: "222222222222", region: "eu-west-1"},
+	}
+	for image, expected := range testCases {
+		location, err := parseCopyDestination(image)
+		assert.NoError(t, err, "Unexpected error parsing %s", image)
+		assert.Equal(t, expected, location, "Unexpected location for %s", image)
+	}
+}
@@ -18,10 +18,13 @@ import (
 	"io"
 	"os"
 	"regexp"
+	"strings"
 	"text/tabwriter"
 	"time"
 
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/repo"
 	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	ecrpublicclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecrpublic"
 	stsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/tagging"
 	dockerclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker"
@@ -32,7 +35,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	taggingSDK "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
-	units "github.com/docker/go-units"
 	docker "github.com/fsouza/go-dockerclient"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -70,6 +72,13 @@ func ImagePush(c *cli.Context) {
 		logrus.Fatal("Error executing 'push': ", err)
 	}
 
+	if isPublicImage(c.Args()) {
+		if err := pushPublicImage(c, dockerClient, ecrpublicclient.NewClient(commandConfig)); err != nil {
+			logrus.Fatal("Error executing 'push': ", err)
+		}
+		return
+	}
+
 	ecrClient := getECRClient(c, commandConfig)
 	stsClient := stsclient.NewClient(commandConfig)
 	taggingClient := tagging.NewTaggingClient(commandConfig)
@@ -98,6 +107,13 @@ func ImagePull(c *cli.Context) {
 		logrus.Fatal("Error executing 'pull': ", err)
 	}
 
+	if isPublicImage(c.Args()) {
+		if err := pullPublicImage(c, dockerClient, ecrpublicclient.NewClient(commandConfig)); err != nil {
+			logrus.Fatal("Error executing 'pull': ", err)
+		}
+		return
+	}
+
 	ecrClient := getECRClient(c, commandConfig)
 	stsClient := stsclient.NewClient(commandConfig)
 
@@ -120,7 +136,7 @@ func ImageList(c *cli.Context) {
 
 	ecrClient := getECRClient(c, commandConfig)
 
-	if err := getImages(c, rdwr, ecrClient); err != nil {
+	if err := getImages(c, rdwr, ecrClient, os.Stdout); err != nil {
 		logrus.Fatal("Error executing 'images': ", err)
 		return
 	}
@@ -157,6 +173,20 @@ func pushImage(c *cli.Context, region string, dockerClient dockerclient.Client,
 		return err
 	}
 
+	platforms, err := parsePlatforms(c.String(flags.PlatformFlag))
+	if err != nil {
+		return err
+	}
+
+	var settings *ecrclient.RepositorySettings
+	if configFile := c.String(flags.RepoConfigFlag); configFile != "" {
+		repoSettings, err := repo.ReadSettings(configFile)
+		if err != nil {
+			return err
+		}
+		settings = &repoSettings
+	}
+
 	// For tagging (need the full ARN) and ECR auth, we need the registry ID
 	// We can get this either from the registry URI or from STS
 	if registryURI == "" {
@@ -178,8 +208,9 @@ func pushImage(c *cli.Context, region string, dockerClient dockerclient.Client,
 
 	repositoryURI := ecrAuth.Registry + "/" + repository
 
-	// Tag image to ECR uri
-	if registryURI == "" {
+	// Tag image to ECR uri; the images of a multi-platform push are tagged
+	// when they are pushed
+	if registryURI == "" && len(platforms) == 0 {
 		if err := dockerClient.TagImage(image, repositoryURI, tag); err != nil {
 			return err
 		}
@@ -187,7 +218,12 @@ func pushImage(c *cli.Context, region string, dockerClient dockerclient.Client,
 
 	// Check if repo exists, create if not present
 	if !ecrClient.RepositoryExists(repository) {
-		if _, err := ecrClient.CreateRepository(repository); err != nil {
+		if settings != nil {
+			_, err = ecrClient.CreateRepositoryWithSettings(repository, *settings)
+		} else {
+			_, err = ecrClient.CreateRepository(repository)
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -213,6 +249,17 @@ func pushImage(c *cli.Context, region string, dockerClient dockerclient.Client,
 		ServerAddress: ecrAuth.ProxyEndpoint,
 	}
 
+	if len(platforms) > 0 {
+		return pushMultiPlatformImage(multiPlatformImage{
+			localName:     strings.TrimSuffix(image, ":"+tag),
+			repository:    repository,
+			repositoryURI: repositoryURI,
+			tag:           tag,
+			platforms:     platforms,
+			tagLocal:      registryURI == "",
+		}, ecrAuth, dockerAuth, dockerClient)
+	}
+
 	err = dockerClient.PushImage(repositoryURI, tag, ecrAuth.Registry, dockerAuth)
 	return err
 }
@@ -259,65 +306,81 @@ type imageInfo struct {
 	ImageDigest    string
 	PushedAt       string
 	Size           string
+	Platforms      string
+	ScanStatus     string
 }
 
-func getImages(c *cli.Context, rdwr config.ReadWriter, ecrClient ecrclient.Client) error {
+func getImages(c *cli.Context, rdwr config.ReadWriter, ecrClient ecrclient.Client, out io.Writer) error {
 	registryID := c.String(flags.RegistryIdFlag)
 	args := c.Args() // repository names
 
-	totalCount := 0
+	filter, err := newListFilter(c)
+	if err != nil {
+		return err
+	}
+	format, order := c.String(flags.FormatFlag), c.String(flags.SortFlag)
+	if err = checkListFormat(format); err != nil {
+		return err
+	}
+	if err = checkSortOrder(order); err != nil {
+		return err
+	}
 
-	w := tabwriter.NewWriter(os.Stdout, MinWidth, TabWidth, Padding, PaddingChar, NumOfFlags)
+	now := time.Now().UTC()
+	// looking up the platforms of an image takes requests to the registry,
+	// so it is only done when they are shown
+	var finder *platformFinder
+	showPlatforms := c.Bool(flags.PlatformsFlag) || usesPlatforms(format)
+	if showPlatforms {
+		finder = newPlatformFinder(ecrClient)
+	}
+	var records []imageRecord
 
-	err := ecrClient.GetImages(aws.StringSlice(args), getTagStatus(c), registryID, func(imageDetails []*ecr.ImageDetail) error {
-		// Prints all images in table
+	err = ecrClient.GetImages(aws.StringSlice(args), getTagStatus(c), registryID, func(imageDetails []*ecr.ImageDetail) error {
 		for _, image := range imageDetails {
-			info := imageInfo{
-				RepositoryName: aws.StringValue(image.RepositoryName),
-				ImageDigest:    aws.StringValue(image.ImageDigest),
-			}
-			info.PushedAt = units.HumanDuration(time.Now().UTC().Sub(time.Unix(image.ImagePushedAt.Unix(), 0))) + " ago"
-			info.Size = units.HumanSizeWithPrecision(float64(aws.Int64Value(image.ImageSizeInBytes)), 3)
-			if len(image.ImageTags) == 0 {
-				info.Tag = "<none>"
-				listImagesContent(w, info, totalCount)
-				totalCount++
-			}
-			for _, tag := range image.ImageTags {
-				info.Tag = aws.StringValue(tag)
-				listImagesContent(w, info, totalCount)
-				totalCount++
+			if filter.matches(image, now) {
+				records = append(records, newImageRecord(image, finder))
 			}
 		}
 		return nil
 	})
-	w.Flush()
-	return err
+	if err != nil {
+		return err
+	}
+
+	sortImageRecords(records, order)
+	return printImageRecords(out, records, format, showPlatforms, now)
 }
 
 func listImagesContent(w *tabwriter.Writer, info imageInfo, count int) {
 	if count%PageSize == 0 {
 		w.Flush()
-		fmt.Println()
-		printImageRow(w, imageInfo{
+		fmt.Fprintln(w)
+		header := imageInfo{
 			RepositoryName: "REPOSITORY NAME",
 			Tag:            "TAG",
 			ImageDigest:    "IMAGE DIGEST",
 			PushedAt:       "PUSHED AT",
 			Size:           "SIZE",
-		})
+			ScanStatus:     "SCAN STATUS",
+		}
+		if info.Platforms != "" {
+			header.Platforms = "PLATFORMS"
+		}
+		printImageRow(w, header)
 	}
 	printImageRow(w, info)
 }
 
+// printImageRow prints the columns of an image, leaving out the platforms
+// unless they were looked up
 func printImageRow(w io.Writer, info imageInfo) {
-	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n",
-		info.RepositoryName,
-		info.Tag,
-		info.ImageDigest,
-		info.PushedAt,
-		info.Size,
-	)
+	columns := []string{info.RepositoryName, info.Tag, info.ImageDigest, info.PushedAt, info.Size}
+	if info.Platforms != "" {
+		columns = append(columns, info.Platforms)
+	}
+	columns = append(columns, info.ScanStatus)
+	fmt.Fprintf(w, "%s\t\n", strings.Join(columns, "\t"))
 }
 
 func getTagStatus(c *cli.Context) string {
@@ -354,7 +417,8 @@ func getECRAuth(registryURI string, registryID string,
 
 func splitImageName(image string, seperatorRegExp string, format string) (registry string, repository string, tag string, err error) {
 	re := regexp.MustCompile(
-		`^(?:((?:[a-zA-Z0-9][a-zA-Z0-9-_]*)\.dkr\.ecr(\-fips)?\.[a-zA-Z0-9\-_]+\.amazonaws\.com(?:\.cn)?)/)?` + // registry uri (Optional)
+		`^(?:((?:[a-zA-Z0-9][a-zA-Z0-9-_]*)\.dkr\.ecr(\-fips)?\.[a-zA-Z0-9\-_]+\.amazonaws\.com(?:\.cn)?` + // registry uri (Optional)
+			`|public\.ecr\.aws/[a-z0-9][a-z0-9\-_]*)/)?` + // or ECR Public registry uri with alias
 			`([0-9a-z\-_/]+)` + // repository
 			`(?:` + seperatorRegExp + `([0-9A-Za-z_.\-:]+))?$`) // tag or sha (Optional)
 	matches := re.FindStringSubmatch(image)
@@ -14,8 +14,10 @@
 package image
 
 import (
+	"bytes"
 	"errors"
 	"flag"
+	"io/ioutil"
 	"os"
 	"testing"
 	"time"
@@ -25,6 +27,7 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts/mock"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/tagging/mock"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker/mock"
+	registryclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/registry"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	"github.com/aws/aws-sdk-go/aws"
@@ -131,6 +134,43 @@ func TestImagePush_WithTags(t *testing.T) {
 	assert.NoError(t, err, "Error pushing image")
 }
 
+func TestImagePush_WithRepoConfig(t *testing.T) {
+	mockECR, mockDocker, mockSTS, mockTagging := setupTestController(t)
+	setupEnvironmentVar()
+
+	configFile, err := ioutil.TempFile("", "repo-config")
+	assert.NoError(t, err, "Unexpected error creating temp file")
+	defer os.Remove(configFile.Name())
+	_, err = configFile.WriteString("image_tag_mutability: immutable\nscan_on_push: true\ntags:\n  team: green\n")
+	assert.NoError(t, err, "Unexpected error writing temp file")
+	configFile.Close()
+
+	expectedSettings := ecr.RepositorySettings{
+		ImageTagMutability: "IMMUTABLE",
+		ScanOnPush:         true,
+		Tags:               map[string]*string{"team": aws.String("green")},
+	}
+
+	gomock.InOrder(
+		mockSTS.EXPECT().GetAWSAccountID().Return(registryID, nil),
+		mockECR.EXPECT().GetAuthorizationTokenByID(gomock.Any()).Return(&ecr.Auth{
+			Registry: registry,
+		}, nil),
+		mockDocker.EXPECT().TagImage(image, repositoryURI, tag).Return(nil),
+		mockECR.EXPECT().RepositoryExists(repository).Return(false),
+		mockECR.EXPECT().CreateRepositoryWithSettings(repository, expectedSettings).Return(&ecrApi.Repository{}, nil),
+		mockDocker.EXPECT().PushImage(repositoryURI, tag, registry,
+			docker.AuthConfiguration{}).Return(nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-push", 0)
+	flagSet.Parse([]string{image})
+	flagSet.String(flags.RepoConfigFlag, configFile.Name(), "")
+	context := cli.NewContext(nil, flagSet, nil)
+	err = pushImage(context, region, mockDocker, mockECR, mockSTS, mockTagging)
+	assert.NoError(t, err, "Error pushing image")
+}
+
 func TestImagePushWithURI(t *testing.T) {
 	repositoryWithURI := "012345678912.dkr.ecr.us-east-1.amazonaws.com/" + image
 
@@ -338,10 +378,13 @@ func TestImageList(t *testing.T) {
 	pushedAt := time.Unix(1489687380, 0)
 	size := int64(1024)
 	tags := aws.StringSlice([]string{"tag1", "tag2"})
+	mockRegistry, restore := stubRegistryClient(t)
+	defer restore()
 	gomock.InOrder(
 		mockECR.EXPECT().GetImages(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Do(func(_, _, _, x interface{}) {
 			funct := x.(ecr.ProcessImageDetails)
 			funct([]*ecrApi.ImageDetail{&ecrApi.ImageDetail{
+				RegistryId:       aws.String(registryID),
 				ImageDigest:      aws.String(imageDigest),
 				RepositoryName:   aws.String(repositoryName),
 				ImagePushedAt:    &pushedAt,
@@ -350,11 +393,44 @@ func TestImageList(t *testing.T) {
 			}})
 		}).Return(nil),
 	)
+	mockECR.EXPECT().GetAuthorizationTokenByID(registryID).Return(&ecr.Auth{Registry: registry}, nil)
+	mockRegistry.EXPECT().GetPlatforms(registry, repositoryName, imageDigest).Return([]registryclient.Platform{
+		{OS: "linux", Architecture: "amd64"},
+		{OS: "linux", Architecture: "arm64"},
+	}, nil)
 
 	flagSet := flag.NewFlagSet("ecs-cli-images", 0)
+	flagSet.Bool(flags.PlatformsFlag, true, "")
 	context := cli.NewContext(nil, flagSet, nil)
-	err := getImages(context, newMockReadWriter(), mockECR)
+	out := &bytes.Buffer{}
+	err := getImages(context, newMockReadWriter(), mockECR, out)
 	assert.NoError(t, err, "Error listing images")
+	assert.Regexp(t, `SIZE\s+PLATFORMS\s+SCAN STATUS`, out.String())
+	assert.Contains(t, out.String(), "linux/amd64,linux/arm64")
+}
+
+func TestImageListWithoutPlatforms(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	setupEnvironmentVar()
+
+	mockRegistry, restore := stubRegistryClient(t)
+	defer restore()
+	mockECR.EXPECT().GetImages(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Do(func(_, _, _, x interface{}) {
+		x.(ecr.ProcessImageDetails)([]*ecrApi.ImageDetail{&ecrApi.ImageDetail{
+			RegistryId:     aws.String(registryID),
+			ImageDigest:    aws.String("sha:2561234567"),
+			RepositoryName: aws.String("repo-name"),
+		}})
+	}).Return(nil)
+	mockECR.EXPECT().GetAuthorizationTokenByID(gomock.Any()).Times(0)
+	mockRegistry.EXPECT().GetPlatforms(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images", 0)
+	context := cli.NewContext(nil, flagSet, nil)
+	out := &bytes.Buffer{}
+	err := getImages(context, newMockReadWriter(), mockECR, out)
+	assert.NoError(t, err, "Error listing images")
+	assert.NotContains(t, out.String(), "PLATFORMS", "Expected no platforms column by default")
 }
 
 func TestImageListFail(t *testing.T) {
@@ -367,7 +443,7 @@ func TestImageListFail(t *testing.T) {
 
 	flagSet := flag.NewFlagSet("ecs-cli-images", 0)
 	context := cli.NewContext(nil, flagSet, nil)
-	err := getImages(context, newMockReadWriter(), mockECR)
+	err := getImages(context, newMockReadWriter(), mockECR, &bytes.Buffer{})
 	assert.Error(t, err, "Expected error listing images")
 }
 
@@ -470,6 +546,20 @@ func TestSplitImageName(t *testing.T) {
 			tag:        "",
 			sha:        "sha256:0b3787ac21ffb4edbd6710e0e60f991d5ded8d8a4f558209ef5987f73db4211a",
 		},
+		{
+			name:       "ECR Public with tag",
+			uri:        "public.ecr.aws/my-alias",
+			repository: repository,
+			tag:        tag,
+			sha:        "",
+		},
+		{
+			name:       "ECR Public with slash in image name and sha",
+			uri:        "public.ecr.aws/my-alias",
+			repository: repositoryWithSlash,
+			tag:        "",
+			sha:        "sha256:0b3787ac21ffb4edbd6710e0e60f991d5ded8d8a4f558209ef5987f73db4211a",
+		},
 	}
 
 	for _, test := range tests {
@@ -510,6 +600,11 @@ func TestSplitImageNameErrorCaseBadURI(t *testing.T) {
 	assert.Error(t, err, "Expected error splitting image name")
 }
 
+func TestSplitImageNameErrorCasePublicWithoutAlias(t *testing.T) {
+	_, _, _, err := splitImageName("public.ecr.aws/"+repository, "[:]", "format")
+	assert.Error(t, err, "Expected error splitting image name without a registry alias")
+}
+
 func TestSplitImageNameErrorCase(t *testing.T) {
 	invalidImage := "rep@sha256:0b3787ac21ffb4edbd6710e0e60f991d5ded8d8a4f558209ef5987f73db4211a"
 	_, _, _, err := splitImageName(invalidImage, "[:]", "format")
@@ -0,0 +1,332 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	units "github.com/docker/go-units"
+	"github.com/urfave/cli"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Output formats of images; any other format is a Go template
+const (
+	TableFormat = "table"
+	JSONFormat  = "json"
+	YAMLFormat  = "yaml"
+)
+
+// Orders of images
+const (
+	SortByPushed = "pushed"
+	SortBySize   = "size"
+)
+
+const noScanStatus = "-"
+
+// imageRecord is an image in the output of images
+type imageRecord struct {
+	Repository   string           `json:"repository" yaml:"repository"`
+	Digest       string           `json:"digest" yaml:"digest"`
+	Tags         []string         `json:"tags" yaml:"tags"`
+	PushedAt     time.Time        `json:"pushedAt" yaml:"pushedAt"`
+	SizeInBytes  int64            `json:"sizeInBytes" yaml:"sizeInBytes"`
+	Platforms    []string         `json:"platforms,omitempty" yaml:"platforms,omitempty"`
+	ScanStatus   string           `json:"scanStatus,omitempty" yaml:"scanStatus,omitempty"`
+	ScanFindings map[string]int64 `json:"scanFindings,omitempty" yaml:"scanFindings,omitempty"`
+}
+
+// repositorySummary contains the totals of the listed images of a repository
+type repositorySummary struct {
+	Repository       string `json:"repository" yaml:"repository"`
+	ImageCount       int    `json:"imageCount" yaml:"imageCount"`
+	TotalSizeInBytes int64  `json:"totalSizeInBytes" yaml:"totalSizeInBytes"`
+}
+
+// imageListing is the output of images in the JSON and YAML formats
+type imageListing struct {
+	Images       []imageRecord       `json:"images" yaml:"images"`
+	Repositories []repositorySummary `json:"repositories" yaml:"repositories"`
+}
+
+// newImageRecord converts an image to its record. Its platforms are only
+// looked up if a platform finder is given.
+func newImageRecord(image *ecr.ImageDetail, finder *platformFinder) imageRecord {
+	record := imageRecord{
+		Repository:  aws.StringValue(image.RepositoryName),
+		Digest:      aws.StringValue(image.ImageDigest),
+		Tags:        aws.StringValueSlice(image.ImageTags),
+		PushedAt:    aws.TimeValue(image.ImagePushedAt).UTC(),
+		SizeInBytes: aws.Int64Value(image.ImageSizeInBytes),
+	}
+	if finder != nil {
+		if platforms := finder.platforms(image); platforms != unknownPlatforms {
+			record.Platforms = strings.Split(platforms, ",")
+		}
+	}
+	if image.ImageScanStatus != nil {
+		record.ScanStatus = aws.StringValue(image.ImageScanStatus.Status)
+	}
+	if image.ImageScanFindingsSummary != nil {
+		record.ScanFindings = aws.Int64ValueMap(image.ImageScanFindingsSummary.FindingSeverityCounts)
+	}
+	return record
+}
+
+// scanSummary returns the scan status of the image, followed by the number
+// of findings of each severity, such as COMPLETE (1 HIGH, 3 LOW)
+func (r imageRecord) scanSummary() string {
+	if r.ScanStatus == "" {
+		return noScanStatus
+	}
+	var counts []string
+	for _, severity := range ecrclient.Severities {
+		if count := r.ScanFindings[severity]; count > 0 {
+			counts = append(counts, fmt.Sprintf("%d %s", count, severity))
+		}
+	}
+	if len(counts) == 0 {
+		return r.ScanStatus
+	}
+	return fmt.Sprintf("%s (%s)", r.ScanStatus, strings.Join(counts, ", "))
+}
+
+// listFilter selects the images to list
+type listFilter struct {
+	since       int // days
+	olderThan   int // days
+	tagPrefixes []string
+	minSize     int64
+}
+
+func newListFilter(c *cli.Context) (*listFilter, error) {
+	filter := &listFilter{
+		since:       c.Int(flags.SinceFlag),
+		olderThan:   c.Int(flags.OlderThanFlag),
+		tagPrefixes: splitTagPrefixes(c.String(flags.TagPrefixFlag)),
+	}
+	if filter.since < 0 || filter.olderThan < 0 {
+		return nil, fmt.Errorf("--%s and --%s can not be negative", flags.SinceFlag, flags.OlderThanFlag)
+	}
+	if minSize := c.String(flags.MinSizeFlag); minSize != "" {
+		size, err := units.FromHumanSize(minSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s %q: %v", flags.MinSizeFlag, minSize, err)
+		}
+		filter.minSize = size
+	}
+	return filter, nil
+}
+
+// matches returns whether an image passes all of the filters
+func (f *listFilter) matches(image *ecr.ImageDetail, now time.Time) bool {
+	age := now.Sub(aws.TimeValue(image.ImagePushedAt))
+	if f.since > 0 && age > days(f.since) {
+		return false
+	}
+	if f.olderThan > 0 && age <= days(f.olderThan) {
+		return false
+	}
+	if aws.Int64Value(image.ImageSizeInBytes) < f.minSize {
+		return false
+	}
+	for _, prefix := range f.tagPrefixes {
+		if hasTagPrefix(image, prefix) {
+			return true
+		}
+	}
+	return len(f.tagPrefixes) == 0
+}
+
+func days(n int) time.Duration {
+	return time.Duration(n) * 24 * time.Hour
+}
+
+// checkListFormat returns an error if the format is not a known format or a
+// valid template
+func checkListFormat(format string) error {
+	switch format {
+	case "", TableFormat, JSONFormat, YAMLFormat:
+		return nil
+	}
+	if !strings.Contains(format, "{{") {
+		return fmt.Errorf("invalid --%s %q; specify %s, %s, %s or a Go template", flags.FormatFlag, format, TableFormat, JSONFormat, YAMLFormat)
+	}
+	_, err := newListTemplate(format)
+	return err
+}
+
+// usesPlatforms returns whether the format is a template which shows the
+// platforms of images
+func usesPlatforms(format string) bool {
+	switch format {
+	case "", TableFormat, JSONFormat, YAMLFormat:
+		return false
+	}
+	return strings.Contains(format, ".Platforms")
+}
+
+func newListTemplate(format string) (*template.Template, error) {
+	tmpl, err := template.New("images").Funcs(template.FuncMap{"join": strings.Join}).Parse(format)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s template: %v", flags.FormatFlag, err)
+	}
+	return tmpl, nil
+}
+
+// checkSortOrder returns an error if the order is not a known order of images
+func checkSortOrder(order string) error {
+	switch order {
+	case "", SortByPushed, SortBySize:
+		return nil
+	}
+	return fmt.Errorf("invalid --%s %q; specify %s or %s", flags.SortFlag, order, SortByPushed, SortBySize)
+}
+
+// sortImageRecords sorts images from the most recently pushed or the
+// largest; otherwise they stay in the order ECR returned them in
+func sortImageRecords(records []imageRecord, order string) {
+	switch order {
+	case SortByPushed:
+		sort.SliceStable(records, func(i, j int) bool {
+			return records[i].PushedAt.After(records[j].PushedAt)
+		})
+	case SortBySize:
+		sort.SliceStable(records, func(i, j int) bool {
+			return records[i].SizeInBytes > records[j].SizeInBytes
+		})
+	}
+}
+
+// summarizeRepositories returns the number and total size of the images of
+// each repository, ordered by repository name
+func summarizeRepositories(records []imageRecord) []repositorySummary {
+	byName := make(map[string]*repositorySummary)
+	for _, record := range records {
+		summary, ok := byName[record.Repository]
+		if !ok {
+			summary = &repositorySummary{Repository: record.Repository}
+			byName[record.Repository] = summary
+		}
+		summary.ImageCount++
+		summary.TotalSizeInBytes += record.SizeInBytes
+	}
+	summaries := make([]repositorySummary, 0, len(byName))
+	for _, summary := range byName {
+		summaries = append(summaries, *summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Repository < summaries[j].Repository
+	})
+	return summaries
+}
+
+// printImageRecords prints the images, and for the structured formats and
+// the table, the totals of each repository
+func printImageRecords(out io.Writer, records []imageRecord, format string, showPlatforms bool, now time.Time) error {
+	switch format {
+	case "", TableFormat:
+		printImageTable(out, records, showPlatforms, now)
+		return nil
+	case JSONFormat:
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(imageListing{Images: nonNil(records), Repositories: summarizeRepositories(records)})
+	case YAMLFormat:
+		data, err := yaml.Marshal(imageListing{Images: nonNil(records), Repositories: summarizeRepositories(records)})
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	}
+
+	tmpl, err := newListTemplate(format)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if err = tmpl.Execute(out, record); err != nil {
+			return err
+		}
+		fmt.Fprintln(out)
+	}
+	return nil
+}
+
+func nonNil(records []imageRecord) []imageRecord {
+	if records == nil {
+		return []imageRecord{}
+	}
+	return records
+}
+
+// printImageTable prints a row for each tag of each image, followed by the
+// totals of each repository
+func printImageTable(out io.Writer, records []imageRecord, showPlatforms bool, now time.Time) {
+	w := tabwriter.NewWriter(out, MinWidth, TabWidth, Padding, PaddingChar, NumOfFlags)
+	totalCount := 0
+	for _, record := range records {
+		info := imageInfo{
+			RepositoryName: record.Repository,
+			ImageDigest:    record.Digest,
+			PushedAt:       units.HumanDuration(now.Sub(record.PushedAt)) + " ago",
+			Size:           units.HumanSizeWithPrecision(float64(record.SizeInBytes), 3),
+			ScanStatus:     record.scanSummary(),
+		}
+		if showPlatforms {
+			info.Platforms = unknownPlatforms
+			if len(record.Platforms) > 0 {
+				info.Platforms = strings.Join(record.Platforms, ",")
+			}
+		}
+		if len(record.Tags) == 0 {
+			info.Tag = "<none>"
+			listImagesContent(w, info, totalCount)
+			totalCount++
+		}
+		for _, tag := range record.Tags {
+			info.Tag = tag
+			listImagesContent(w, info, totalCount)
+			totalCount++
+		}
+	}
+	w.Flush()
+
+	if len(records) == 0 {
+		return
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintf(w, "%s\t%s\t%s\t\n", "REPOSITORY NAME", "IMAGES", "TOTAL SIZE")
+	for _, summary := range summarizeRepositories(records) {
+		fmt.Fprintf(w, "%s\t%d\t%s\t\n",
+			summary.Repository,
+			summary.ImageCount,
+			units.HumanSizeWithPrecision(float64(summary.TotalSizeInBytes), 3),
+		)
+	}
+	w.Flush()
+}
@@ -0,0 +1,207 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	ecrApi "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func listedImages() []*ecrApi.ImageDetail {
+	now := time.Now()
+	return []*ecrApi.ImageDetail{
+		{
+			RepositoryName:   aws.String("web"),
+			ImageDigest:      aws.String("sha256:web1"),
+			ImageTags:        aws.StringSlice([]string{"v1", "latest"}),
+			ImagePushedAt:    aws.Time(now.Add(-2 * 24 * time.Hour)),
+			ImageSizeInBytes: aws.Int64(50 * 1000 * 1000),
+			ImageScanStatus:  &ecrApi.ImageScanStatus{Status: aws.String(ecrApi.ScanStatusComplete)},
+			ImageScanFindingsSummary: &ecrApi.ImageScanFindingsSummary{
+				FindingSeverityCounts: aws.Int64Map(map[string]int64{"HIGH": 1, "LOW": 3}),
+			},
+		},
+		{
+			RepositoryName:   aws.String("web"),
+			ImageDigest:      aws.String("sha256:web0"),
+			ImagePushedAt:    aws.Time(now.Add(-40 * 24 * time.Hour)),
+			ImageSizeInBytes: aws.Int64(200 * 1000 * 1000),
+		},
+		{
+			RepositoryName:   aws.String("api"),
+			ImageDigest:      aws.String("sha256:api1"),
+			ImageTags:        aws.StringSlice([]string{"release-3"}),
+			ImagePushedAt:    aws.Time(now.Add(-10 * 24 * time.Hour)),
+			ImageSizeInBytes: aws.Int64(10 * 1000 * 1000),
+		},
+	}
+}
+
+// listImages runs images with the given flags against the listed images
+func listImages(t *testing.T, args ...string) (string, error) {
+	mockECR, _, _, _ := setupTestController(t)
+	expectListedImages(mockECR)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images", 0)
+	flagSet.Int(flags.SinceFlag, 0, "")
+	flagSet.Int(flags.OlderThanFlag, 0, "")
+	flagSet.String(flags.TagPrefixFlag, "", "")
+	flagSet.String(flags.MinSizeFlag, "", "")
+	flagSet.String(flags.SortFlag, "", "")
+	flagSet.String(flags.FormatFlag, "", "")
+	flagSet.Bool(flags.PlatformsFlag, false, "")
+	require.NoError(t, flagSet.Parse(args))
+
+	out := &bytes.Buffer{}
+	err := getImages(cli.NewContext(nil, flagSet, nil), newMockReadWriter(), mockECR, out)
+	return out.String(), err
+}
+
+func expectListedImages(mockECR *mock_ecr.MockClient) {
+	mockECR.EXPECT().GetImages(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Do(func(_, _, _, x interface{}) {
+		x.(ecr.ProcessImageDetails)(listedImages())
+	}).Return(nil).AnyTimes()
+	mockECR.EXPECT().GetAuthorizationTokenByID(gomock.Any()).Return(nil, errors.New("no access")).AnyTimes()
+}
+
+func listedDigests(t *testing.T, args ...string) []string {
+	output, err := listImages(t, append(args, "--"+flags.FormatFlag, "{{.Digest}}")...)
+	require.NoError(t, err, "Unexpected error listing images")
+	return strings.Fields(output)
+}
+
+func TestImageList_Table(t *testing.T) {
+	output, err := listImages(t)
+	require.NoError(t, err, "Unexpected error listing images")
+
+	assert.Regexp(t, `REPOSITORY NAME\s+TAG\s+IMAGE DIGEST\s+PUSHED AT\s+SIZE\s+SCAN STATUS`, output)
+	assert.Regexp(t, `web\s+v1\s+sha256:web1\s+2 days ago\s+50MB\s+COMPLETE \(1 HIGH, 3 LOW\)`, output)
+	assert.Regexp(t, `web\s+latest\s+sha256:web1`, output)
+	assert.Regexp(t, `web\s+<none>\s+sha256:web0\s+.*200MB\s+-`, output)
+	assert.Regexp(t, `REPOSITORY NAME\s+IMAGES\s+TOTAL SIZE\s+api\s+1\s+10MB\s+web\s+2\s+250MB`, output, "Expected repository totals")
+}
+
+func TestImageList_TableWithPlatforms(t *testing.T) {
+	output, err := listImages(t, "--"+flags.PlatformsFlag)
+	require.NoError(t, err, "Unexpected error listing images")
+
+	assert.Regexp(t, `REPOSITORY NAME\s+TAG\s+IMAGE DIGEST\s+PUSHED AT\s+SIZE\s+PLATFORMS\s+SCAN STATUS`, output)
+	assert.Regexp(t, `web\s+v1\s+sha256:web1\s+2 days ago\s+50MB\s+-\s+COMPLETE \(1 HIGH, 3 LOW\)`, output)
+	assert.Regexp(t, `web\s+<none>\s+sha256:web0\s+.*200MB\s+-\s+-`, output)
+}
+
+func TestUsesPlatforms(t *testing.T) {
+	assert.False(t, usesPlatforms(TableFormat))
+	assert.False(t, usesPlatforms(JSONFormat))
+	assert.False(t, usesPlatforms("{{.Digest}}"))
+	assert.True(t, usesPlatforms("{{.Digest}} {{.Platforms}}"))
+}
+
+func TestImageList_JSON(t *testing.T) {
+	output, err := listImages(t, "--"+flags.FormatFlag, JSONFormat)
+	require.NoError(t, err, "Unexpected error listing images")
+
+	listing := imageListing{}
+	require.NoError(t, json.Unmarshal([]byte(output), &listing), "Expected JSON output")
+	require.Len(t, listing.Images, 3)
+	assert.Equal(t, "web", listing.Images[0].Repository)
+	assert.Equal(t, []string{"v1", "latest"}, listing.Images[0].Tags)
+	assert.Equal(t, int64(50*1000*1000), listing.Images[0].SizeInBytes)
+	assert.Equal(t, ecrApi.ScanStatusComplete, listing.Images[0].ScanStatus)
+	assert.Equal(t, map[string]int64{"HIGH": 1, "LOW": 3}, listing.Images[0].ScanFindings)
+	assert.Equal(t, []string{}, listing.Images[1].Tags, "Expected empty tags of untagged image")
+	assert.Equal(t, []repositorySummary{
+		{Repository: "api", ImageCount: 1, TotalSizeInBytes: 10 * 1000 * 1000},
+		{Repository: "web", ImageCount: 2, TotalSizeInBytes: 250 * 1000 * 1000},
+	}, listing.Repositories)
+}
+
+func TestImageList_YAML(t *testing.T) {
+	output, err := listImages(t, "--"+flags.FormatFlag, YAMLFormat, "--"+flags.TagPrefixFlag, "release-")
+	require.NoError(t, err, "Unexpected error listing images")
+
+	listing := imageListing{}
+	require.NoError(t, yaml.Unmarshal([]byte(output), &listing), "Expected YAML output")
+	require.Len(t, listing.Images, 1)
+	assert.Equal(t, "sha256:api1", listing.Images[0].Digest)
+	assert.Equal(t, []repositorySummary{{Repository: "api", ImageCount: 1, TotalSizeInBytes: 10 * 1000 * 1000}}, listing.Repositories)
+}
+
+func TestImageList_Template(t *testing.T) {
+	output, err := listImages(t, "--"+flags.FormatFlag, `{{.Repository}} {{join .Tags ","}}`)
+	require.NoError(t, err, "Unexpected error listing images")
+	assert.Equal(t, "web v1,latest\nweb \napi release-3\n", output)
+}
+
+func TestImageList_Filters(t *testing.T) {
+	assert.Equal(t, []string{"sha256:web1", "sha256:api1"}, listedDigests(t, "--"+flags.SinceFlag, "30"))
+	assert.Equal(t, []string{"sha256:web0", "sha256:api1"}, listedDigests(t, "--"+flags.OlderThanFlag, "5"))
+	assert.Equal(t, []string{"sha256:web1", "sha256:api1"}, listedDigests(t, "--"+flags.TagPrefixFlag, "v, release-"))
+	assert.Equal(t, []string{"sha256:web1", "sha256:web0"}, listedDigests(t, "--"+flags.MinSizeFlag, "50MB"))
+	assert.Equal(t, []string{"sha256:api1"}, listedDigests(t, "--"+flags.SinceFlag, "30", "--"+flags.OlderThanFlag, "5"))
+}
+
+func TestImageList_Sort(t *testing.T) {
+	assert.Equal(t, []string{"sha256:web1", "sha256:api1", "sha256:web0"}, listedDigests(t, "--"+flags.SortFlag, SortByPushed))
+	assert.Equal(t, []string{"sha256:web0", "sha256:web1", "sha256:api1"}, listedDigests(t, "--"+flags.SortFlag, SortBySize))
+}
+
+func TestImageList_InvalidFlags(t *testing.T) {
+	for _, args := range [][]string{
+		{"--" + flags.FormatFlag, "xml"},
+		{"--" + flags.FormatFlag, "{{.Repository"},
+		{"--" + flags.SortFlag, "name"},
+		{"--" + flags.MinSizeFlag, "big"},
+		{"--" + flags.SinceFlag, "-1"},
+	} {
+		mockECR, _, _, _ := setupTestController(t)
+		flagSet := flag.NewFlagSet("ecs-cli-images", 0)
+		flagSet.Int(flags.SinceFlag, 0, "")
+		flagSet.String(flags.MinSizeFlag, "", "")
+		flagSet.String(flags.SortFlag, "", "")
+		flagSet.String(flags.FormatFlag, "", "")
+		require.NoError(t, flagSet.Parse(args))
+
+		err := getImages(cli.NewContext(nil, flagSet, nil), newMockReadWriter(), mockECR, &bytes.Buffer{})
+		assert.Error(t, err, "Expected error for %v", args)
+	}
+}
+
+func TestImageList_Empty(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	mockECR.EXPECT().GetImages(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images", 0)
+	flagSet.String(flags.FormatFlag, JSONFormat, "")
+	out := &bytes.Buffer{}
+	err := getImages(cli.NewContext(nil, flagSet, nil), newMockReadWriter(), mockECR, out)
+	require.NoError(t, err, "Unexpected error listing images")
+	assert.JSONEq(t, `{"images":[],"repositories":[]}`, out.String())
+}
@@ -0,0 +1,92 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/credhelper"
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/dockerconfig"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// lookPath finds the credential helper binary; it is replaced in tests
+var lookPath = exec.LookPath
+
+// ImageLogin logs Docker in to an ECR registry
+func ImageLogin(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'images login': ", err)
+	}
+
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'images login': ", err)
+	}
+
+	dockerConfig, err := dockerconfig.Load()
+	if err != nil {
+		logrus.Fatal("Error executing 'images login': ", err)
+	}
+
+	ecrClient := getECRClient(c, commandConfig)
+
+	if err := loginRegistry(c, ecrClient, dockerConfig, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'images login': ", err)
+	}
+}
+
+// loginRegistry writes either an authorization token for the registry or
+// the ECS CLI credential helper into the Docker config file
+func loginRegistry(c *cli.Context, ecrClient ecrclient.Client, dockerConfig *dockerconfig.ConfigFile, out io.Writer) error {
+	auth, err := ecrClient.GetAuthorizationData(c.String(flags.RegistryIdFlag))
+	if err != nil {
+		return err
+	}
+
+	if c.Bool(flags.CredentialHelperFlag) {
+		if err := dockerConfig.SetCredentialHelper(auth.Registry, credhelper.HelperName); err != nil {
+			return err
+		}
+		if _, err := lookPath(credhelper.BinaryName); err != nil {
+			logrus.Warnf("%s was not found in your PATH. Docker runs it to get credentials, so create a link to the ECS CLI named %s in a directory in your PATH.", credhelper.BinaryName, credhelper.BinaryName)
+		}
+	} else {
+		if helper := dockerConfig.CredentialsStore(auth.Registry); helper != "" {
+			logrus.Warnf("Docker uses the credential helper docker-credential-%s for %s, so it ignores the authorization token in %s.", helper, auth.Registry, dockerConfig.Path)
+		}
+		if err := dockerConfig.SetAuth(auth.Registry, auth.Username, auth.Password); err != nil {
+			return err
+		}
+	}
+
+	if err := dockerConfig.Save(); err != nil {
+		return err
+	}
+
+	if c.Bool(flags.CredentialHelperFlag) {
+		fmt.Fprintf(out, "Docker now gets credentials for %s from the ECS CLI\n", auth.Registry)
+	} else {
+		fmt.Fprintf(out, "Login Succeeded for %s; the authorization token expires at %s\n", auth.Registry, auth.ExpiresAt.Local().Format("2006-01-02 15:04:05 MST"))
+	}
+	return nil
+}
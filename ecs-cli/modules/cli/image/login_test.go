@@ -0,0 +1,133 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/dockerconfig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+const loginRegistryURI = "123456789012.dkr.ecr.us-west-2.amazonaws.com"
+
+func loginAuth() *ecr.Auth {
+	return &ecr.Auth{
+		ProxyEndpoint: "https://" + loginRegistryURI,
+		Registry:      loginRegistryURI,
+		Username:      "AWS",
+		Password:      "secret",
+		ExpiresAt:     time.Now().Add(12 * time.Hour),
+	}
+}
+
+func setupDockerConfig(t *testing.T, content string) (*dockerconfig.ConfigFile, func()) {
+	dir, err := ioutil.TempDir("", "ecs-cli-login")
+	require.NoError(t, err)
+	path := filepath.Join(dir, "config.json")
+	if content != "" {
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	}
+	dockerConfig, err := dockerconfig.LoadFile(path)
+	require.NoError(t, err)
+	return dockerConfig, func() { os.RemoveAll(dir) }
+}
+
+func readDockerConfig(t *testing.T, path string) map[string]interface{} {
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	content := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(data, &content))
+	return content
+}
+
+func TestLoginRegistry(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	dockerConfig, cleanup := setupDockerConfig(t, `{"credsStore":"desktop"}`)
+	defer cleanup()
+
+	mockECR.EXPECT().GetAuthorizationData("").Return(loginAuth(), nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-login", 0)
+	out := &bytes.Buffer{}
+	err := loginRegistry(cli.NewContext(nil, flagSet, nil), mockECR, dockerConfig, out)
+	require.NoError(t, err, "Unexpected error logging in")
+	assert.Contains(t, out.String(), "Login Succeeded for "+loginRegistryURI)
+
+	content := readDockerConfig(t, dockerConfig.Path)
+	assert.Equal(t, "desktop", content["credsStore"], "Expected other settings to be kept")
+	auths := content["auths"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"auth": "QVdTOnNlY3JldA=="}, auths[loginRegistryURI])
+}
+
+func TestLoginRegistryWithRegistryID(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	dockerConfig, cleanup := setupDockerConfig(t, "")
+	defer cleanup()
+
+	mockECR.EXPECT().GetAuthorizationData("123456789012").Return(loginAuth(), nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-login", 0)
+	flagSet.String(flags.RegistryIdFlag, "123456789012", "")
+	err := loginRegistry(cli.NewContext(nil, flagSet, nil), mockECR, dockerConfig, &bytes.Buffer{})
+	assert.NoError(t, err, "Unexpected error logging in")
+}
+
+func TestLoginRegistryWithCredentialHelper(t *testing.T) {
+	defer func(restore func(string) (string, error)) { lookPath = restore }(lookPath)
+	lookPath = func(file string) (string, error) { return "", errors.New("not found") }
+
+	mockECR, _, _, _ := setupTestController(t)
+	dockerConfig, cleanup := setupDockerConfig(t, "")
+	defer cleanup()
+
+	mockECR.EXPECT().GetAuthorizationData("").Return(loginAuth(), nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-login", 0)
+	flagSet.Bool(flags.CredentialHelperFlag, true, "")
+	out := &bytes.Buffer{}
+	err := loginRegistry(cli.NewContext(nil, flagSet, nil), mockECR, dockerConfig, out)
+	require.NoError(t, err, "Unexpected error logging in")
+	assert.Contains(t, out.String(), "from the ECS CLI")
+
+	content := readDockerConfig(t, dockerConfig.Path)
+	assert.Equal(t, map[string]interface{}{loginRegistryURI: "ecs-cli"}, content["credHelpers"])
+	assert.Nil(t, content["auths"], "Expected no token to be written")
+}
+
+func TestLoginRegistryErrorCase(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	dockerConfig, cleanup := setupDockerConfig(t, "")
+	defer cleanup()
+
+	mockECR.EXPECT().GetAuthorizationData("").Return(nil, errors.New("something failed"))
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-login", 0)
+	err := loginRegistry(cli.NewContext(nil, flagSet, nil), mockECR, dockerConfig, &bytes.Buffer{})
+	assert.Error(t, err, "Expected error logging in")
+	_, err = os.Stat(dockerConfig.Path)
+	assert.True(t, os.IsNotExist(err), "Expected Docker config file not to be written")
+}
@@ -0,0 +1,209 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"fmt"
+	"strings"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	dockerclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker"
+	registryclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/registry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultTag       = "latest"
+	unknownPlatforms = "-"
+)
+
+// newRegistryClient creates the client used to read and push manifests in
+// ECR with the Docker Registry API, which can be replaced in tests
+var newRegistryClient = registryclient.NewClientWithCredentials
+
+// multiPlatformImage contains the names of an image pushed for several platforms
+type multiPlatformImage struct {
+	localName     string // name of the local images, without a tag
+	repository    string
+	repositoryURI string
+	tag           string
+	platforms     []registryclient.Platform
+	tagLocal      bool // whether the local images need to be tagged with the repository URI
+}
+
+// parsePlatforms parses the comma separated list of platforms given to push
+func parsePlatforms(value string) ([]registryclient.Platform, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var platforms []registryclient.Platform
+	tags := make(map[string]string)
+	for _, platformValue := range strings.Split(value, ",") {
+		platform, err := registryclient.ParsePlatform(platformValue)
+		if err != nil {
+			return nil, err
+		}
+		suffix := platformTagSuffix(platform)
+		if other, ok := tags[suffix]; ok {
+			return nil, fmt.Errorf("platforms %s and %s would both be pushed with the tag suffix %s", other, platform, suffix)
+		}
+		tags[suffix] = platform.String()
+		platforms = append(platforms, platform)
+	}
+	return platforms, nil
+}
+
+// platformTagSuffix returns the suffix of the tag of the image for a platform,
+// which is its architecture and variant, for example arm64 or arm-v7
+func platformTagSuffix(platform registryclient.Platform) string {
+	if platform.Variant != "" {
+		return platform.Architecture + "-" + platform.Variant
+	}
+	return platform.Architecture
+}
+
+// pushMultiPlatformImage pushes the local image for each platform under its
+// own tag, then pushes a manifest list which refers to all of them under the
+// tag of the image, so that each platform pulls its own image
+func pushMultiPlatformImage(image multiPlatformImage, ecrAuth *ecrclient.Auth, dockerAuth docker.AuthConfiguration, dockerClient dockerclient.Client) error {
+	tag := image.tag
+	if tag == "" {
+		tag = defaultTag
+	}
+	registryClient := newRegistryClient(ecrAuth.Registry, docker.AuthConfiguration{
+		Username: ecrAuth.Username,
+		Password: ecrAuth.Password,
+	})
+
+	var manifests []*registryclient.Manifest
+	for _, platform := range image.platforms {
+		platformTag := tag + "-" + platformTagSuffix(platform)
+		localImage := image.localName + ":" + platformTag
+
+		localDetails, err := dockerClient.InspectImage(localImage)
+		if err != nil {
+			return err
+		}
+		if localDetails.Architecture != platform.Architecture {
+			return fmt.Errorf("image %s is built for architecture %s, not for platform %s", localImage, localDetails.Architecture, platform)
+		}
+
+		if image.tagLocal {
+			if err = dockerClient.TagImage(localImage, image.repositoryURI, platformTag); err != nil {
+				return err
+			}
+		}
+		if err = dockerClient.PushImage(image.repositoryURI, platformTag, ecrAuth.Registry, dockerAuth); err != nil {
+			return err
+		}
+
+		manifest, err := registryClient.GetManifest(ecrAuth.Registry, image.repository, platformTag)
+		if err != nil {
+			return err
+		}
+		manifests = append(manifests, manifest)
+	}
+
+	manifestList, err := registryclient.NewManifestList(manifests, image.platforms)
+	if err != nil {
+		return err
+	}
+	digest, err := registryClient.PutManifest(ecrAuth.Registry, image.repository, tag, manifestList)
+	if err != nil {
+		return err
+	}
+	logrus.WithFields(logrus.Fields{
+		"repository": image.repository,
+		"tag":        tag,
+		"digest":     digest,
+	}).Info("Pushed manifest list")
+	return nil
+}
+
+// platformFinder looks up the platforms of images in ECR, authenticating
+// with each registry once
+type platformFinder struct {
+	ecrClient ecrclient.Client
+	clients   map[string]registryclient.Client
+	hosts     map[string]string
+	authErrs  map[string]error
+	warned    bool
+}
+
+func newPlatformFinder(ecrClient ecrclient.Client) *platformFinder {
+	return &platformFinder{
+		ecrClient: ecrClient,
+		clients:   make(map[string]registryclient.Client),
+		hosts:     make(map[string]string),
+		authErrs:  make(map[string]error),
+	}
+}
+
+// platforms returns the platforms of an image, which are those of each image
+// in a manifest list, or "-" if they can not be found
+func (f *platformFinder) platforms(image *ecr.ImageDetail) string {
+	platforms, err := f.lookUp(image)
+	if err != nil {
+		if !f.warned {
+			logrus.Warnf("Unable to look up image platforms: %v", err)
+			f.warned = true
+		} else {
+			logrus.Debugf("Unable to look up image platforms: %v", err)
+		}
+		return unknownPlatforms
+	}
+	if len(platforms) == 0 {
+		return unknownPlatforms
+	}
+
+	names := make([]string, len(platforms))
+	for i, platform := range platforms {
+		names[i] = platform.String()
+	}
+	return strings.Join(names, ",")
+}
+
+func (f *platformFinder) lookUp(image *ecr.ImageDetail) ([]registryclient.Platform, error) {
+	client, host, err := f.registryClient(aws.StringValue(image.RegistryId))
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPlatforms(host, aws.StringValue(image.RepositoryName), aws.StringValue(image.ImageDigest))
+}
+
+// registryClient returns the client and host of the registry with the given
+// ID, authenticating with it the first time
+func (f *platformFinder) registryClient(registryID string) (registryclient.Client, string, error) {
+	if err := f.authErrs[registryID]; err != nil {
+		return nil, "", err
+	}
+	client, ok := f.clients[registryID]
+	if !ok {
+		auth, err := f.ecrClient.GetAuthorizationTokenByID(registryID)
+		if err != nil {
+			f.authErrs[registryID] = err
+			return nil, "", err
+		}
+		client = newRegistryClient(auth.Registry, docker.AuthConfiguration{
+			Username: auth.Username,
+			Password: auth.Password,
+		})
+		f.clients[registryID] = client
+		f.hosts[registryID] = auth.Registry
+	}
+	return client, f.hosts[registryID], nil
+}
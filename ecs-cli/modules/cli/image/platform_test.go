@@ -0,0 +1,162 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	registryclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/registry"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/registry/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	ecrApi "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/fsouza/go-dockerclient"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func TestImagePush_MultiPlatform(t *testing.T) {
+	mockECR, mockDocker, mockSTS, mockTagging := setupTestController(t)
+	mockRegistry, restore := stubRegistryClient(t)
+	defer restore()
+	setupEnvironmentVar()
+
+	amdManifest := &registryclient.Manifest{MediaType: registryclient.MediaTypeManifest, Digest: "sha256:amd", Body: []byte("{}")}
+	armManifest := &registryclient.Manifest{MediaType: registryclient.MediaTypeManifest, Digest: "sha256:arm", Body: []byte("{}")}
+
+	gomock.InOrder(
+		mockSTS.EXPECT().GetAWSAccountID().Return(registryID, nil),
+		mockECR.EXPECT().GetAuthorizationTokenByID(gomock.Any()).Return(&ecr.Auth{
+			Registry: registry,
+		}, nil),
+		mockECR.EXPECT().RepositoryExists(repository).Return(true),
+		mockDocker.EXPECT().InspectImage(image+"-amd64").Return(&docker.Image{Architecture: "amd64"}, nil),
+		mockDocker.EXPECT().TagImage(image+"-amd64", repositoryURI, tag+"-amd64").Return(nil),
+		mockDocker.EXPECT().PushImage(repositoryURI, tag+"-amd64", registry, docker.AuthConfiguration{}).Return(nil),
+		mockRegistry.EXPECT().GetManifest(registry, repository, tag+"-amd64").Return(amdManifest, nil),
+		mockDocker.EXPECT().InspectImage(image+"-arm-v7").Return(&docker.Image{Architecture: "arm"}, nil),
+		mockDocker.EXPECT().TagImage(image+"-arm-v7", repositoryURI, tag+"-arm-v7").Return(nil),
+		mockDocker.EXPECT().PushImage(repositoryURI, tag+"-arm-v7", registry, docker.AuthConfiguration{}).Return(nil),
+		mockRegistry.EXPECT().GetManifest(registry, repository, tag+"-arm-v7").Return(armManifest, nil),
+		mockRegistry.EXPECT().PutManifest(registry, repository, tag, gomock.Any()).Do(func(_, _, _ string, manifest *registryclient.Manifest) {
+			assert.Equal(t, registryclient.MediaTypeManifestList, manifest.MediaType)
+			list := registryclient.ManifestList{}
+			assert.NoError(t, json.Unmarshal(manifest.Body, &list))
+			if assert.Len(t, list.Manifests, 2) {
+				assert.Equal(t, "sha256:amd", list.Manifests[0].Digest)
+				assert.Equal(t, "linux/amd64", list.Manifests[0].Platform.String())
+				assert.Equal(t, "sha256:arm", list.Manifests[1].Digest)
+				assert.Equal(t, "linux/arm/v7", list.Manifests[1].Platform.String())
+			}
+		}).Return("sha256:list", nil),
+	)
+
+	err := pushImage(pushPlatformContext(image, "linux/amd64,linux/arm/v7"), region, mockDocker, mockECR, mockSTS, mockTagging)
+	assert.NoError(t, err, "Error pushing multi-platform image")
+}
+
+func TestImagePush_MultiPlatformWrongArchitecture(t *testing.T) {
+	mockECR, mockDocker, mockSTS, mockTagging := setupTestController(t)
+	_, restore := stubRegistryClient(t)
+	defer restore()
+	setupEnvironmentVar()
+
+	gomock.InOrder(
+		mockSTS.EXPECT().GetAWSAccountID().Return(registryID, nil),
+		mockECR.EXPECT().GetAuthorizationTokenByID(gomock.Any()).Return(&ecr.Auth{
+			Registry: registry,
+		}, nil),
+		mockECR.EXPECT().RepositoryExists(repository).Return(true),
+		mockDocker.EXPECT().InspectImage(image+"-arm64").Return(&docker.Image{Architecture: "amd64"}, nil),
+	)
+
+	err := pushImage(pushPlatformContext(image, "linux/arm64"), region, mockDocker, mockECR, mockSTS, mockTagging)
+	assert.Error(t, err, "Expected error when local image is built for another architecture")
+}
+
+func TestImagePush_InvalidPlatform(t *testing.T) {
+	mockECR, mockDocker, mockSTS, mockTagging := setupTestController(t)
+
+	err := pushImage(pushPlatformContext(image, "linux/amd64,arm64"), region, mockDocker, mockECR, mockSTS, mockTagging)
+	assert.Error(t, err, "Expected error for invalid platform")
+}
+
+func TestParsePlatforms(t *testing.T) {
+	platforms, err := parsePlatforms("linux/amd64,linux/arm64")
+	assert.NoError(t, err, "Unexpected error when parsing platforms")
+	assert.Equal(t, []registryclient.Platform{
+		{OS: "linux", Architecture: "amd64"},
+		{OS: "linux", Architecture: "arm64"},
+	}, platforms)
+
+	platforms, err = parsePlatforms("")
+	assert.NoError(t, err, "Unexpected error when no platforms are given")
+	assert.Empty(t, platforms)
+
+	_, err = parsePlatforms("linux/amd64,windows/amd64")
+	assert.Error(t, err, "Expected error for platforms with the same tag suffix")
+}
+
+func TestPlatformFinder(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	mockRegistry, restore := stubRegistryClient(t)
+	defer restore()
+
+	mockECR.EXPECT().GetAuthorizationTokenByID(registryID).Return(&ecr.Auth{Registry: registry}, nil)
+	mockRegistry.EXPECT().GetPlatforms(registry, repository, "sha256:list").Return([]registryclient.Platform{
+		{OS: "linux", Architecture: "amd64"},
+		{OS: "linux", Architecture: "arm", Variant: "v7"},
+	}, nil)
+	mockRegistry.EXPECT().GetPlatforms(registry, repository, "sha256:missing").Return(nil, errors.New("something failed"))
+	mockECR.EXPECT().GetAuthorizationTokenByID("210987654321").Return(nil, errors.New("access denied"))
+
+	finder := newPlatformFinder(mockECR)
+	assert.Equal(t, "linux/amd64,linux/arm/v7", finder.platforms(imageDetail(registryID, "sha256:list")))
+	assert.Equal(t, unknownPlatforms, finder.platforms(imageDetail(registryID, "sha256:missing")))
+	assert.Equal(t, unknownPlatforms, finder.platforms(imageDetail("210987654321", "sha256:other")))
+	assert.Equal(t, unknownPlatforms, finder.platforms(imageDetail("210987654321", "sha256:another")), "Expected authorization to be tried once per registry")
+}
+
+func imageDetail(registryID, digest string) *ecrApi.ImageDetail {
+	return &ecrApi.ImageDetail{
+		RegistryId:     aws.String(registryID),
+		RepositoryName: aws.String(repository),
+		ImageDigest:    aws.String(digest),
+	}
+}
+
+func pushPlatformContext(image, platforms string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-push", 0)
+	flagSet.String(flags.PlatformFlag, platforms, "")
+	flagSet.Parse([]string{image})
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+// stubRegistryClient replaces the registry client with a mock, and returns a
+// function which restores it
+func stubRegistryClient(t *testing.T) (*mock_registry.MockClient, func()) {
+	mockRegistry := mock_registry.NewMockClient(gomock.NewController(t))
+	original := newRegistryClient
+	newRegistryClient = func(string, docker.AuthConfiguration) registryclient.Client {
+		return mockRegistry
+	}
+	return mockRegistry, func() {
+		newRegistryClient = original
+	}
+}
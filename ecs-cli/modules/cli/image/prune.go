@@ -0,0 +1,327 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	registryclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/registry"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	units "github.com/docker/go-units"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// PruneImageFormat is the argument of images prune
+const PruneImageFormat = "ECR_REPOSITORY [ECR_REPOSITORY...]"
+
+// ImagePrune deletes the images of ECR repositories which match the filters
+func ImagePrune(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'images prune': ", err)
+	}
+
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'images prune': ", err)
+	}
+
+	ecrClient := getECRClient(c, commandConfig)
+
+	if err := pruneImages(c, ecrClient, os.Stdout, bufio.NewReader(os.Stdin)); err != nil {
+		logrus.Fatal("Error executing 'images prune': ", err)
+	}
+}
+
+// pruneFilter selects the images of a repository to delete. An image is
+// selected if it matches all of the filters which are set, unless it is one
+// of the keepLast most recently pushed images (of each tag prefix, if tag
+// prefixes are given).
+type pruneFilter struct {
+	untagged    bool
+	olderThan   int // days
+	keepLast    int
+	tagPrefixes []string
+}
+
+func newPruneFilter(c *cli.Context) (*pruneFilter, error) {
+	filter := &pruneFilter{
+		untagged:    c.Bool(flags.UntaggedFlag),
+		olderThan:   c.Int(flags.OlderThanFlag),
+		keepLast:    c.Int(flags.KeepLastFlag),
+		tagPrefixes: splitTagPrefixes(c.String(flags.TagPrefixFlag)),
+	}
+	if filter.olderThan < 0 || filter.keepLast < 0 {
+		return nil, fmt.Errorf("--%s and --%s can not be negative", flags.OlderThanFlag, flags.KeepLastFlag)
+	}
+
+	if !filter.untagged && filter.olderThan == 0 && filter.keepLast == 0 {
+		return nil, fmt.Errorf("at least one of --%s, --%s or --%s must be specified", flags.UntaggedFlag, flags.OlderThanFlag, flags.KeepLastFlag)
+	}
+	if filter.untagged && len(filter.tagPrefixes) > 0 {
+		return nil, fmt.Errorf("--%s can not be used with --%s", flags.TagPrefixFlag, flags.UntaggedFlag)
+	}
+	return filter, nil
+}
+
+// selectImages returns the images which the filter selects for deletion,
+// most recently pushed first
+func (f *pruneFilter) selectImages(images []*ecr.ImageDetail, now time.Time) []*ecr.ImageDetail {
+	sorted := make([]*ecr.ImageDetail, len(images))
+	copy(sorted, images)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return aws.TimeValue(sorted[i].ImagePushedAt).After(aws.TimeValue(sorted[j].ImagePushedAt))
+	})
+
+	kept := make(map[*ecr.ImageDetail]bool)
+	if f.keepLast > 0 {
+		if len(f.tagPrefixes) == 0 {
+			for i := 0; i < f.keepLast && i < len(sorted); i++ {
+				kept[sorted[i]] = true
+			}
+		}
+		for _, prefix := range f.tagPrefixes {
+			count := 0
+			for _, image := range sorted {
+				if count < f.keepLast && hasTagPrefix(image, prefix) {
+					kept[image] = true
+					count++
+				}
+			}
+		}
+	}
+
+	cutoff := now.AddDate(0, 0, -f.olderThan)
+	selected := []*ecr.ImageDetail{}
+	for _, image := range sorted {
+		if kept[image] {
+			continue
+		}
+		if f.untagged && len(image.ImageTags) > 0 {
+			continue
+		}
+		if len(f.tagPrefixes) > 0 && !f.matchesTagPrefix(image) {
+			continue
+		}
+		if f.olderThan > 0 && !aws.TimeValue(image.ImagePushedAt).Before(cutoff) {
+			continue
+		}
+		selected = append(selected, image)
+	}
+	return selected
+}
+
+func (f *pruneFilter) matchesTagPrefix(image *ecr.ImageDetail) bool {
+	for _, prefix := range f.tagPrefixes {
+		if hasTagPrefix(image, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitTagPrefixes parses a comma separated list of tag prefixes
+func splitTagPrefixes(value string) []string {
+	var prefixes []string
+	for _, prefix := range strings.Split(value, ",") {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+func hasTagPrefix(image *ecr.ImageDetail, prefix string) bool {
+	for _, tag := range image.ImageTags {
+		if strings.HasPrefix(aws.StringValue(tag), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func pruneImages(c *cli.Context, ecrClient ecrclient.Client, out io.Writer, reader *bufio.Reader) error {
+	filter, err := newPruneFilter(c)
+	if err != nil {
+		return err
+	}
+	repositories := c.Args()
+	if len(repositories) == 0 {
+		return fmt.Errorf("at least 1 repository name is required")
+	}
+	registryID := c.String(flags.RegistryIdFlag)
+
+	now := time.Now()
+	finder := newPlatformFinder(ecrClient)
+	selected := make(map[string][]*ecr.ImageDetail)
+	total := 0
+
+	w := tabwriter.NewWriter(out, MinWidth, TabWidth, Padding, PaddingChar, NumOfFlags)
+	fmt.Fprintln(w, "REPOSITORY NAME\tTAGS\tIMAGE DIGEST\tPUSHED AT\tSIZE\t")
+	for _, repository := range repositories {
+		// Tagged images are listed with --untagged too, to find the manifest
+		// lists which refer to untagged images
+		images := []*ecr.ImageDetail{}
+		err := ecrClient.GetImages([]*string{aws.String(repository)}, "", registryID, func(imageDetails []*ecr.ImageDetail) error {
+			images = append(images, imageDetails...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		selected[repository], err = keepListedImages(images, filter.selectImages(images, now), finder)
+		if err != nil {
+			return err
+		}
+		for _, image := range selected[repository] {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n",
+				repository,
+				formatImageTags(image.ImageTags),
+				aws.StringValue(image.ImageDigest),
+				units.HumanDuration(now.Sub(aws.TimeValue(image.ImagePushedAt)))+" ago",
+				units.HumanSizeWithPrecision(float64(aws.Int64Value(image.ImageSizeInBytes)), 3),
+			)
+		}
+		total += len(selected[repository])
+	}
+	w.Flush()
+
+	if total == 0 {
+		logrus.Info("No images to prune")
+		return nil
+	}
+	if c.Bool(flags.DryRunFlag) {
+		fmt.Fprintf(out, "\n%d images would be deleted\n", total)
+		return nil
+	}
+
+	if !c.Bool(flags.ForceFlag) {
+		fmt.Fprintf(out, "\nAre you sure you want to delete these %d images? [y/N]\n", total)
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("Error reading input: %s", err.Error())
+		}
+		formattedInput := strings.ToLower(strings.TrimSpace(input))
+		if formattedInput != "yes" && formattedInput != "y" {
+			return fmt.Errorf("Aborted image deletion. To delete the images, re-run this command and specify the '--%s' flag or confirm that you'd like to delete them at the prompt.", flags.ForceFlag)
+		}
+	}
+
+	deleted := 0
+	for _, repository := range repositories {
+		imageIDs := []*ecr.ImageIdentifier{}
+		for _, image := range selected[repository] {
+			imageIDs = append(imageIDs, &ecr.ImageIdentifier{ImageDigest: image.ImageDigest})
+		}
+		if len(imageIDs) == 0 {
+			continue
+		}
+
+		failures, err := ecrClient.DeleteImages(registryID, repository, imageIDs)
+		if err != nil {
+			return err
+		}
+		for _, failure := range failures {
+			digest := ""
+			if failure.ImageId != nil {
+				digest = aws.StringValue(failure.ImageId.ImageDigest)
+			}
+			logrus.WithFields(logrus.Fields{
+				"repository": repository,
+				"digest":     digest,
+				"reason":     aws.StringValue(failure.FailureReason),
+			}).Warn("Unable to delete image")
+		}
+		deleted += len(imageIDs) - len(failures)
+	}
+
+	logrus.WithField("count", deleted).Info("Pruned images")
+	if deleted < total {
+		return fmt.Errorf("%d of %d images could not be deleted", total-deleted, total)
+	}
+	return nil
+}
+
+// isManifestList returns whether the image is a manifest list or image index,
+// which refers to an image for each of several platforms
+func isManifestList(image *ecr.ImageDetail) bool {
+	mediaType := aws.StringValue(image.ImageManifestMediaType)
+	return mediaType == registryclient.MediaTypeManifestList || mediaType == registryclient.MediaTypeImageIndex
+}
+
+// keepListedImages removes the images which are referred to by a manifest
+// list or image index that is kept from the selected images. These images
+// are usually untagged, but deleting them would break the tags of the list.
+func keepListedImages(images, selected []*ecr.ImageDetail, finder *platformFinder) ([]*ecr.ImageDetail, error) {
+	deleted := make(map[string]bool)
+	for _, image := range selected {
+		deleted[aws.StringValue(image.ImageDigest)] = true
+	}
+
+	listed := make(map[string]bool)
+	for _, image := range images {
+		if !isManifestList(image) || deleted[aws.StringValue(image.ImageDigest)] {
+			continue
+		}
+		client, host, err := finder.registryClient(aws.StringValue(image.RegistryId))
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to read the manifest lists which refer to the images")
+		}
+		manifest, err := client.GetManifest(host, aws.StringValue(image.RepositoryName), aws.StringValue(image.ImageDigest))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read manifest list %s", aws.StringValue(image.ImageDigest))
+		}
+		descriptors, err := manifest.Manifests()
+		if err != nil {
+			return nil, err
+		}
+		for _, descriptor := range descriptors {
+			listed[descriptor.Digest] = true
+		}
+	}
+
+	kept := []*ecr.ImageDetail{}
+	for _, image := range selected {
+		if listed[aws.StringValue(image.ImageDigest)] {
+			logrus.WithFields(logrus.Fields{
+				"repository": aws.StringValue(image.RepositoryName),
+				"digest":     aws.StringValue(image.ImageDigest),
+			}).Debug("Keeping image referred to by a manifest list")
+			continue
+		}
+		kept = append(kept, image)
+	}
+	return kept, nil
+}
+
+func formatImageTags(tags []*string) string {
+	if len(tags) == 0 {
+		return "<none>"
+	}
+	return strings.Join(aws.StringValueSlice(tags), ",")
+}
@@ -0,0 +1,259 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	registryclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/registry"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	ecrApi "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func pruneTestImage(digest string, age time.Duration, tags ...string) *ecrApi.ImageDetail {
+	return &ecrApi.ImageDetail{
+		RepositoryName: aws.String(repository),
+		ImageDigest:    aws.String(digest),
+		ImagePushedAt:  aws.Time(time.Now().Add(-age)),
+		ImageTags:      aws.StringSlice(tags),
+	}
+}
+
+func digestsOf(images []*ecrApi.ImageDetail) []string {
+	digests := []string{}
+	for _, image := range images {
+		digests = append(digests, aws.StringValue(image.ImageDigest))
+	}
+	return digests
+}
+
+func TestSelectImages(t *testing.T) {
+	day := 24 * time.Hour
+	images := []*ecrApi.ImageDetail{
+		pruneTestImage("sha256:ci-old", 40*day, "ci-1"),
+		pruneTestImage("sha256:untagged-old", 30*day),
+		pruneTestImage("sha256:ci-new", 2*day, "ci-3"),
+		pruneTestImage("sha256:release", 60*day, "v1.0.0"),
+		pruneTestImage("sha256:ci-mid", 20*day, "ci-2"),
+		pruneTestImage("sha256:untagged-new", 1*time.Hour),
+	}
+
+	testCases := map[string]struct {
+		filter   pruneFilter
+		expected []string
+	}{
+		"untagged": {
+			filter:   pruneFilter{untagged: true},
+			expected: []string{"sha256:untagged-new", "sha256:untagged-old"},
+		},
+		"untagged older than": {
+			filter:   pruneFilter{untagged: true, olderThan: 7},
+			expected: []string{"sha256:untagged-old"},
+		},
+		"older than": {
+			filter:   pruneFilter{olderThan: 25},
+			expected: []string{"sha256:untagged-old", "sha256:ci-old", "sha256:release"},
+		},
+		"keep last": {
+			filter:   pruneFilter{keepLast: 4},
+			expected: []string{"sha256:ci-old", "sha256:release"},
+		},
+		"keep last per tag prefix": {
+			filter:   pruneFilter{keepLast: 1, tagPrefixes: []string{"ci-"}},
+			expected: []string{"sha256:ci-mid", "sha256:ci-old"},
+		},
+		"keep last per tag prefix older than": {
+			filter:   pruneFilter{keepLast: 1, olderThan: 30, tagPrefixes: []string{"ci-", "v"}},
+			expected: []string{"sha256:ci-old"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			selected := tc.filter.selectImages(images, time.Now())
+			assert.Equal(t, tc.expected, digestsOf(selected))
+		})
+	}
+}
+
+func TestPruneImages_NoFilter(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-prune", 0)
+	flagSet.Parse([]string{repository})
+	err := pruneImages(cli.NewContext(nil, flagSet, nil), mockECR, &bytes.Buffer{}, nil)
+	assert.Error(t, err, "Expected error when no filter is given")
+}
+
+func TestPruneImages_UntaggedWithTagPrefix(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-prune", 0)
+	flagSet.Bool(flags.UntaggedFlag, true, "")
+	flagSet.String(flags.TagPrefixFlag, "ci-", "")
+	flagSet.Parse([]string{repository})
+	err := pruneImages(cli.NewContext(nil, flagSet, nil), mockECR, &bytes.Buffer{}, nil)
+	assert.Error(t, err, "Expected error when --tag-prefix is used with --untagged")
+}
+
+func TestPruneImages_DryRun(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	mockECR.EXPECT().GetImages([]*string{aws.String(repository)}, "", "", gomock.Any()).Do(
+		func(_ []*string, _, _ string, fn ecr.ProcessImageDetails) {
+			fn([]*ecrApi.ImageDetail{pruneTestImage("sha256:untagged", time.Hour)})
+		}).Return(nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-prune", 0)
+	flagSet.Bool(flags.UntaggedFlag, true, "")
+	flagSet.Bool(flags.DryRunFlag, true, "")
+	flagSet.Parse([]string{repository})
+	out := &bytes.Buffer{}
+	err := pruneImages(cli.NewContext(nil, flagSet, nil), mockECR, out, nil)
+	assert.NoError(t, err, "Unexpected error pruning images")
+	assert.Regexp(t, repository+`\s+<none>\s+sha256:untagged`, out.String())
+	assert.Contains(t, out.String(), "1 images would be deleted")
+}
+
+func TestPruneImages_Confirmed(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	gomock.InOrder(
+		mockECR.EXPECT().GetImages([]*string{aws.String(repository)}, "", registryID, gomock.Any()).Do(
+			func(_ []*string, _, _ string, fn ecr.ProcessImageDetails) {
+				fn([]*ecrApi.ImageDetail{
+					pruneTestImage("sha256:new", time.Hour, "ci-2"),
+					pruneTestImage("sha256:old", 2*time.Hour, "ci-1"),
+				})
+			}).Return(nil),
+		mockECR.EXPECT().DeleteImages(registryID, repository, []*ecrApi.ImageIdentifier{
+			{ImageDigest: aws.String("sha256:old")},
+		}).Return([]*ecrApi.ImageFailure{}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-prune", 0)
+	flagSet.String(flags.RegistryIdFlag, registryID, "")
+	flagSet.Int(flags.KeepLastFlag, 1, "")
+	flagSet.Parse([]string{repository})
+	err := pruneImages(cli.NewContext(nil, flagSet, nil), mockECR, &bytes.Buffer{}, bufio.NewReader(strings.NewReader("y\n")))
+	assert.NoError(t, err, "Unexpected error pruning images")
+}
+
+func TestPruneImages_Aborted(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	mockECR.EXPECT().GetImages(gomock.Any(), "", "", gomock.Any()).Do(
+		func(_ []*string, _, _ string, fn ecr.ProcessImageDetails) {
+			fn([]*ecrApi.ImageDetail{pruneTestImage("sha256:old", 48*time.Hour, "ci-1")})
+		}).Return(nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-prune", 0)
+	flagSet.Int(flags.OlderThanFlag, 1, "")
+	flagSet.Parse([]string{repository})
+	err := pruneImages(cli.NewContext(nil, flagSet, nil), mockECR, &bytes.Buffer{}, bufio.NewReader(strings.NewReader("n\n")))
+	assert.Error(t, err, "Expected error when deletion is not confirmed")
+}
+
+func TestPruneImages_Failures(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	gomock.InOrder(
+		mockECR.EXPECT().GetImages(gomock.Any(), "", "", gomock.Any()).Do(
+			func(_ []*string, _, _ string, fn ecr.ProcessImageDetails) {
+				fn([]*ecrApi.ImageDetail{pruneTestImage("sha256:old", 48*time.Hour, "ci-1")})
+			}).Return(nil),
+		mockECR.EXPECT().DeleteImages("", repository, gomock.Any()).Return([]*ecrApi.ImageFailure{
+			{
+				ImageId:       &ecrApi.ImageIdentifier{ImageDigest: aws.String("sha256:old")},
+				FailureReason: aws.String("image is in use"),
+			},
+		}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-prune", 0)
+	flagSet.Int(flags.OlderThanFlag, 1, "")
+	flagSet.Bool(flags.ForceFlag, true, "")
+	flagSet.Parse([]string{repository})
+	err := pruneImages(cli.NewContext(nil, flagSet, nil), mockECR, &bytes.Buffer{}, nil)
+	assert.Error(t, err, "Expected error when images could not be deleted")
+}
+
+func TestPruneImages_UntaggedKeepsListedImages(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	mockRegistry, restore := stubRegistryClient(t)
+	defer restore()
+
+	list := pruneTestImage("sha256:list", time.Hour, "v1")
+	list.ImageManifestMediaType = aws.String(registryclient.MediaTypeManifestList)
+	oldList := pruneTestImage("sha256:oldlist", 48*time.Hour)
+	oldList.ImageManifestMediaType = aws.String(registryclient.MediaTypeImageIndex)
+	gomock.InOrder(
+		mockECR.EXPECT().GetImages([]*string{aws.String(repository)}, "", "", gomock.Any()).Do(
+			func(_ []*string, _, _ string, fn ecr.ProcessImageDetails) {
+				fn([]*ecrApi.ImageDetail{
+					list,
+					pruneTestImage("sha256:amd64", time.Hour),
+					pruneTestImage("sha256:arm64", time.Hour),
+					pruneTestImage("sha256:orphan", time.Hour),
+					oldList,
+				})
+			}).Return(nil),
+		mockECR.EXPECT().GetAuthorizationTokenByID("").Return(&ecr.Auth{Registry: registry}, nil),
+		mockRegistry.EXPECT().GetManifest(registry, repository, "sha256:list").Return(&registryclient.Manifest{
+			MediaType: registryclient.MediaTypeManifestList,
+			Digest:    "sha256:list",
+			Body:      []byte(`{"schemaVersion":2,"manifests":[{"digest":"sha256:amd64"},{"digest":"sha256:arm64"}]}`),
+		}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-prune", 0)
+	flagSet.Bool(flags.UntaggedFlag, true, "")
+	flagSet.Bool(flags.DryRunFlag, true, "")
+	flagSet.Parse([]string{repository})
+	out := &bytes.Buffer{}
+	err := pruneImages(cli.NewContext(nil, flagSet, nil), mockECR, out, nil)
+	assert.NoError(t, err, "Unexpected error pruning images")
+	assert.NotContains(t, out.String(), "sha256:amd64", "Expected image of tagged manifest list to be kept")
+	assert.NotContains(t, out.String(), "sha256:arm64", "Expected image of tagged manifest list to be kept")
+	assert.Contains(t, out.String(), "sha256:orphan")
+	assert.Contains(t, out.String(), "sha256:oldlist", "Expected untagged image index to be deleted")
+	assert.Contains(t, out.String(), "2 images would be deleted")
+}
+
+func TestPruneImages_ManifestListError(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	list := pruneTestImage("sha256:list", time.Hour, "v1")
+	list.ImageManifestMediaType = aws.String(registryclient.MediaTypeManifestList)
+	gomock.InOrder(
+		mockECR.EXPECT().GetImages(gomock.Any(), "", "", gomock.Any()).Do(
+			func(_ []*string, _, _ string, fn ecr.ProcessImageDetails) {
+				fn([]*ecrApi.ImageDetail{list, pruneTestImage("sha256:untagged", time.Hour)})
+			}).Return(nil),
+		mockECR.EXPECT().GetAuthorizationTokenByID("").Return(nil, errors.New("access denied")),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-prune", 0)
+	flagSet.Bool(flags.UntaggedFlag, true, "")
+	flagSet.Bool(flags.ForceFlag, true, "")
+	flagSet.Parse([]string{repository})
+	err := pruneImages(cli.NewContext(nil, flagSet, nil), mockECR, &bytes.Buffer{}, nil)
+	assert.Error(t, err, "Expected error when the images of a manifest list can not be found")
+}
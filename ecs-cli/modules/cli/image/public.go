@@ -0,0 +1,149 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"fmt"
+	"strings"
+
+	ecrpublicclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecrpublic"
+	dockerclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// isPublicImage returns whether the arguments of push or pull name an image
+// in ECR Public, such as public.ecr.aws/alias/repository:tag
+func isPublicImage(args cli.Args) bool {
+	return len(args) == 1 && strings.HasPrefix(args[0], ecrpublicclient.RegistryDomain+"/")
+}
+
+// pushPublicImage pushes an image to a repository in the ECR Public registry
+// of the account, creating the repository if it does not exist. Unlike
+// images pushed to private repositories, the local image must already be
+// named after the public repository.
+func pushPublicImage(c *cli.Context, dockerClient dockerclient.Client, publicClient ecrpublicclient.Client) error {
+	args := c.Args()
+	if len(args) != 1 {
+		return fmt.Errorf("ecs-cli push requires exactly 1 argument")
+	}
+	image := args[0]
+
+	registryURI, repository, tag, err := splitImageName(image, "[:]", PushImageFormat)
+	if err != nil {
+		return err
+	}
+	alias, ok := ecrpublicclient.ParseRegistry(registryURI)
+	if !ok {
+		return fmt.Errorf("Please specify the image name in the correct format [%s/ALIAS/%s]", ecrpublicclient.RegistryDomain, PushImageFormat)
+	}
+	for _, flag := range []string{flags.RegistryIdFlag, flags.RepoConfigFlag} {
+		if c.String(flag) != "" {
+			return fmt.Errorf("--%s can not be used with ECR Public repositories", flag)
+		}
+	}
+
+	platforms, err := parsePlatforms(c.String(flags.PlatformFlag))
+	if err != nil {
+		return err
+	}
+
+	// Pushing to an alias of another account's registry would only fail
+	// after the repository was created in this account
+	aliases, err := publicClient.RegistryAliases()
+	if err != nil {
+		return err
+	}
+	if !hasAlias(aliases, alias) {
+		return fmt.Errorf("%s is not an alias of the ECR Public registry of this account; its aliases are: %s", alias, strings.Join(aliases, ", "))
+	}
+
+	publicAuth, err := publicClient.GetAuthorizationToken()
+	if err != nil {
+		return err
+	}
+
+	var tags map[string]*string
+	if tagVal := c.String(flags.ResourceTagsFlag); tagVal != "" {
+		if tags, err = utils.GetTagsMap(tagVal); err != nil {
+			return err
+		}
+	}
+
+	// Check if repo exists, create if not present
+	if !publicClient.RepositoryExists(repository) {
+		if _, err = publicClient.CreateRepository(repository, tags); err != nil {
+			return err
+		}
+	} else if tags != nil {
+		logrus.WithField("repository", repository).Info("Tagging repository...")
+		if err = publicClient.TagRepository(repository, tags); err != nil {
+			return err
+		}
+	}
+
+	repositoryURI := registryURI + "/" + repository
+	authConfig := dockerAuth(publicAuth)
+
+	if len(platforms) > 0 {
+		return pushMultiPlatformImage(multiPlatformImage{
+			localName:     strings.TrimSuffix(image, ":"+tag),
+			repository:    alias + "/" + repository,
+			repositoryURI: repositoryURI,
+			tag:           tag,
+			platforms:     platforms,
+		}, publicAuth, authConfig, dockerClient)
+	}
+
+	return dockerClient.PushImage(repositoryURI, tag, publicAuth.Registry, authConfig)
+}
+
+// pullPublicImage pulls an image from ECR Public. Public images can be
+// pulled anonymously, but authenticated pulls have a higher rate limit.
+func pullPublicImage(c *cli.Context, dockerClient dockerclient.Client, publicClient ecrpublicclient.Client) error {
+	args := c.Args()
+	if len(args) != 1 {
+		return fmt.Errorf("ecs-cli pull requires exactly 1 argument")
+	}
+	image := args[0]
+
+	registryURI, repository, tag, err := splitImageName(image, "[:|@]", PullImageFormat)
+	if err != nil {
+		return err
+	}
+	if _, ok := ecrpublicclient.ParseRegistry(registryURI); !ok {
+		return fmt.Errorf("Please specify the image name in the correct format [%s/ALIAS/%s]", ecrpublicclient.RegistryDomain, PullImageFormat)
+	}
+
+	authConfig := docker.AuthConfiguration{}
+	if publicAuth, err := publicClient.GetAuthorizationToken(); err != nil {
+		logrus.Warnf("Pulling %s anonymously, since no ECR Public authorization token could be retrieved: %v", image, err)
+	} else {
+		authConfig = dockerAuth(publicAuth)
+	}
+
+	return dockerClient.PullImage(registryURI+"/"+repository, tag, authConfig)
+}
+
+func hasAlias(aliases []string, alias string) bool {
+	for _, a := range aliases {
+		if a == alias {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecrpublic/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const (
+	publicRegistry      = "public.ecr.aws"
+	publicRepositoryURI = "public.ecr.aws/my-alias/" + repository
+	publicImage         = publicRepositoryURI + ":" + tag
+)
+
+var publicAuth = &ecr.Auth{
+	Username:      "AWS",
+	Password:      "secret",
+	ProxyEndpoint: "https://" + publicRegistry,
+	Registry:      publicRegistry,
+}
+
+var publicDockerAuth = docker.AuthConfiguration{
+	Username:      "AWS",
+	Password:      "secret",
+	ServerAddress: "https://" + publicRegistry,
+}
+
+func setupPublicTestController(t *testing.T) (*mock_ecrpublic.MockClient, *mock_docker.MockClient, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	return mock_ecrpublic.NewMockClient(ctrl), mock_docker.NewMockClient(ctrl), ctrl
+}
+
+func publicImageContext(args ...string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-push", 0)
+	flagSet.String(flags.RegistryIdFlag, "", "")
+	flagSet.String(flags.RepoConfigFlag, "", "")
+	flagSet.String(flags.PlatformFlag, "", "")
+	flagSet.String(flags.ResourceTagsFlag, "", "")
+	flagSet.Parse(args)
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func TestIsPublicImage(t *testing.T) {
+	assert.True(t, isPublicImage(cli.Args{publicImage}))
+	assert.False(t, isPublicImage(cli.Args{image}))
+	assert.False(t, isPublicImage(cli.Args{registry + "/" + repository}))
+	assert.False(t, isPublicImage(cli.Args{}))
+}
+
+func TestPushPublicImage(t *testing.T) {
+	mockPublic, mockDocker, ctrl := setupPublicTestController(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockPublic.EXPECT().RegistryAliases().Return([]string{"default-alias", "my-alias"}, nil),
+		mockPublic.EXPECT().GetAuthorizationToken().Return(publicAuth, nil),
+		mockPublic.EXPECT().RepositoryExists(repository).Return(false),
+		mockPublic.EXPECT().CreateRepository(repository, map[string]*string{"team": aws.String("green")}).Return(nil, nil),
+		mockDocker.EXPECT().PushImage(publicRepositoryURI, tag, publicRegistry, publicDockerAuth).Return(nil),
+	)
+
+	context := publicImageContext("--"+flags.ResourceTagsFlag, "team=green", publicImage)
+	err := pushPublicImage(context, mockDocker, mockPublic)
+	assert.NoError(t, err, "Error pushing public image")
+}
+
+func TestPushPublicImage_ExistingRepositoryWithTags(t *testing.T) {
+	mockPublic, mockDocker, ctrl := setupPublicTestController(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockPublic.EXPECT().RegistryAliases().Return([]string{"my-alias"}, nil),
+		mockPublic.EXPECT().GetAuthorizationToken().Return(publicAuth, nil),
+		mockPublic.EXPECT().RepositoryExists(repository).Return(true),
+		mockPublic.EXPECT().TagRepository(repository, map[string]*string{"team": aws.String("green")}).Return(nil),
+		mockDocker.EXPECT().PushImage(publicRepositoryURI, tag, publicRegistry, publicDockerAuth).Return(nil),
+	)
+
+	context := publicImageContext("--"+flags.ResourceTagsFlag, "team=green", publicImage)
+	err := pushPublicImage(context, mockDocker, mockPublic)
+	assert.NoError(t, err, "Error pushing public image")
+}
+
+func TestPushPublicImage_AliasOfOtherAccount(t *testing.T) {
+	mockPublic, mockDocker, ctrl := setupPublicTestController(t)
+	defer ctrl.Finish()
+
+	mockPublic.EXPECT().RegistryAliases().Return([]string{"default-alias"}, nil)
+
+	err := pushPublicImage(publicImageContext(publicImage), mockDocker, mockPublic)
+	assert.Error(t, err, "Expected error pushing to an alias of another account")
+}
+
+func TestPushPublicImage_PrivateOnlyFlags(t *testing.T) {
+	for _, flagName := range []string{flags.RegistryIdFlag, flags.RepoConfigFlag} {
+		mockPublic, mockDocker, ctrl := setupPublicTestController(t)
+
+		err := pushPublicImage(publicImageContext("--"+flagName, "value", publicImage), mockDocker, mockPublic)
+		assert.Error(t, err, "Expected error for --%s", flagName)
+		ctrl.Finish()
+	}
+}
+
+func TestPullPublicImage(t *testing.T) {
+	mockPublic, mockDocker, ctrl := setupPublicTestController(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockPublic.EXPECT().GetAuthorizationToken().Return(publicAuth, nil),
+		mockDocker.EXPECT().PullImage(publicRepositoryURI, tag, publicDockerAuth).Return(nil),
+	)
+
+	err := pullPublicImage(publicImageContext(publicImage), mockDocker, mockPublic)
+	assert.NoError(t, err, "Error pulling public image")
+}
+
+func TestPullPublicImage_Anonymous(t *testing.T) {
+	mockPublic, mockDocker, ctrl := setupPublicTestController(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockPublic.EXPECT().GetAuthorizationToken().Return(nil, errors.New("no credentials")),
+		mockDocker.EXPECT().PullImage(publicRepositoryURI, tag, docker.AuthConfiguration{}).Return(nil),
+	)
+
+	err := pullPublicImage(publicImageContext(publicImage), mockDocker, mockPublic)
+	assert.NoError(t, err, "Error pulling public image anonymously")
+}
@@ -0,0 +1,226 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// ScanImageFormat is the argument of images scan
+const ScanImageFormat = "ECR_REPOSITORY[:TAG|@DIGEST]"
+
+// Attributes of scan findings
+const (
+	packageNameAttribute    = "package_name"
+	packageVersionAttribute = "package_version"
+)
+
+// ImageScan scans an image in ECR for vulnerabilities and prints the findings
+func ImageScan(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'images scan': ", err)
+	}
+
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'images scan': ", err)
+	}
+
+	ecrClient := getECRClient(c, commandConfig)
+
+	if err := scanImage(c, ecrClient, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'images scan': ", err)
+	}
+}
+
+// scanReport is the JSON output of images scan
+type scanReport struct {
+	Repository        string           `json:"repository"`
+	ImageDigest       string           `json:"imageDigest"`
+	Status            string           `json:"status"`
+	StatusDescription string           `json:"statusDescription,omitempty"`
+	CompletedAt       *time.Time       `json:"completedAt,omitempty"`
+	SeverityCounts    map[string]int64 `json:"severityCounts"`
+	Findings          []scanFinding    `json:"findings"`
+}
+
+type scanFinding struct {
+	Name           string `json:"name"`
+	Severity       string `json:"severity"`
+	PackageName    string `json:"packageName,omitempty"`
+	PackageVersion string `json:"packageVersion,omitempty"`
+	URI            string `json:"uri,omitempty"`
+	Description    string `json:"description,omitempty"`
+}
+
+func scanImage(c *cli.Context, ecrClient ecrclient.Client, out io.Writer) error {
+	args := c.Args()
+	if len(args) != 1 {
+		return fmt.Errorf("ecs-cli images scan requires exactly 1 argument")
+	}
+	image := args[0]
+
+	maxSeverity := ""
+	if value := c.String(flags.MaxSeverityFlag); value != "" {
+		var err error
+		if maxSeverity, err = ecrclient.ParseSeverity(value); err != nil {
+			return err
+		}
+	}
+
+	registryURI, repository, reference, err := splitImageName(image, "[:|@]", ScanImageFormat)
+	if err != nil {
+		return err
+	}
+	registryID := c.String(flags.RegistryIdFlag)
+	if registryURI != "" {
+		if registryID, err = getRegistryIDFromURI(registryURI); err != nil {
+			return err
+		}
+	}
+
+	imageID := &ecr.ImageIdentifier{}
+	switch {
+	case strings.Contains(image, "@"):
+		imageID.SetImageDigest(reference)
+	case reference != "":
+		imageID.SetImageTag(reference)
+	default:
+		imageID.SetImageTag("latest")
+	}
+
+	if err = ecrClient.ScanImage(registryID, repository, imageID); err != nil {
+		return err
+	}
+	result, err := ecrClient.GetImageScanFindings(registryID, repository, imageID)
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		return fmt.Errorf("no scan was found for image %s", image)
+	}
+
+	report := newScanReport(repository, result)
+	if c.Bool(flags.JSON) {
+		output, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(output))
+	} else {
+		printScanReport(out, report)
+	}
+
+	if maxSeverity != "" {
+		if count := result.CountAbove(maxSeverity); count > 0 {
+			return fmt.Errorf("image %s has %d findings more severe than %s", image, count, maxSeverity)
+		}
+	}
+	return nil
+}
+
+// newScanReport returns the report of a scan, with the most severe findings first
+func newScanReport(repository string, result *ecrclient.ImageScanResult) scanReport {
+	report := scanReport{
+		Repository:        repository,
+		ImageDigest:       result.ImageDigest,
+		Status:            result.Status,
+		StatusDescription: result.StatusDescription,
+		CompletedAt:       result.CompletedAt,
+		SeverityCounts:    result.SeverityCounts,
+		Findings:          []scanFinding{},
+	}
+	for _, finding := range result.Findings {
+		report.Findings = append(report.Findings, scanFinding{
+			Name:           aws.StringValue(finding.Name),
+			Severity:       aws.StringValue(finding.Severity),
+			PackageName:    findingAttribute(finding, packageNameAttribute),
+			PackageVersion: findingAttribute(finding, packageVersionAttribute),
+			URI:            aws.StringValue(finding.Uri),
+			Description:    aws.StringValue(finding.Description),
+		})
+	}
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		left, right := report.Findings[i], report.Findings[j]
+		if rankLeft, rankRight := ecrclient.SeverityRank(left.Severity), ecrclient.SeverityRank(right.Severity); rankLeft != rankRight {
+			return rankLeft > rankRight
+		}
+		return left.Name < right.Name
+	})
+	return report
+}
+
+func printScanReport(out io.Writer, report scanReport) {
+	w := tabwriter.NewWriter(out, MinWidth, TabWidth, Padding, PaddingChar, NumOfFlags)
+	fmt.Fprintf(w, "Repository:\t%s\n", report.Repository)
+	fmt.Fprintf(w, "Image digest:\t%s\n", report.ImageDigest)
+	fmt.Fprintf(w, "Scan status:\t%s\n", report.Status)
+	if report.StatusDescription != "" {
+		fmt.Fprintf(w, "Scan status description:\t%s\n", report.StatusDescription)
+	}
+	if report.CompletedAt != nil {
+		fmt.Fprintf(w, "Scan completed:\t%s\n", report.CompletedAt.UTC().Format(time.RFC3339))
+	}
+	w.Flush()
+
+	fmt.Fprintln(out)
+	counts := []string{}
+	for _, severity := range ecrclient.Severities {
+		counts = append(counts, fmt.Sprintf("%d", report.SeverityCounts[severity]))
+	}
+	fmt.Fprintln(w, strings.Join(ecrclient.Severities, "\t")+"\t")
+	fmt.Fprintln(w, strings.Join(counts, "\t")+"\t")
+	w.Flush()
+
+	if len(report.Findings) == 0 {
+		return
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintln(w, "NAME\tSEVERITY\tPACKAGE\tVERSION\tURI\t")
+	for _, finding := range report.Findings {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n",
+			finding.Name,
+			finding.Severity,
+			finding.PackageName,
+			finding.PackageVersion,
+			finding.URI,
+		)
+	}
+	w.Flush()
+}
+
+func findingAttribute(finding *ecr.ImageScanFinding, key string) string {
+	for _, attribute := range finding.Attributes {
+		if aws.StringValue(attribute.Key) == key {
+			return aws.StringValue(attribute.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	ecrApi "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const scanDigest = "sha256:0123"
+
+func scanResult() *ecr.ImageScanResult {
+	return &ecr.ImageScanResult{
+		ImageDigest: scanDigest,
+		Status:      ecrApi.ScanStatusComplete,
+		SeverityCounts: map[string]int64{
+			ecrApi.FindingSeverityHigh: 1,
+			ecrApi.FindingSeverityLow:  1,
+		},
+		Findings: []*ecrApi.ImageScanFinding{
+			{
+				Name:     aws.String("CVE-2020-0002"),
+				Severity: aws.String(ecrApi.FindingSeverityLow),
+				Uri:      aws.String("https://cve.example/CVE-2020-0002"),
+			},
+			{
+				Name:     aws.String("CVE-2020-0001"),
+				Severity: aws.String(ecrApi.FindingSeverityHigh),
+				Attributes: []*ecrApi.Attribute{
+					{Key: aws.String(packageNameAttribute), Value: aws.String("openssl")},
+					{Key: aws.String(packageVersionAttribute), Value: aws.String("1.1.1")},
+				},
+			},
+		},
+	}
+}
+
+func TestScanImage(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	imageID := &ecrApi.ImageIdentifier{ImageTag: aws.String(tag)}
+	gomock.InOrder(
+		mockECR.EXPECT().ScanImage("", repository, imageID).Return(nil),
+		mockECR.EXPECT().GetImageScanFindings("", repository, imageID).Return(scanResult(), nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-scan", 0)
+	flagSet.Parse([]string{image})
+	out := &bytes.Buffer{}
+	err := scanImage(cli.NewContext(nil, flagSet, nil), mockECR, out)
+	assert.NoError(t, err, "Unexpected error scanning image")
+
+	output := out.String()
+	assert.Regexp(t, `Image digest:\s+`+scanDigest, output)
+	assert.Regexp(t, `CRITICAL\s+HIGH\s+MEDIUM\s+LOW\s+INFORMATIONAL\s+UNDEFINED`, output)
+	assert.Regexp(t, `0\s+1\s+0\s+1\s+0\s+0`, output)
+	high := strings.Index(output, "CVE-2020-0001")
+	low := strings.Index(output, "CVE-2020-0002")
+	assert.True(t, high > 0 && high < low, "Expected the most severe findings first")
+	assert.Regexp(t, `CVE-2020-0001\s+HIGH\s+openssl\s+1.1.1`, output)
+}
+
+func TestScanImage_DigestAndRegistryURI(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	imageID := &ecrApi.ImageIdentifier{ImageDigest: aws.String(scanDigest)}
+	gomock.InOrder(
+		mockECR.EXPECT().ScanImage(registryID, repository, imageID).Return(nil),
+		mockECR.EXPECT().GetImageScanFindings(registryID, repository, imageID).Return(scanResult(), nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-scan", 0)
+	flagSet.Bool(flags.JSON, true, "")
+	flagSet.Parse([]string{registryID + ".dkr.ecr.us-west-2.amazonaws.com/" + repository + "@" + scanDigest})
+	out := &bytes.Buffer{}
+	err := scanImage(cli.NewContext(nil, flagSet, nil), mockECR, out)
+	assert.NoError(t, err, "Unexpected error scanning image")
+
+	report := scanReport{}
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &report), "Expected JSON output")
+	assert.Equal(t, repository, report.Repository)
+	assert.Equal(t, int64(1), report.SeverityCounts[ecrApi.FindingSeverityHigh])
+	if assert.Len(t, report.Findings, 2) {
+		assert.Equal(t, "CVE-2020-0001", report.Findings[0].Name)
+		assert.Equal(t, "openssl", report.Findings[0].PackageName)
+	}
+}
+
+func TestScanImage_MaxSeverity(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	gomock.InOrder(
+		mockECR.EXPECT().ScanImage("", repository, gomock.Any()).Return(nil),
+		mockECR.EXPECT().GetImageScanFindings("", repository, gomock.Any()).Return(scanResult(), nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-scan", 0)
+	flagSet.String(flags.MaxSeverityFlag, "medium", "")
+	flagSet.Parse([]string{image})
+	err := scanImage(cli.NewContext(nil, flagSet, nil), mockECR, &bytes.Buffer{})
+	assert.Error(t, err, "Expected error for findings above the maximum severity")
+}
+
+func TestScanImage_InvalidMaxSeverity(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images-scan", 0)
+	flagSet.String(flags.MaxSeverityFlag, "severe", "")
+	flagSet.Parse([]string{image})
+	err := scanImage(cli.NewContext(nil, flagSet, nil), mockECR, &bytes.Buffer{})
+	assert.Error(t, err, "Expected error for an invalid severity")
+}
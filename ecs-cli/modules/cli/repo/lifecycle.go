@@ -0,0 +1,314 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package repo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/repoconfig"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// Values of the lifecycle policy document, see
+// https://docs.aws.amazon.com/AmazonECR/latest/userguide/LifecyclePolicies.html
+const (
+	tagStatusTagged   = "tagged"
+	tagStatusUntagged = "untagged"
+	tagStatusAny      = "any"
+
+	countTypeImageCount   = "imageCountMoreThan"
+	countTypeSincePushed  = "sinceImagePushed"
+	countUnitDays         = "days"
+	lifecycleActionExpire = "expire"
+
+	// defaultUntaggedDays is how long untagged images are kept if no age is given
+	defaultUntaggedDays = 1
+)
+
+type lifecyclePolicy struct {
+	Rules []lifecycleRule `json:"rules"`
+}
+
+type lifecycleRule struct {
+	RulePriority int                `json:"rulePriority"`
+	Description  string             `json:"description,omitempty"`
+	Selection    lifecycleSelection `json:"selection"`
+	Action       lifecycleAction    `json:"action"`
+}
+
+type lifecycleSelection struct {
+	TagStatus     string   `json:"tagStatus"`
+	TagPrefixList []string `json:"tagPrefixList,omitempty"`
+	CountType     string   `json:"countType"`
+	CountUnit     string   `json:"countUnit,omitempty"`
+	CountNumber   int      `json:"countNumber"`
+}
+
+type lifecycleAction struct {
+	Type string `json:"type"`
+}
+
+// lifecycleRules are the common rules a lifecycle policy can be generated from
+type lifecycleRules struct {
+	untagged    bool
+	olderThan   int // days
+	keepLast    int
+	tagPrefixes []string
+}
+
+func newLifecycleRules(c *cli.Context) lifecycleRules {
+	rules := lifecycleRules{
+		untagged:  c.Bool(flags.UntaggedFlag),
+		olderThan: c.Int(flags.OlderThanFlag),
+		keepLast:  c.Int(flags.KeepLastFlag),
+	}
+	for _, prefix := range strings.Split(c.String(flags.TagPrefixFlag), ",") {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			rules.tagPrefixes = append(rules.tagPrefixes, prefix)
+		}
+	}
+	return rules
+}
+
+func (r lifecycleRules) empty() bool {
+	return !r.untagged && r.olderThan == 0 && r.keepLast == 0 && len(r.tagPrefixes) == 0
+}
+
+// policy generates a lifecycle policy from the rules. Untagged images older
+// than olderThan days (or a day, by default) expire if untagged is set. Of
+// the images with a tag that starts with each tag prefix, all but the
+// keepLast most recently pushed expire. Without tag prefixes, keepLast
+// applies to all images, and if untagged is not set, all images older than
+// olderThan days expire. An age can not be combined with tag prefixes: a
+// rule can not expire images which match the tags of an earlier rule, so the
+// age would either have no effect or apply to images without the prefixes.
+func (r lifecycleRules) policy() (string, error) {
+	if r.olderThan < 0 || r.keepLast < 0 {
+		return "", fmt.Errorf("--%s and --%s can not be negative", flags.OlderThanFlag, flags.KeepLastFlag)
+	}
+	if len(r.tagPrefixes) > 0 && r.keepLast == 0 {
+		return "", fmt.Errorf("--%s must be specified with --%s", flags.KeepLastFlag, flags.TagPrefixFlag)
+	}
+	if len(r.tagPrefixes) > 0 && r.olderThan > 0 && !r.untagged {
+		return "", fmt.Errorf("--%s can not be used with --%s in a lifecycle policy, since ECR can not expire the images of a tag prefix by both count and age; use 'images prune' to delete them instead", flags.OlderThanFlag, flags.TagPrefixFlag)
+	}
+	if len(r.tagPrefixes) == 0 && r.keepLast > 0 && r.olderThan > 0 && !r.untagged {
+		return "", fmt.Errorf("--%s and --%s both apply to all images; specify --%s or --%s to narrow one of them", flags.KeepLastFlag, flags.OlderThanFlag, flags.TagPrefixFlag, flags.UntaggedFlag)
+	}
+
+	policy := lifecyclePolicy{}
+	addRule := func(description string, selection lifecycleSelection) {
+		policy.Rules = append(policy.Rules, lifecycleRule{
+			RulePriority: len(policy.Rules) + 1,
+			Description:  description,
+			Selection:    selection,
+			Action:       lifecycleAction{Type: lifecycleActionExpire},
+		})
+	}
+
+	if r.untagged {
+		days := r.olderThan
+		if days == 0 {
+			days = defaultUntaggedDays
+		}
+		addRule(fmt.Sprintf("Expire untagged images older than %d days", days), lifecycleSelection{
+			TagStatus:   tagStatusUntagged,
+			CountType:   countTypeSincePushed,
+			CountUnit:   countUnitDays,
+			CountNumber: days,
+		})
+	}
+	for _, prefix := range r.tagPrefixes {
+		addRule(fmt.Sprintf("Keep the last %d images tagged %s*", r.keepLast, prefix), lifecycleSelection{
+			TagStatus:     tagStatusTagged,
+			TagPrefixList: []string{prefix},
+			CountType:     countTypeImageCount,
+			CountNumber:   r.keepLast,
+		})
+	}
+	// A rule which selects all images must be evaluated last
+	if len(r.tagPrefixes) == 0 && r.keepLast > 0 {
+		addRule(fmt.Sprintf("Keep the last %d images", r.keepLast), lifecycleSelection{
+			TagStatus:   tagStatusAny,
+			CountType:   countTypeImageCount,
+			CountNumber: r.keepLast,
+		})
+	}
+	if r.olderThan > 0 && !r.untagged {
+		addRule(fmt.Sprintf("Expire images older than %d days", r.olderThan), lifecycleSelection{
+			TagStatus:   tagStatusAny,
+			CountType:   countTypeSincePushed,
+			CountUnit:   countUnitDays,
+			CountNumber: r.olderThan,
+		})
+	}
+
+	if len(policy.Rules) == 0 {
+		return "", fmt.Errorf("at least one of --%s, --%s or --%s must be specified", flags.UntaggedFlag, flags.OlderThanFlag, flags.KeepLastFlag)
+	}
+	text, err := json.MarshalIndent(policy, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(text), nil
+}
+
+// LifecycleGet prints the lifecycle policy of an ECR repository
+func LifecycleGet(c *cli.Context) {
+	ecrClient := newECRClient(c)
+	if err := getLifecyclePolicy(c, ecrClient, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'lifecycle get': ", err)
+	}
+}
+
+// LifecycleSet sets the lifecycle policy of an ECR repository
+func LifecycleSet(c *cli.Context) {
+	ecrClient := newECRClient(c)
+	if err := setLifecyclePolicy(c, ecrClient); err != nil {
+		logrus.Fatal("Error executing 'lifecycle set': ", err)
+	}
+}
+
+// LifecyclePreview lists the images a lifecycle policy would expire
+func LifecyclePreview(c *cli.Context) {
+	ecrClient := newECRClient(c)
+	if err := previewLifecyclePolicy(c, ecrClient, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'lifecycle preview': ", err)
+	}
+}
+
+// LifecycleGenerate prints a lifecycle policy generated from the rules given as flags
+func LifecycleGenerate(c *cli.Context) {
+	if err := generateLifecyclePolicy(c, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'lifecycle generate': ", err)
+	}
+}
+
+// lifecyclePolicyText returns the lifecycle policy from the policy file, or
+// the one generated from the rules given as flags. It returns an empty string
+// if neither is given.
+func lifecyclePolicyText(c *cli.Context) (string, error) {
+	rules := newLifecycleRules(c)
+	policyFile := c.String(flags.PolicyFileFlag)
+	if policyFile != "" {
+		if !rules.empty() {
+			return "", fmt.Errorf("--%s can not be used with the rules of a generated policy", flags.PolicyFileFlag)
+		}
+		return repoconfig.ReadPolicy(policyFile)
+	}
+	if rules.empty() {
+		return "", nil
+	}
+	return rules.policy()
+}
+
+func getLifecyclePolicy(c *cli.Context, ecrClient ecrclient.Client, out io.Writer) error {
+	repositoryName, err := repositoryArg(c)
+	if err != nil {
+		return err
+	}
+
+	policy, err := ecrClient.GetLifecyclePolicy(c.String(flags.RegistryIdFlag), repositoryName)
+	if err != nil {
+		return err
+	}
+	if policy == "" {
+		logrus.WithField("repository", repositoryName).Info("Repository has no lifecycle policy")
+		return nil
+	}
+
+	indented := bytes.Buffer{}
+	if err = json.Indent(&indented, []byte(policy), "", "  "); err != nil {
+		fmt.Fprintln(out, policy)
+		return nil
+	}
+	fmt.Fprintln(out, indented.String())
+	return nil
+}
+
+func setLifecyclePolicy(c *cli.Context, ecrClient ecrclient.Client) error {
+	repositoryName, err := repositoryArg(c)
+	if err != nil {
+		return err
+	}
+
+	policy, err := lifecyclePolicyText(c)
+	if err != nil {
+		return err
+	}
+	if policy == "" {
+		return fmt.Errorf("either --%s or the rules of a policy to generate must be specified", flags.PolicyFileFlag)
+	}
+	logrus.Debugf("Using lifecycle policy: %s", policy)
+
+	if err = ecrClient.PutLifecyclePolicy(c.String(flags.RegistryIdFlag), repositoryName, policy); err != nil {
+		return err
+	}
+	logrus.WithField("repository", repositoryName).Info("Lifecycle policy set")
+	return nil
+}
+
+func previewLifecyclePolicy(c *cli.Context, ecrClient ecrclient.Client, out io.Writer) error {
+	repositoryName, err := repositoryArg(c)
+	if err != nil {
+		return err
+	}
+
+	policy, err := lifecyclePolicyText(c)
+	if err != nil {
+		return err
+	}
+
+	results, err := ecrClient.PreviewLifecyclePolicy(c.String(flags.RegistryIdFlag), repositoryName, policy)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, minWidth, tabWidth, padding, paddingChar, numOfFlags)
+	fmt.Fprintln(w, "IMAGE DIGEST\tTAGS\tPUSHED AT\tRULE PRIORITY\t")
+	for _, result := range results {
+		tags := "<none>"
+		if len(result.ImageTags) > 0 {
+			tags = strings.Join(aws.StringValueSlice(result.ImageTags), ",")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t\n",
+			aws.StringValue(result.ImageDigest),
+			tags,
+			formatTime(result.ImagePushedAt),
+			aws.Int64Value(result.AppliedRulePriority),
+		)
+	}
+	w.Flush()
+	fmt.Fprintf(out, "\n%d images would expire\n", len(results))
+	return nil
+}
+
+func generateLifecyclePolicy(c *cli.Context, out io.Writer) error {
+	policy, err := newLifecycleRules(c).policy()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, policy)
+	return nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package repo
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func TestLifecycleRulesPolicy(t *testing.T) {
+	text, err := lifecycleRules{
+		untagged:    true,
+		olderThan:   14,
+		keepLast:    10,
+		tagPrefixes: []string{"ci-", "pr-"},
+	}.policy()
+	assert.NoError(t, err, "Unexpected error generating lifecycle policy")
+
+	policy := lifecyclePolicy{}
+	assert.NoError(t, json.Unmarshal([]byte(text), &policy))
+	expected := lifecyclePolicy{
+		Rules: []lifecycleRule{
+			{
+				RulePriority: 1,
+				Description:  "Expire untagged images older than 14 days",
+				Selection:    lifecycleSelection{TagStatus: "untagged", CountType: "sinceImagePushed", CountUnit: "days", CountNumber: 14},
+				Action:       lifecycleAction{Type: "expire"},
+			},
+			{
+				RulePriority: 2,
+				Description:  "Keep the last 10 images tagged ci-*",
+				Selection:    lifecycleSelection{TagStatus: "tagged", TagPrefixList: []string{"ci-"}, CountType: "imageCountMoreThan", CountNumber: 10},
+				Action:       lifecycleAction{Type: "expire"},
+			},
+			{
+				RulePriority: 3,
+				Description:  "Keep the last 10 images tagged pr-*",
+				Selection:    lifecycleSelection{TagStatus: "tagged", TagPrefixList: []string{"pr-"}, CountType: "imageCountMoreThan", CountNumber: 10},
+				Action:       lifecycleAction{Type: "expire"},
+			},
+		},
+	}
+	assert.Equal(t, expected, policy)
+}
+
+func TestLifecycleRulesPolicy_AnyRulesLast(t *testing.T) {
+	text, err := lifecycleRules{untagged: true, olderThan: 90, keepLast: 5}.policy()
+	assert.NoError(t, err, "Unexpected error generating lifecycle policy")
+
+	policy := lifecyclePolicy{}
+	assert.NoError(t, json.Unmarshal([]byte(text), &policy))
+	if assert.Len(t, policy.Rules, 2) {
+		assert.Equal(t, "untagged", policy.Rules[0].Selection.TagStatus)
+		assert.Equal(t, "any", policy.Rules[1].Selection.TagStatus)
+		assert.Equal(t, 2, policy.Rules[1].RulePriority)
+		assert.Equal(t, 5, policy.Rules[1].Selection.CountNumber)
+	}
+}
+
+func TestLifecycleRulesPolicy_AgeWithTagPrefix(t *testing.T) {
+	// An age rule for all images would expire the images without the prefix
+	_, err := lifecycleRules{olderThan: 14, keepLast: 10, tagPrefixes: []string{"ci-"}}.policy()
+	if assert.Error(t, err, "Expected error generating lifecycle policy with an age and a tag prefix") {
+		assert.Contains(t, err.Error(), "--older-than can not be used with --tag-prefix")
+	}
+}
+
+func TestLifecycleRulesPolicy_UntaggedDefault(t *testing.T) {
+	text, err := lifecycleRules{untagged: true}.policy()
+	assert.NoError(t, err, "Unexpected error generating lifecycle policy")
+
+	policy := lifecyclePolicy{}
+	assert.NoError(t, json.Unmarshal([]byte(text), &policy))
+	if assert.Len(t, policy.Rules, 1) {
+		assert.Equal(t, defaultUntaggedDays, policy.Rules[0].Selection.CountNumber)
+	}
+}
+
+func TestLifecycleRulesPolicy_Invalid(t *testing.T) {
+	testCases := map[string]lifecycleRules{
+		"no rules":                 {},
+		"tag prefix without count": {tagPrefixes: []string{"ci-"}},
+		"two rules for any images": {keepLast: 5, olderThan: 30},
+		"age with tag prefix":      {keepLast: 5, olderThan: 30, tagPrefixes: []string{"release-"}},
+		"negative count":           {keepLast: -1},
+	}
+	for name, rules := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := rules.policy()
+			assert.Error(t, err, "Expected error generating lifecycle policy")
+		})
+	}
+}
+
+func TestSetLifecyclePolicy_PolicyFile(t *testing.T) {
+	policyFile, err := ioutil.TempFile("", "lifecycle")
+	assert.NoError(t, err, "Unexpected error creating temp file")
+	defer os.Remove(policyFile.Name())
+	_, err = policyFile.WriteString(`{"rules":[]}`)
+	assert.NoError(t, err, "Unexpected error writing temp file")
+	policyFile.Close()
+
+	mockECR := setupTestController(t)
+	mockECR.EXPECT().PutLifecyclePolicy("", repositoryName, `{"rules":[]}`).Return(nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-repo-lifecycle-set", 0)
+	flagSet.String(flags.PolicyFileFlag, policyFile.Name(), "")
+	flagSet.Parse([]string{repositoryName})
+	err = setLifecyclePolicy(cli.NewContext(nil, flagSet, nil), mockECR)
+	assert.NoError(t, err, "Unexpected error setting lifecycle policy")
+}
+
+func TestSetLifecyclePolicy_PolicyFileAndRules(t *testing.T) {
+	mockECR := setupTestController(t)
+
+	flagSet := flag.NewFlagSet("ecs-cli-repo-lifecycle-set", 0)
+	flagSet.String(flags.PolicyFileFlag, "policy.json", "")
+	flagSet.Bool(flags.UntaggedFlag, true, "")
+	flagSet.Parse([]string{repositoryName})
+	err := setLifecyclePolicy(cli.NewContext(nil, flagSet, nil), mockECR)
+	assert.Error(t, err, "Expected error when a policy file and rules are given")
+}
+
+func TestSetLifecyclePolicy_NoPolicy(t *testing.T) {
+	mockECR := setupTestController(t)
+
+	flagSet := flag.NewFlagSet("ecs-cli-repo-lifecycle-set", 0)
+	flagSet.Parse([]string{repositoryName})
+	err := setLifecyclePolicy(cli.NewContext(nil, flagSet, nil), mockECR)
+	assert.Error(t, err, "Expected error when no policy is given")
+}
+
+func TestGetLifecyclePolicy(t *testing.T) {
+	mockECR := setupTestController(t)
+	mockECR.EXPECT().GetLifecyclePolicy("", repositoryName).Return(`{"rules":[]}`, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-repo-lifecycle-get", 0)
+	flagSet.Parse([]string{repositoryName})
+	out := &bytes.Buffer{}
+	err := getLifecyclePolicy(cli.NewContext(nil, flagSet, nil), mockECR, out)
+	assert.NoError(t, err, "Unexpected error getting lifecycle policy")
+	assert.Equal(t, "{\n  \"rules\": []\n}\n", out.String())
+}
+
+func TestPreviewLifecyclePolicy_GeneratedRules(t *testing.T) {
+	pushedAt := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+	expectedPolicy, err := lifecycleRules{untagged: true, olderThan: 7}.policy()
+	assert.NoError(t, err, "Unexpected error generating lifecycle policy")
+
+	mockECR := setupTestController(t)
+	mockECR.EXPECT().PreviewLifecyclePolicy("", repositoryName, expectedPolicy).Return([]*ecr.LifecyclePolicyPreviewResult{
+		{
+			ImageDigest:         aws.String("sha256:abc"),
+			ImagePushedAt:       &pushedAt,
+			AppliedRulePriority: aws.Int64(1),
+		},
+	}, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-repo-lifecycle-preview", 0)
+	flagSet.Bool(flags.UntaggedFlag, true, "")
+	flagSet.Int(flags.OlderThanFlag, 7, "")
+	flagSet.Parse([]string{repositoryName})
+	out := &bytes.Buffer{}
+	err = previewLifecyclePolicy(cli.NewContext(nil, flagSet, nil), mockECR, out)
+	assert.NoError(t, err, "Unexpected error previewing lifecycle policy")
+	assert.Regexp(t, `sha256:abc\s+<none>\s+2020-07-01T12:00:00Z\s+1`, out.String())
+	assert.Contains(t, out.String(), "1 images would expire")
+}
+
+func TestPreviewLifecyclePolicy_CurrentPolicy(t *testing.T) {
+	mockECR := setupTestController(t)
+	mockECR.EXPECT().PreviewLifecyclePolicy("", repositoryName, "").Return(nil, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-repo-lifecycle-preview", 0)
+	flagSet.Parse([]string{repositoryName})
+	err := previewLifecyclePolicy(cli.NewContext(nil, flagSet, nil), mockECR, &bytes.Buffer{})
+	assert.NoError(t, err, "Unexpected error previewing lifecycle policy")
+}
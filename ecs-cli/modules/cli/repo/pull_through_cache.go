@@ -0,0 +1,164 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package repo
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+const (
+	// RepoPrefixFormat is the argument of the pull through cache commands
+	RepoPrefixFormat = "ECR_REPOSITORY_PREFIX"
+
+	dockerHubRegistry = "registry-1.docker.io"
+
+	// credentialSecretPrefix is the prefix which the names of the Secrets
+	// Manager secrets with upstream registry credentials must have
+	credentialSecretPrefix = "ecr-pullthroughcache/"
+)
+
+// upstreamRegistries maps the names of the well-known upstream registries,
+// which can be given instead of their URLs, to their URLs
+var upstreamRegistries = map[string]string{
+	ecr.UpstreamRegistryDockerHub:               dockerHubRegistry,
+	"docker.io":                                 dockerHubRegistry,
+	ecr.UpstreamRegistryEcrPublic:               "public.ecr.aws",
+	ecr.UpstreamRegistryQuay:                    "quay.io",
+	ecr.UpstreamRegistryGithubContainerRegistry: "ghcr.io",
+}
+
+// PullThroughCacheCreate creates a pull through cache rule
+func PullThroughCacheCreate(c *cli.Context) {
+	ecrClient := newECRClient(c)
+	if err := createPullThroughCacheRule(c, ecrClient, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'create': ", err)
+	}
+}
+
+// PullThroughCacheList lists the pull through cache rules of a registry
+func PullThroughCacheList(c *cli.Context) {
+	ecrClient := newECRClient(c)
+	if err := listPullThroughCacheRules(c, ecrClient, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'ls': ", err)
+	}
+}
+
+// PullThroughCacheDelete deletes a pull through cache rule
+func PullThroughCacheDelete(c *cli.Context) {
+	ecrClient := newECRClient(c)
+	if err := deletePullThroughCacheRule(c, ecrClient); err != nil {
+		logrus.Fatal("Error executing 'delete': ", err)
+	}
+}
+
+func repositoryPrefixArg(c *cli.Context) (string, error) {
+	if len(c.Args()) != 1 {
+		return "", fmt.Errorf("Exactly 1 repository prefix is required. Found: %d", len(c.Args()))
+	}
+	return c.Args()[0], nil
+}
+
+// upstreamRegistryURL returns the URL of an upstream registry given by name
+// or by URL
+func upstreamRegistryURL(upstream string) string {
+	upstream = strings.TrimSuffix(strings.TrimPrefix(upstream, "https://"), "/")
+	if url, ok := upstreamRegistries[strings.ToLower(upstream)]; ok {
+		return url
+	}
+	return upstream
+}
+
+// requiresCredentials returns whether ECR can only pull from an upstream
+// registry with credentials from Secrets Manager
+func requiresCredentials(upstreamURL string) bool {
+	return upstreamURL == dockerHubRegistry ||
+		upstreamURL == upstreamRegistries[ecr.UpstreamRegistryGithubContainerRegistry] ||
+		strings.HasSuffix(upstreamURL, ".azurecr.io")
+}
+
+func createPullThroughCacheRule(c *cli.Context, ecrClient ecrclient.Client, out io.Writer) error {
+	prefix, err := repositoryPrefixArg(c)
+	if err != nil {
+		return err
+	}
+	upstream := c.String(flags.UpstreamRegistryFlag)
+	if upstream == "" {
+		return fmt.Errorf("--%s is required", flags.UpstreamRegistryFlag)
+	}
+
+	rule := ecrclient.PullThroughCacheRule{
+		RepositoryPrefix:    prefix,
+		UpstreamRegistryURL: upstreamRegistryURL(upstream),
+		CredentialARN:       c.String(flags.CredentialARNFlag),
+	}
+	if rule.CredentialARN == "" && requiresCredentials(rule.UpstreamRegistryURL) {
+		return fmt.Errorf("%s requires authentication; specify --%s with the ARN of a Secrets Manager secret whose name starts with %s, which contains the username and accessToken to pull with",
+			rule.UpstreamRegistryURL, flags.CredentialARNFlag, credentialSecretPrefix)
+	}
+
+	if _, err = ecrClient.CreatePullThroughCacheRule(c.String(flags.RegistryIdFlag), rule); err != nil {
+		return err
+	}
+
+	example := "<upstream repository>"
+	if rule.UpstreamRegistryURL == dockerHubRegistry {
+		// Docker Hub official images are in the library namespace
+		example = "library/nginx"
+	}
+	fmt.Fprintf(out, "Images of %s can now be pulled from the repositories named %s/%s\n", rule.UpstreamRegistryURL, prefix, example)
+	return nil
+}
+
+func listPullThroughCacheRules(c *cli.Context, ecrClient ecrclient.Client, out io.Writer) error {
+	rules, err := ecrClient.ListPullThroughCacheRules(c.String(flags.RegistryIdFlag))
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, minWidth, tabWidth, padding, paddingChar, numOfFlags)
+	fmt.Fprintln(w, "REPOSITORY PREFIX\tUPSTREAM REGISTRY\tCREDENTIAL\tCREATED\t")
+	for _, rule := range rules {
+		credential := aws.StringValue(rule.CredentialArn)
+		if credential == "" {
+			credential = "<none>"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n",
+			aws.StringValue(rule.EcrRepositoryPrefix),
+			aws.StringValue(rule.UpstreamRegistryUrl),
+			credential,
+			formatTime(rule.CreatedAt),
+		)
+	}
+	w.Flush()
+	return nil
+}
+
+func deletePullThroughCacheRule(c *cli.Context, ecrClient ecrclient.Client) error {
+	prefix, err := repositoryPrefixArg(c)
+	if err != nil {
+		return err
+	}
+	return ecrClient.DeletePullThroughCacheRule(c.String(flags.RegistryIdFlag), prefix)
+}
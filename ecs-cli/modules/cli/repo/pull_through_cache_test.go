@@ -0,0 +1,120 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package repo
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const credentialARN = "arn:aws:secretsmanager:us-west-2:123456789012:secret:ecr-pullthroughcache/docker-hub-AbCdEf"
+
+func pullThroughCacheContext(args ...string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-repo-pull-through-cache", 0)
+	flagSet.String(flags.RegistryIdFlag, "", "")
+	flagSet.String(flags.UpstreamRegistryFlag, "", "")
+	flagSet.String(flags.CredentialARNFlag, "", "")
+	flagSet.Parse(args)
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func TestUpstreamRegistryURL(t *testing.T) {
+	assert.Equal(t, "registry-1.docker.io", upstreamRegistryURL("docker-hub"))
+	assert.Equal(t, "registry-1.docker.io", upstreamRegistryURL("docker.io"))
+	assert.Equal(t, "public.ecr.aws", upstreamRegistryURL("ecr-public"))
+	assert.Equal(t, "ghcr.io", upstreamRegistryURL("GitHub-Container-Registry"))
+	assert.Equal(t, "myregistry.azurecr.io", upstreamRegistryURL("https://myregistry.azurecr.io/"))
+}
+
+func TestCreatePullThroughCacheRule_DockerHub(t *testing.T) {
+	mockECR := setupTestController(t)
+	mockECR.EXPECT().CreatePullThroughCacheRule("", ecrclient.PullThroughCacheRule{
+		RepositoryPrefix:    "docker-hub",
+		UpstreamRegistryURL: "registry-1.docker.io",
+		CredentialARN:       credentialARN,
+	}).Return(&ecr.CreatePullThroughCacheRuleOutput{}, nil)
+
+	out := &bytes.Buffer{}
+	context := pullThroughCacheContext("--"+flags.UpstreamRegistryFlag, "docker-hub", "--"+flags.CredentialARNFlag, credentialARN, "docker-hub")
+	err := createPullThroughCacheRule(context, mockECR, out)
+	assert.NoError(t, err, "Unexpected error creating pull through cache rule")
+	assert.Contains(t, out.String(), "docker-hub/library/nginx")
+}
+
+func TestCreatePullThroughCacheRule_WithoutCredentials(t *testing.T) {
+	mockECR := setupTestController(t)
+	mockECR.EXPECT().CreatePullThroughCacheRule("123456789012", ecrclient.PullThroughCacheRule{
+		RepositoryPrefix:    "quay",
+		UpstreamRegistryURL: "quay.io",
+	}).Return(&ecr.CreatePullThroughCacheRuleOutput{}, nil)
+
+	context := pullThroughCacheContext("--"+flags.UpstreamRegistryFlag, "quay", "--"+flags.RegistryIdFlag, "123456789012", "quay")
+	err := createPullThroughCacheRule(context, mockECR, &bytes.Buffer{})
+	assert.NoError(t, err, "Unexpected error creating pull through cache rule")
+}
+
+func TestCreatePullThroughCacheRule_Invalid(t *testing.T) {
+	for name, args := range map[string][]string{
+		"no prefix":                     {"--" + flags.UpstreamRegistryFlag, "quay"},
+		"no upstream":                   {"quay"},
+		"Docker Hub without credential": {"--" + flags.UpstreamRegistryFlag, "docker.io", "docker-hub"},
+		"Azure without credential":      {"--" + flags.UpstreamRegistryFlag, "myregistry.azurecr.io", "azure"},
+	} {
+		mockECR := setupTestController(t)
+		err := createPullThroughCacheRule(pullThroughCacheContext(args...), mockECR, &bytes.Buffer{})
+		assert.Error(t, err, "Expected error for %s", name)
+	}
+}
+
+func TestListPullThroughCacheRules(t *testing.T) {
+	createdAt := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+	mockECR := setupTestController(t)
+	mockECR.EXPECT().ListPullThroughCacheRules("").Return([]*ecr.PullThroughCacheRule{
+		{
+			EcrRepositoryPrefix: aws.String("docker-hub"),
+			UpstreamRegistryUrl: aws.String("registry-1.docker.io"),
+			CredentialArn:       aws.String(credentialARN),
+			CreatedAt:           &createdAt,
+		},
+		{
+			EcrRepositoryPrefix: aws.String("quay"),
+			UpstreamRegistryUrl: aws.String("quay.io"),
+			CreatedAt:           &createdAt,
+		},
+	}, nil)
+
+	out := &bytes.Buffer{}
+	err := listPullThroughCacheRules(pullThroughCacheContext(), mockECR, out)
+	assert.NoError(t, err, "Unexpected error listing pull through cache rules")
+	assert.Regexp(t, `REPOSITORY PREFIX\s+UPSTREAM REGISTRY\s+CREDENTIAL\s+CREATED`, out.String())
+	assert.Regexp(t, `docker-hub\s+registry-1.docker.io\s+`+credentialARN+`\s+2020-07-01T12:00:00Z`, out.String())
+	assert.Regexp(t, `quay\s+quay.io\s+<none>\s+2020-07-01T12:00:00Z`, out.String())
+}
+
+func TestDeletePullThroughCacheRule(t *testing.T) {
+	mockECR := setupTestController(t)
+	mockECR.EXPECT().DeletePullThroughCacheRule("", "quay").Return(nil)
+
+	err := deletePullThroughCacheRule(pullThroughCacheContext("quay"), mockECR)
+	assert.NoError(t, err, "Unexpected error deleting pull through cache rule")
+}
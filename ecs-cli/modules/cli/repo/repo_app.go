@@ -0,0 +1,311 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package repo implements the commands which manage ECR repositories.
+package repo
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/repoconfig"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// const symbols and widths
+const (
+	minWidth    = 20
+	tabWidth    = 1
+	padding     = 3
+	paddingChar = ' '
+	numOfFlags  = 0
+
+	// RepoNameFormat is the argument of the commands which take a repository
+	RepoNameFormat = "ECR_REPOSITORY"
+
+	defaultEncryption = "AES256"
+)
+
+// Create creates an ECR repository with the settings from the repository
+// config file and flags
+func Create(c *cli.Context) {
+	ecrClient := newECRClient(c)
+	if err := createRepository(c, ecrClient); err != nil {
+		logrus.Fatal("Error executing 'create': ", err)
+	}
+}
+
+// Describe prints the settings, tags and policy of an ECR repository
+func Describe(c *cli.Context) {
+	ecrClient := newECRClient(c)
+	if err := describeRepository(c, ecrClient, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'describe': ", err)
+	}
+}
+
+// Delete deletes an ECR repository and the images in it
+func Delete(c *cli.Context) {
+	ecrClient := newECRClient(c)
+	if err := deleteRepository(c, ecrClient, bufio.NewReader(os.Stdin)); err != nil {
+		logrus.Fatal("Error executing 'delete': ", err)
+	}
+}
+
+// List lists the ECR repositories in a registry
+func List(c *cli.Context) {
+	ecrClient := newECRClient(c)
+	if err := listRepositories(c, ecrClient, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'ls': ", err)
+	}
+}
+
+// ReadSettings returns the settings of new repositories from a repository
+// config file
+func ReadSettings(configFile string) (ecrclient.RepositorySettings, error) {
+	settings := ecrclient.RepositorySettings{}
+	repoConfig, err := repoconfig.ReadRepoConfig(configFile)
+	if err != nil {
+		return settings, err
+	}
+
+	settings.ImageTagMutability = repoConfig.ImageTagMutability
+	settings.ScanOnPush = repoConfig.ScanOnPush
+	settings.KMSKey = repoConfig.KMSKey
+	settings.Policy = repoConfig.Policy
+	if len(repoConfig.Tags) > 0 {
+		settings.Tags = make(map[string]*string)
+		for key, value := range repoConfig.Tags {
+			settings.Tags[key] = aws.String(value)
+		}
+	}
+	return settings, nil
+}
+
+func newECRClient(c *cli.Context) ecrclient.Client {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing command: ", err)
+	}
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing command: ", err)
+	}
+	return ecrclient.NewClient(commandConfig)
+}
+
+func repositoryArg(c *cli.Context) (string, error) {
+	if len(c.Args()) != 1 {
+		return "", fmt.Errorf("Exactly 1 repository name is required. Found: %d", len(c.Args()))
+	}
+	return c.Args()[0], nil
+}
+
+func createRepository(c *cli.Context, ecrClient ecrclient.Client) error {
+	repositoryName, err := repositoryArg(c)
+	if err != nil {
+		return err
+	}
+	settings, err := createSettings(c)
+	if err != nil {
+		return err
+	}
+
+	repository, err := ecrClient.CreateRepositoryWithSettings(repositoryName, settings)
+	if err != nil {
+		return err
+	}
+	logrus.WithField("uri", aws.StringValue(repository.RepositoryUri)).Info("Created repository")
+	return nil
+}
+
+// createSettings returns the settings from the repository config file, if
+// one is given, overridden by any settings given as flags
+func createSettings(c *cli.Context) (ecrclient.RepositorySettings, error) {
+	settings := ecrclient.RepositorySettings{}
+	if configFile := c.String(flags.RepoConfigFlag); configFile != "" {
+		var err error
+		if settings, err = ReadSettings(configFile); err != nil {
+			return settings, err
+		}
+	}
+
+	if c.IsSet(flags.ImageTagMutabilityFlag) {
+		mutability, err := repoconfig.ValidateTagMutability(c.String(flags.ImageTagMutabilityFlag))
+		if err != nil {
+			return settings, err
+		}
+		settings.ImageTagMutability = mutability
+	}
+	if c.Bool(flags.ScanOnPushFlag) {
+		settings.ScanOnPush = true
+	}
+	if kmsKey := c.String(flags.KMSKeyFlag); kmsKey != "" {
+		settings.KMSKey = kmsKey
+	}
+	if policyFile := c.String(flags.PolicyFileFlag); policyFile != "" {
+		policy, err := repoconfig.ReadPolicy(policyFile)
+		if err != nil {
+			return settings, err
+		}
+		settings.Policy = policy
+	}
+	if tagValue := c.String(flags.ResourceTagsFlag); tagValue != "" {
+		tags, err := utils.GetTagsMap(tagValue)
+		if err != nil {
+			return settings, err
+		}
+		if settings.Tags == nil {
+			settings.Tags = make(map[string]*string)
+		}
+		for key, value := range tags {
+			settings.Tags[key] = value
+		}
+	}
+	return settings, nil
+}
+
+func describeRepository(c *cli.Context, ecrClient ecrclient.Client, out io.Writer) error {
+	repositoryName, err := repositoryArg(c)
+	if err != nil {
+		return err
+	}
+	registryID := c.String(flags.RegistryIdFlag)
+
+	repository, err := ecrClient.DescribeRepository(registryID, repositoryName)
+	if err != nil {
+		return err
+	}
+	tags, err := ecrClient.ListRepositoryTags(aws.StringValue(repository.RepositoryArn))
+	if err != nil {
+		return err
+	}
+	policy, err := ecrClient.GetRepositoryPolicy(registryID, repositoryName)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, minWidth, tabWidth, padding, paddingChar, numOfFlags)
+	fmt.Fprintf(w, "Name:\t%s\n", aws.StringValue(repository.RepositoryName))
+	fmt.Fprintf(w, "URI:\t%s\n", aws.StringValue(repository.RepositoryUri))
+	fmt.Fprintf(w, "ARN:\t%s\n", aws.StringValue(repository.RepositoryArn))
+	fmt.Fprintf(w, "Created:\t%s\n", formatTime(repository.CreatedAt))
+	fmt.Fprintf(w, "Image tag mutability:\t%s\n", aws.StringValue(repository.ImageTagMutability))
+	fmt.Fprintf(w, "Scan on push:\t%t\n", scanOnPush(repository))
+	fmt.Fprintf(w, "Encryption:\t%s\n", encryption(repository))
+	fmt.Fprintf(w, "Tags:\t%s\n", formatTags(tags))
+	w.Flush()
+
+	if policy == "" {
+		fmt.Fprintln(out, "Policy: <none>")
+		return nil
+	}
+	fmt.Fprintln(out, "Policy:")
+	indented := bytes.Buffer{}
+	if err = json.Indent(&indented, []byte(policy), "", "  "); err != nil {
+		fmt.Fprintln(out, policy)
+		return nil
+	}
+	fmt.Fprintln(out, indented.String())
+	return nil
+}
+
+func deleteRepository(c *cli.Context, ecrClient ecrclient.Client, reader *bufio.Reader) error {
+	repositoryName, err := repositoryArg(c)
+	if err != nil {
+		return err
+	}
+
+	if !c.Bool(flags.ForceFlag) {
+		fmt.Printf("Are you sure you want to delete repository %s and all of its images? [y/N]\n", repositoryName)
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("Error reading input: %s", err.Error())
+		}
+		formattedInput := strings.ToLower(strings.TrimSpace(input))
+		if formattedInput != "yes" && formattedInput != "y" {
+			return fmt.Errorf("Aborted repository deletion. To delete your repository, re-run this command and specify the '--%s' flag or confirm that you'd like to delete your repository at the prompt.", flags.ForceFlag)
+		}
+	}
+
+	return ecrClient.DeleteRepository(c.String(flags.RegistryIdFlag), repositoryName)
+}
+
+func listRepositories(c *cli.Context, ecrClient ecrclient.Client, out io.Writer) error {
+	w := tabwriter.NewWriter(out, minWidth, tabWidth, padding, paddingChar, numOfFlags)
+	fmt.Fprintln(w, "REPOSITORY NAME\tURI\tTAG MUTABILITY\tSCAN ON PUSH\tENCRYPTION\tCREATED\t")
+	err := ecrClient.ListRepositories(c.String(flags.RegistryIdFlag), func(repositories []*ecr.Repository) error {
+		for _, repository := range repositories {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\t%s\t\n",
+				aws.StringValue(repository.RepositoryName),
+				aws.StringValue(repository.RepositoryUri),
+				aws.StringValue(repository.ImageTagMutability),
+				scanOnPush(repository),
+				encryption(repository),
+				formatTime(repository.CreatedAt),
+			)
+		}
+		return nil
+	})
+	w.Flush()
+	return err
+}
+
+func scanOnPush(repository *ecr.Repository) bool {
+	return repository.ImageScanningConfiguration != nil && aws.BoolValue(repository.ImageScanningConfiguration.ScanOnPush)
+}
+
+// encryption returns the encryption type of a repository, and its KMS key if it has one
+func encryption(repository *ecr.Repository) string {
+	config := repository.EncryptionConfiguration
+	if config == nil {
+		return defaultEncryption
+	}
+	if kmsKey := aws.StringValue(config.KmsKey); kmsKey != "" {
+		return aws.StringValue(config.EncryptionType) + " (" + kmsKey + ")"
+	}
+	return aws.StringValue(config.EncryptionType)
+}
+
+func formatTags(tags []*ecr.Tag) string {
+	if len(tags) == 0 {
+		return "<none>"
+	}
+	pairs := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		pairs = append(pairs, aws.StringValue(tag.Key)+"="+aws.StringValue(tag.Value))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
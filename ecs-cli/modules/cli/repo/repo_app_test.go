@@ -0,0 +1,192 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package repo
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const (
+	repositoryName = "web"
+	repositoryARN  = "arn:aws:ecr:us-west-2:123456789012:repository/web"
+	repositoryURI  = "123456789012.dkr.ecr.us-west-2.amazonaws.com/web"
+)
+
+func TestCreateRepository_ConfigAndFlags(t *testing.T) {
+	dir, err := ioutil.TempDir("", "repo")
+	assert.NoError(t, err, "Unexpected error creating temp dir")
+	defer os.RemoveAll(dir)
+	configFile := filepath.Join(dir, "repo-config.yml")
+	assert.NoError(t, ioutil.WriteFile(configFile, []byte("image_tag_mutability: IMMUTABLE\nkms_key: alias/config\ntags:\n  team: green\n  env: prod\n"), 0644))
+	policyFile := filepath.Join(dir, "policy.json")
+	assert.NoError(t, ioutil.WriteFile(policyFile, []byte(`{"Statement":[]}`), 0644))
+
+	mockECR := setupTestController(t)
+	mockECR.EXPECT().CreateRepositoryWithSettings(repositoryName, ecrclient.RepositorySettings{
+		ImageTagMutability: "IMMUTABLE",
+		ScanOnPush:         true,
+		KMSKey:             "alias/flag",
+		Policy:             `{"Statement":[]}`,
+		Tags: map[string]*string{
+			"team": aws.String("blue"),
+			"env":  aws.String("prod"),
+		},
+	}).Return(&ecr.Repository{RepositoryUri: aws.String(repositoryURI)}, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-repo-create", 0)
+	flagSet.String(flags.RepoConfigFlag, configFile, "")
+	flagSet.Bool(flags.ScanOnPushFlag, true, "")
+	flagSet.String(flags.KMSKeyFlag, "alias/flag", "")
+	flagSet.String(flags.PolicyFileFlag, policyFile, "")
+	flagSet.String(flags.ResourceTagsFlag, "team=blue", "")
+	flagSet.Parse([]string{repositoryName})
+
+	err = createRepository(cli.NewContext(nil, flagSet, nil), mockECR)
+	assert.NoError(t, err, "Unexpected error creating repository")
+}
+
+func TestCreateRepository_InvalidMutability(t *testing.T) {
+	mockECR := setupTestController(t)
+
+	flagSet := flag.NewFlagSet("ecs-cli-repo-create", 0)
+	flagSet.String(flags.ImageTagMutabilityFlag, "", "")
+	flagSet.Parse([]string{"--" + flags.ImageTagMutabilityFlag, "never", repositoryName})
+
+	err := createRepository(cli.NewContext(nil, flagSet, nil), mockECR)
+	assert.Error(t, err, "Expected error for invalid image tag mutability")
+}
+
+func TestCreateRepository_NoName(t *testing.T) {
+	mockECR := setupTestController(t)
+
+	flagSet := flag.NewFlagSet("ecs-cli-repo-create", 0)
+	err := createRepository(cli.NewContext(nil, flagSet, nil), mockECR)
+	assert.Error(t, err, "Expected error when no repository name is given")
+}
+
+func TestDescribeRepository(t *testing.T) {
+	createdAt := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+	mockECR := setupTestController(t)
+	gomock.InOrder(
+		mockECR.EXPECT().DescribeRepository("", repositoryName).Return(&ecr.Repository{
+			RepositoryName:             aws.String(repositoryName),
+			RepositoryArn:              aws.String(repositoryARN),
+			RepositoryUri:              aws.String(repositoryURI),
+			CreatedAt:                  &createdAt,
+			ImageTagMutability:         aws.String(ecr.ImageTagMutabilityImmutable),
+			ImageScanningConfiguration: &ecr.ImageScanningConfiguration{ScanOnPush: aws.Bool(true)},
+			EncryptionConfiguration: &ecr.EncryptionConfiguration{
+				EncryptionType: aws.String(ecr.EncryptionTypeKms),
+				KmsKey:         aws.String("arn:aws:kms:us-west-2:123456789012:key/abc"),
+			},
+		}, nil),
+		mockECR.EXPECT().ListRepositoryTags(repositoryARN).Return([]*ecr.Tag{
+			{Key: aws.String("team"), Value: aws.String("green")},
+			{Key: aws.String("env"), Value: aws.String("prod")},
+		}, nil),
+		mockECR.EXPECT().GetRepositoryPolicy("", repositoryName).Return(`{"Statement":[]}`, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-repo-describe", 0)
+	flagSet.Parse([]string{repositoryName})
+	out := &bytes.Buffer{}
+	err := describeRepository(cli.NewContext(nil, flagSet, nil), mockECR, out)
+	assert.NoError(t, err, "Unexpected error describing repository")
+
+	output := out.String()
+	assert.Contains(t, output, repositoryURI)
+	assert.Contains(t, output, "2020-07-01T12:00:00Z")
+	assert.Regexp(t, `Image tag mutability:\s+IMMUTABLE`, output)
+	assert.Regexp(t, `Scan on push:\s+true`, output)
+	assert.Regexp(t, `Encryption:\s+KMS \(arn:aws:kms:us-west-2:123456789012:key/abc\)`, output)
+	assert.Regexp(t, `Tags:\s+env=prod,team=green`, output)
+	assert.Contains(t, output, "Policy:\n{\n  \"Statement\": []\n}")
+}
+
+func TestDeleteRepository_Confirmed(t *testing.T) {
+	mockECR := setupTestController(t)
+	mockECR.EXPECT().DeleteRepository("123456789012", repositoryName).Return(nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-repo-delete", 0)
+	flagSet.String(flags.RegistryIdFlag, "123456789012", "")
+	flagSet.Parse([]string{repositoryName})
+	err := deleteRepository(cli.NewContext(nil, flagSet, nil), mockECR, bufio.NewReader(strings.NewReader("y\n")))
+	assert.NoError(t, err, "Unexpected error deleting repository")
+}
+
+func TestDeleteRepository_Aborted(t *testing.T) {
+	mockECR := setupTestController(t)
+
+	flagSet := flag.NewFlagSet("ecs-cli-repo-delete", 0)
+	flagSet.Parse([]string{repositoryName})
+	err := deleteRepository(cli.NewContext(nil, flagSet, nil), mockECR, bufio.NewReader(strings.NewReader("n\n")))
+	assert.Error(t, err, "Expected error when deletion is not confirmed")
+}
+
+func TestDeleteRepository_Force(t *testing.T) {
+	mockECR := setupTestController(t)
+	mockECR.EXPECT().DeleteRepository("", repositoryName).Return(nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-repo-delete", 0)
+	flagSet.Bool(flags.ForceFlag, true, "")
+	flagSet.Parse([]string{repositoryName})
+	err := deleteRepository(cli.NewContext(nil, flagSet, nil), mockECR, nil)
+	assert.NoError(t, err, "Unexpected error deleting repository")
+}
+
+func TestListRepositories(t *testing.T) {
+	mockECR := setupTestController(t)
+	mockECR.EXPECT().ListRepositories("", gomock.Any()).Do(func(_ string, fn ecrclient.ProcessRepositoryDetails) {
+		fn([]*ecr.Repository{
+			{
+				RepositoryName:     aws.String(repositoryName),
+				RepositoryUri:      aws.String(repositoryURI),
+				ImageTagMutability: aws.String(ecr.ImageTagMutabilityMutable),
+			},
+		})
+	}).Return(nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-repo-ls", 0)
+	out := &bytes.Buffer{}
+	err := listRepositories(cli.NewContext(nil, flagSet, nil), mockECR, out)
+	assert.NoError(t, err, "Unexpected error listing repositories")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if assert.Len(t, lines, 2) {
+		assert.Regexp(t, `^REPOSITORY NAME\s+URI\s+TAG MUTABILITY\s+SCAN ON PUSH\s+ENCRYPTION\s+CREATED`, lines[0])
+		assert.Regexp(t, `^web\s+`+repositoryURI+`\s+MUTABLE\s+false\s+AES256`, lines[1])
+	}
+}
+
+func setupTestController(t *testing.T) *mock_ecr.MockClient {
+	ctrl := gomock.NewController(t)
+	return mock_ecr.NewMockClient(ctrl)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	utils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
 	"github.com/aws/aws-sdk-go/aws"
+	cfnsdk "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -76,6 +77,43 @@ func Update(networkMode, serviceName string, c *context.ECSContext) error {
 	return update(c.CLIContext, networkMode, serviceName, c.CommandConfig.Cluster, cfnClient, ecsParamsSD)
 }
 
+// ReviewUpdateFunc is the interface/signature for ReviewUpdate
+// This helps when writing code in other packages that need to mock ReviewUpdate
+type ReviewUpdateFunc func(networkMode, serviceName string, c *context.ECSContext) (*ReviewedUpdate, error)
+
+// ReviewedUpdate is an update of the Service Discovery Service whose changes
+// were reviewed before the ECS service is changed
+type ReviewedUpdate struct {
+	// Stop is set if nothing should be changed, after a dry run or after
+	// rendering the template of the stack
+	Stop bool
+	// Execute applies the reviewed changes; it is nil if there are none
+	Execute func() error
+}
+
+// IsReviewed returns whether the changes to the Service Discovery Service are
+// reviewed before they are applied, with --preview-service-discovery,
+// --dry-run or --render-template
+func IsReviewed(c *cli.Context) bool {
+	return c.Bool(flags.PreviewServiceDiscoveryFlag) || c.Bool(flags.DryRunFlag) || c.String(flags.RenderTemplateFlag) != ""
+}
+
+// ReviewUpdate shows the changes to the Service Discovery Service as a change
+// set, or renders the template of its stack, without changing anything. The
+// changes are only applied by executing the returned update.
+func ReviewUpdate(networkMode, serviceName string, c *context.ECSContext) (*ReviewedUpdate, error) {
+	cfnClient := cloudformation.NewCloudformationClient(c.CommandConfig)
+
+	var ecsParamsSD *utils.ServiceDiscovery
+	if c.ECSParams != nil {
+		ecsParamsSD = &c.ECSParams.RunParams.ServiceDiscovery
+	} else {
+		ecsParamsSD = &utils.ServiceDiscovery{}
+	}
+
+	return reviewUpdate(c.CLIContext, networkMode, serviceName, c.CommandConfig.Cluster, cfnClient, ecsParamsSD)
+}
+
 // DeleteFunc is the interface/signature for Delete
 // This helps when writing code in other packages that need to mock Create (specifically it's a nicety that helps IDE features work)
 type DeleteFunc func(serviceName string, c *context.ECSContext) error
@@ -88,33 +126,91 @@ func Delete(serviceName string, c *context.ECSContext) error {
 }
 
 func update(c *cli.Context, networkMode, serviceName, clusterName string, cfnClient cloudformation.CloudformationClient, ecsParamsSD *utils.ServiceDiscovery) error {
+	sdsStackName, sdsParams, _, err := getSDSParamsForUpdate(c, networkMode, serviceName, clusterName, cfnClient, ecsParamsSD)
+	if err != nil {
+		return err
+	}
+
+	if _, err := cfnClient.UpdateStack(sdsStackName, sdsParams); err != nil {
+		return err
+	}
+
+	logrus.Info("Waiting for your Service Discovery resources to be updated...")
+	return cfnClient.WaitUntilUpdateComplete(sdsStackName)
+}
+
+func reviewUpdate(c *cli.Context, networkMode, serviceName, clusterName string, cfnClient cloudformation.CloudformationClient, ecsParamsSD *utils.ServiceDiscovery) (*ReviewedUpdate, error) {
+	options := cloudformation.PreviewOptions{
+		DryRun: c.Bool(flags.DryRunFlag),
+		Yes:    c.Bool(flags.YesFlag),
+	}
+	if options.Yes && !options.DryRun && !c.Bool(flags.PreviewServiceDiscoveryFlag) {
+		return nil, fmt.Errorf("You can only specify '--%s' with '--%s'", flags.YesFlag, flags.PreviewServiceDiscoveryFlag)
+	}
+
+	sdsStackName, sdsParams, existingParameters, err := getSDSParamsForUpdate(c, networkMode, serviceName, clusterName, cfnClient, ecsParamsSD)
+	if err != nil {
+		return nil, err
+	}
+
+	if renderPath := c.String(flags.RenderTemplateFlag); renderPath != "" {
+		template, err := cfnClient.GetTemplate(sdsStackName)
+		if err != nil {
+			return nil, err
+		}
+		if err := cloudformation.RenderStack(renderPath, sdsStackName, template, sdsParams, existingParameters); err != nil {
+			return nil, err
+		}
+		return &ReviewedUpdate{Stop: true}, nil
+	}
+
+	changeSet, err := cfnClient.CreateChangeSet("", sdsStackName, sdsParams, nil, cfnsdk.ChangeSetTypeUpdate)
+	if err != nil {
+		return nil, err
+	}
+	execute, err := cloudformation.ReviewChangeSet(cfnClient, changeSet, options)
+	if err != nil {
+		return nil, err
+	}
+	if !execute {
+		return &ReviewedUpdate{Stop: options.DryRun}, nil
+	}
+
+	return &ReviewedUpdate{
+		Execute: func() error {
+			if err := cfnClient.ExecuteChangeSet(changeSet.ID); err != nil {
+				return err
+			}
+			logrus.Info("Waiting for your Service Discovery resources to be updated...")
+			return cfnClient.WaitUntilUpdateComplete(sdsStackName)
+		},
+	}, nil
+}
+
+// getSDSParamsForUpdate returns the name of the stack of the Service
+// Discovery Service, with its new and existing parameters
+func getSDSParamsForUpdate(c *cli.Context, networkMode, serviceName, clusterName string, cfnClient cloudformation.CloudformationClient, ecsParamsSD *utils.ServiceDiscovery) (string, *cloudformation.CfnStackParams, []*cfnsdk.Parameter, error) {
 	warnOnFlagsNotValidForUpdate(c)
 
 	sdsInput, err := mergeSDSFields(c, ecsParamsSD.ServiceDiscoveryService)
 	if err != nil {
-		return err
+		return "", nil, nil, err
 	}
 
 	sdsStackName := cfnStackName(serviceDiscoveryServiceStackNameFormat, clusterName, serviceName)
 	existingParameters, err := cfnClient.GetStackParameters(sdsStackName)
 	if err != nil {
-		return errors.Wrap(err, "CloudFormation stack not found for Service Discovery Service")
+		return "", nil, nil, errors.Wrap(err, "CloudFormation stack not found for Service Discovery Service")
 	}
 
 	sdsParams, err := getSDSCFNParamsForUpdate(networkMode, sdsInput, existingParameters)
 	if err != nil {
-		return err
+		return "", nil, nil, err
 	}
 	if err := sdsParams.Validate(); err != nil {
-		return err
+		return "", nil, nil, err
 	}
-
-	if _, err := cfnClient.UpdateStack(sdsStackName, sdsParams); err != nil {
-		return err
-	}
-
-	logrus.Info("Waiting for your Service Discovery resources to be updated...")
-	return cfnClient.WaitUntilUpdateComplete(sdsStackName)
+	return sdsStackName, sdsParams, existingParameters, nil
 }
 
 func delete(c *cli.Context, cfnClient cloudformation.CloudformationClient, serviceName, projectName, clusterName string) error {
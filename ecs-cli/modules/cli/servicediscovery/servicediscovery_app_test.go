@@ -16,6 +16,9 @@ package servicediscovery
 import (
 	"flag"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -651,6 +654,179 @@ func TestUpdateServiceDiscoveryUpdateStackError(t *testing.T) {
 	assert.Error(t, err, "Expected error calling update")
 }
 
+func TestUpdateServiceDiscoveryWithPreview(t *testing.T) {
+	input := &utils.ServiceDiscovery{
+		ServiceDiscoveryService: utils.ServiceDiscoveryService{
+			DNSConfig: utils.DNSConfig{
+				TTL: aws.Int64(120),
+			},
+		},
+	}
+
+	existingParameters := []*sdk.Parameter{
+		&sdk.Parameter{
+			ParameterKey:   aws.String(parameterKeySDSDescription),
+			ParameterValue: aws.String(testDescription),
+		},
+		&sdk.Parameter{
+			ParameterKey:   aws.String(parameterKeySDSName),
+			ParameterValue: aws.String(testServiceName),
+		},
+		&sdk.Parameter{
+			ParameterKey:   aws.String(parameterKeyNamespaceID),
+			ParameterValue: aws.String(testNamespaceID),
+		},
+		&sdk.Parameter{
+			ParameterKey:   aws.String(parameterKeyDNSType),
+			ParameterValue: aws.String(servicediscovery.RecordTypeA),
+		},
+		&sdk.Parameter{
+			ParameterKey:   aws.String(parameterKeyDNSTTL),
+			ParameterValue: aws.String("60"),
+		},
+		&sdk.Parameter{
+			ParameterKey:   aws.String(parameterKeyHealthCheckCustomConfigFailureThreshold),
+			ParameterValue: aws.String("1"),
+		},
+	}
+
+	changeSet := &cloudformation.ChangeSet{
+		ID:        "changeSetID",
+		StackName: testSDSStackName,
+		Type:      sdk.ChangeSetTypeUpdate,
+		Changes: []*sdk.ResourceChange{
+			&sdk.ResourceChange{
+				Action:            aws.String(sdk.ChangeActionModify),
+				LogicalResourceId: aws.String("ServiceDiscoveryService"),
+				ResourceType:      aws.String("AWS::ServiceDiscovery::Service"),
+				Replacement:       aws.String(sdk.ReplacementFalse),
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().GetStackParameters(testSDSStackName).Return(existingParameters, nil),
+		mockCloudformation.EXPECT().CreateChangeSet("", testSDSStackName, gomock.Any(), gomock.Any(), sdk.ChangeSetTypeUpdate).Do(func(v, w, x, y, z interface{}) {
+			cfnParams := x.(*cloudformation.CfnStackParams)
+			validateCFNParam("120", parameterKeyDNSTTL, cfnParams, t)
+		}).Return(changeSet, nil),
+		mockCloudformation.EXPECT().ExecuteChangeSet("changeSetID").Return(nil),
+		mockCloudformation.EXPECT().WaitUntilUpdateComplete(testSDSStackName).Return(nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Bool(flags.PreviewServiceDiscoveryFlag, true, "")
+	flagSet.Bool(flags.YesFlag, true, "")
+	context := cli.NewContext(nil, flagSet, nil)
+
+	reviewed, err := reviewUpdate(context, "awsvpc", testServiceName, testClusterName, mockCloudformation, input)
+	assert.NoError(t, err, "Unexpected error reviewing update")
+	assert.False(t, reviewed.Stop, "Expected the service to be updated")
+	if assert.NotNil(t, reviewed.Execute, "Expected changes to execute") {
+		assert.NoError(t, reviewed.Execute(), "Unexpected error executing update")
+	}
+}
+
+func existingSDSParameters() []*sdk.Parameter {
+	return []*sdk.Parameter{
+		{ParameterKey: aws.String(parameterKeySDSDescription), ParameterValue: aws.String(testDescription)},
+		{ParameterKey: aws.String(parameterKeySDSName), ParameterValue: aws.String(testServiceName)},
+		{ParameterKey: aws.String(parameterKeyNamespaceID), ParameterValue: aws.String(testNamespaceID)},
+		{ParameterKey: aws.String(parameterKeyDNSType), ParameterValue: aws.String(servicediscovery.RecordTypeA)},
+		{ParameterKey: aws.String(parameterKeyDNSTTL), ParameterValue: aws.String("60")},
+		{ParameterKey: aws.String(parameterKeyHealthCheckCustomConfigFailureThreshold), ParameterValue: aws.String("1")},
+	}
+}
+
+func TestReviewUpdateServiceDiscoveryDryRun(t *testing.T) {
+	input := &utils.ServiceDiscovery{
+		ServiceDiscoveryService: utils.ServiceDiscoveryService{
+			DNSConfig: utils.DNSConfig{TTL: aws.Int64(120)},
+		},
+	}
+	changeSet := &cloudformation.ChangeSet{
+		ID:        "changeSetID",
+		StackName: testSDSStackName,
+		Type:      sdk.ChangeSetTypeUpdate,
+		Changes: []*sdk.ResourceChange{
+			{
+				Action:            aws.String(sdk.ChangeActionModify),
+				LogicalResourceId: aws.String("ServiceDiscoveryService"),
+				ResourceType:      aws.String("AWS::ServiceDiscovery::Service"),
+				Replacement:       aws.String(sdk.ReplacementFalse),
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().GetStackParameters(testSDSStackName).Return(existingSDSParameters(), nil),
+		mockCloudformation.EXPECT().CreateChangeSet("", testSDSStackName, gomock.Any(), gomock.Any(), sdk.ChangeSetTypeUpdate).Return(changeSet, nil),
+		mockCloudformation.EXPECT().DeleteChangeSet("changeSetID").Return(nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Bool(flags.DryRunFlag, true, "")
+	context := cli.NewContext(nil, flagSet, nil)
+
+	reviewed, err := reviewUpdate(context, "awsvpc", testServiceName, testClusterName, mockCloudformation, input)
+	assert.NoError(t, err, "Unexpected error reviewing update")
+	assert.True(t, reviewed.Stop, "Expected nothing to be changed on a dry run")
+	assert.Nil(t, reviewed.Execute, "Expected no changes to execute on a dry run")
+}
+
+func TestReviewUpdateServiceDiscoveryRenderTemplate(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "sds-render")
+	assert.NoError(t, err, "Could not create temp dir")
+	defer os.RemoveAll(tempDir)
+	renderPath := filepath.Join(tempDir, "sds.json")
+
+	input := &utils.ServiceDiscovery{
+		ServiceDiscoveryService: utils.ServiceDiscoveryService{
+			DNSConfig: utils.DNSConfig{TTL: aws.Int64(120)},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().GetStackParameters(testSDSStackName).Return(existingSDSParameters(), nil),
+		mockCloudformation.EXPECT().GetTemplate(testSDSStackName).Return(`{"Resources": {}}`, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.String(flags.RenderTemplateFlag, renderPath, "")
+	context := cli.NewContext(nil, flagSet, nil)
+
+	reviewed, err := reviewUpdate(context, "awsvpc", testServiceName, testClusterName, mockCloudformation, input)
+	assert.NoError(t, err, "Unexpected error rendering template")
+	assert.True(t, reviewed.Stop, "Expected nothing to be changed when rendering the template")
+
+	data, err := ioutil.ReadFile(renderPath)
+	assert.NoError(t, err, "Expected the template to be rendered")
+	assert.Contains(t, string(data), `"DNSTTL": "120"`)
+	assert.Contains(t, string(data), `"NamespaceID": "`+testNamespaceID+`"`)
+}
+
+func TestReviewUpdateServiceDiscoveryYesWithoutPreview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Bool(flags.YesFlag, true, "")
+	context := cli.NewContext(nil, flagSet, nil)
+
+	_, err := reviewUpdate(context, "awsvpc", testServiceName, testClusterName, mockCloudformation, &utils.ServiceDiscovery{})
+	assert.Error(t, err, "Expected error for --yes without --preview-service-discovery")
+}
+
 func TestDeleteServiceDiscovery(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -16,6 +16,7 @@ package amimetadata
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	"github.com/aws/aws-sdk-go/aws"
@@ -34,8 +35,88 @@ const (
 	amazonLinux2X86RecommendedParameterName    = "/aws/service/ecs/optimized-ami/amazon-linux-2/recommended"
 	amazonLinux2ARM64RecommendedParameterName  = "/aws/service/ecs/optimized-ami/amazon-linux-2/arm64/recommended"
 	amazonLinux2X86GPURecommendedParameterName = "/aws/service/ecs/optimized-ami/amazon-linux-2/gpu/recommended"
+
+	amazonLinux2InfRecommendedParameterName = "/aws/service/ecs/optimized-ami/amazon-linux-2/inf/recommended"
+
+	amazonLinux2Kernel510X86RecommendedParameterName    = "/aws/service/ecs/optimized-ami/amazon-linux-2/kernel-5.10/recommended"
+	amazonLinux2Kernel510ARM64RecommendedParameterName  = "/aws/service/ecs/optimized-ami/amazon-linux-2/kernel-5.10/arm64/recommended"
+	amazonLinux2Kernel510X86GPURecommendedParameterName = "/aws/service/ecs/optimized-ami/amazon-linux-2/kernel-5.10/gpu/recommended"
+
+	amazonLinux2023X86RecommendedParameterName    = "/aws/service/ecs/optimized-ami/amazon-linux-2023/recommended"
+	amazonLinux2023ARM64RecommendedParameterName  = "/aws/service/ecs/optimized-ami/amazon-linux-2023/arm64/recommended"
+	amazonLinux2023X86GPURecommendedParameterName = "/aws/service/ecs/optimized-ami/amazon-linux-2023/gpu/recommended"
+)
+
+// SSM parameter names to retrieve the Bottlerocket AMI for ECS. Unlike the ECS
+// optimized AMI parameters, their value is the image ID rather than JSON metadata.
+// See: https://github.com/bottlerocket-os/bottlerocket/blob/develop/QUICKSTART-ECS.md
+const (
+	bottlerocketX86ParameterName    = "/aws/service/bottlerocket/aws-ecs-2/x86_64/latest/image_id"
+	bottlerocketARM64ParameterName  = "/aws/service/bottlerocket/aws-ecs-2/arm64/latest/image_id"
+	bottlerocketX86GPUParameterName = "/aws/service/bottlerocket/aws-ecs-2-nvidia/x86_64/latest/image_id"
 )
 
+// ECS optimized AMI families which can be launched by ecs-cli up.
+const (
+	AMIFamilyAmazonLinux2          = "al2"
+	AMIFamilyAmazonLinux2023       = "al2023"
+	AMIFamilyBottlerocket          = "bottlerocket"
+	AMIFamilyAmazonLinux2Kernel510 = "al2-kernel-5.10"
+	AMIFamilyInferentia            = "inferentia"
+)
+
+// AMIFamilies lists the supported AMI families.
+var AMIFamilies = []string{
+	AMIFamilyAmazonLinux2,
+	AMIFamilyAmazonLinux2023,
+	AMIFamilyBottlerocket,
+	AMIFamilyAmazonLinux2Kernel510,
+	AMIFamilyInferentia,
+}
+
+// amiFamily holds the SSM parameter names of an AMI family for each architecture.
+// An empty arm64 parameter means the family has no Arm AMI, and an empty gpu
+// parameter means the x86 AMI is used for GPU instances too.
+type amiFamily struct {
+	displayName string
+	x86         string
+	arm64       string
+	gpu         string
+	imageIDOnly bool
+}
+
+var amiFamilyParameters = map[string]amiFamily{
+	AMIFamilyAmazonLinux2: {
+		displayName: "Amazon Linux 2",
+		x86:         amazonLinux2X86RecommendedParameterName,
+		arm64:       amazonLinux2ARM64RecommendedParameterName,
+		gpu:         amazonLinux2X86GPURecommendedParameterName,
+	},
+	AMIFamilyAmazonLinux2023: {
+		displayName: "Amazon Linux 2023",
+		x86:         amazonLinux2023X86RecommendedParameterName,
+		arm64:       amazonLinux2023ARM64RecommendedParameterName,
+		gpu:         amazonLinux2023X86GPURecommendedParameterName,
+	},
+	AMIFamilyBottlerocket: {
+		displayName: "Bottlerocket",
+		x86:         bottlerocketX86ParameterName,
+		arm64:       bottlerocketARM64ParameterName,
+		gpu:         bottlerocketX86GPUParameterName,
+		imageIDOnly: true,
+	},
+	AMIFamilyAmazonLinux2Kernel510: {
+		displayName: "Amazon Linux 2 (kernel 5.10)",
+		x86:         amazonLinux2Kernel510X86RecommendedParameterName,
+		arm64:       amazonLinux2Kernel510ARM64RecommendedParameterName,
+		gpu:         amazonLinux2Kernel510X86GPURecommendedParameterName,
+	},
+	AMIFamilyInferentia: {
+		displayName: "Amazon Linux 2 (Inferentia)",
+		x86:         amazonLinux2InfRecommendedParameterName,
+	},
+}
+
 // AMIMetadata is returned through ssm:GetParameters and can be used to retrieve the ImageId
 // while launching instances.
 //
@@ -51,6 +132,7 @@ type AMIMetadata struct {
 // Client defines methods to interact with the SSM API interface.
 type Client interface {
 	GetRecommendedECSLinuxAMI(string) (*AMIMetadata, error)
+	GetRecommendedECSAMI(amiFamily, instanceType string) (*AMIMetadata, error)
 }
 
 // metadataClient implements Client.
@@ -71,18 +153,30 @@ func NewMetadataClient(commandConfig *config.CommandConfig) Client {
 
 // GetRecommendedECSLinuxAMI returns the recommended Amazon ECS-Optimized AMI Metadata given the instance type.
 func (c *metadataClient) GetRecommendedECSLinuxAMI(instanceType string) (*AMIMetadata, error) {
-	if isARM64Instance(instanceType) {
-		logrus.Infof("Using Arm ecs-optimized AMI because instance type was %s", instanceType)
-		return c.parameterValueFor(amazonLinux2ARM64RecommendedParameterName)
+	return c.GetRecommendedECSAMI(AMIFamilyAmazonLinux2, instanceType)
+}
+
+// GetRecommendedECSAMI returns the metadata of the recommended AMI of the given family for the instance type.
+func (c *metadataClient) GetRecommendedECSAMI(amiFamily, instanceType string) (*AMIMetadata, error) {
+	family, ok := amiFamilyParameters[amiFamily]
+	if !ok {
+		return nil, fmt.Errorf("Unsupported AMI family %s; valid values are %s", amiFamily, strings.Join(AMIFamilies, ", "))
 	}
-	if isGPUInstance(instanceType) {
-		logrus.Infof("Using GPU ecs-optimized AMI because instance type was %s", instanceType)
-		return c.parameterValueFor(amazonLinux2X86GPURecommendedParameterName)
+	ssmParamName := family.x86
+	if IsARM64Instance(instanceType) {
+		if family.arm64 == "" {
+			return nil, fmt.Errorf("The %s AMI does not support Arm instance type %s", family.displayName, instanceType)
+		}
+		logrus.Infof("Using Arm %s AMI because instance type was %s", family.displayName, instanceType)
+		ssmParamName = family.arm64
+	} else if IsGPUInstance(instanceType) && family.gpu != "" {
+		logrus.Infof("Using GPU %s AMI because instance type was %s", family.displayName, instanceType)
+		ssmParamName = family.gpu
 	}
-	return c.parameterValueFor(amazonLinux2X86RecommendedParameterName)
+	return c.parameterValueFor(ssmParamName, family)
 }
 
-func (c *metadataClient) parameterValueFor(ssmParamName string) (*AMIMetadata, error) {
+func (c *metadataClient) parameterValueFor(ssmParamName string, family amiFamily) (*AMIMetadata, error) {
 	response, err := c.client.GetParameter(&ssm.GetParameterInput{
 		Name: aws.String(ssmParamName),
 	})
@@ -91,24 +185,32 @@ func (c *metadataClient) parameterValueFor(ssmParamName string) (*AMIMetadata, e
 			if aerr.Code() == ssm.ErrCodeParameterNotFound {
 				// Added for AMIs which are only supported in some regions
 				return nil, errors.Wrapf(err,
-					"Could not find Recommended Amazon Linux 2 AMI %s in %s; the AMI may not be supported in this region",
+					"Could not find Recommended %s AMI %s in %s; the AMI may not be supported in this region",
+					family.displayName,
 					ssmParamName,
 					c.region)
 			}
 		}
 		return nil, err
 	}
+	if family.imageIDOnly {
+		return &AMIMetadata{
+			ImageID: aws.StringValue(response.Parameter.Value),
+			OsName:  family.displayName,
+		}, nil
+	}
 	metadata := &AMIMetadata{}
 	err = json.Unmarshal([]byte(aws.StringValue(response.Parameter.Value)), metadata)
 	return metadata, err
 }
 
+// IsARM64Instance returns whether the instance type has an arm64 processor.
 // See: https://aws.amazon.com/ec2/instance-types/
 // a1 is the first generation of graviton processors.
 // t4g, m6g, c6g, r6g are using graviton 2.
 // The d suffix is for disk optimized and applies to all except a1 and t4g, e.g. m6gd.medium.
 // Invalid instance type like t4gd.nano will trigger validation error in API so we don't do validation here.
-func isARM64Instance(instanceType string) bool {
+func IsARM64Instance(instanceType string) bool {
 	r := regexp.MustCompile("(a1|.\\dgd?)\\.(medium|\\d*x?large|metal)")
 	if r.MatchString(instanceType) {
 		return true
@@ -116,8 +218,10 @@ func isARM64Instance(instanceType string) bool {
 	return false
 }
 
+// IsGPUInstance returns whether the instance type has GPUs, which the GPU
+// AMI of a family is used for.
 // See: https://docs.aws.amazon.com/AmazonECS/latest/developerguide/ecs-gpu.html
-func isGPUInstance(instanceType string) bool {
+func IsGPUInstance(instanceType string) bool {
 	var gpuInstanceClasses = []string{
 		"p2.",
 		"p3.",
@@ -134,3 +238,10 @@ func isGPUInstance(instanceType string) bool {
 	}
 	return false
 }
+
+// IsInferentiaInstance returns whether the instance type has AWS Inferentia
+// chips, which need the Inferentia AMI.
+// See: https://docs.aws.amazon.com/AmazonECS/latest/developerguide/ecs-inference.html
+func IsInferentiaInstance(instanceType string) bool {
+	return strings.HasPrefix(instanceType, "inf")
+}
@@ -99,6 +99,70 @@ func TestMetadataClient_GetRecommendedECSLinuxAMI(t *testing.T) {
 	}
 }
 
+func TestMetadataClient_GetRecommendedECSAMI(t *testing.T) {
+	tests := []struct {
+		amiFamily         string
+		instanceType      string
+		expectedParameter string
+	}{
+		{AMIFamilyAmazonLinux2023, "t3.micro", amazonLinux2023X86RecommendedParameterName},
+		{AMIFamilyAmazonLinux2023, "m6g.large", amazonLinux2023ARM64RecommendedParameterName},
+		{AMIFamilyAmazonLinux2023, "g4dn.xlarge", amazonLinux2023X86GPURecommendedParameterName},
+		{AMIFamilyAmazonLinux2Kernel510, "t3.micro", amazonLinux2Kernel510X86RecommendedParameterName},
+		{AMIFamilyAmazonLinux2Kernel510, "c6g.large", amazonLinux2Kernel510ARM64RecommendedParameterName},
+		{AMIFamilyAmazonLinux2Kernel510, "p3.2xlarge", amazonLinux2Kernel510X86GPURecommendedParameterName},
+		{AMIFamilyInferentia, "inf1.xlarge", amazonLinux2InfRecommendedParameterName},
+		{AMIFamilyBottlerocket, "t3.micro", bottlerocketX86ParameterName},
+		{AMIFamilyBottlerocket, "a1.medium", bottlerocketARM64ParameterName},
+		{AMIFamilyBottlerocket, "g4dn.xlarge", bottlerocketX86GPUParameterName},
+	}
+
+	for _, test := range tests {
+		m := newMockSSMAPI(t)
+		m.EXPECT().GetParameter(gomock.Any()).Do(func(input *ssm.GetParameterInput) {
+			assert.Equal(t, test.expectedParameter, *input.Name)
+		}).Return(emptySSMParameterOutput(), nil)
+
+		c := metadataClient{
+			m,
+			"us-east-1",
+		}
+		_, err := c.GetRecommendedECSAMI(test.amiFamily, test.instanceType)
+		assert.NoError(t, err, "Unexpected error for %s on %s", test.amiFamily, test.instanceType)
+	}
+}
+
+func TestMetadataClient_GetRecommendedECSAMIBottlerocket(t *testing.T) {
+	imageID := "ami-12345"
+	m := newMockSSMAPI(t)
+	m.EXPECT().GetParameter(gomock.Any()).Return(&ssm.GetParameterOutput{
+		Parameter: &ssm.Parameter{
+			Value: &imageID,
+		},
+	}, nil)
+
+	c := metadataClient{
+		m,
+		"us-east-1",
+	}
+	metadata, err := c.GetRecommendedECSAMI(AMIFamilyBottlerocket, "t3.micro")
+	assert.NoError(t, err, "Unexpected error getting Bottlerocket AMI")
+	assert.Equal(t, imageID, metadata.ImageID)
+	assert.Equal(t, "Bottlerocket", metadata.OsName)
+}
+
+func TestMetadataClient_GetRecommendedECSAMIErrorCases(t *testing.T) {
+	c := metadataClient{
+		newMockSSMAPI(t),
+		"us-east-1",
+	}
+	_, err := c.GetRecommendedECSAMI("ubuntu", "t3.micro")
+	assert.Error(t, err, "Expected error for unsupported AMI family")
+
+	_, err = c.GetRecommendedECSAMI(AMIFamilyInferentia, "m6g.large")
+	assert.Error(t, err, "Expected error for Arm instance type with the Inferentia AMI")
+}
+
 func newMockSSMAPI(t *testing.T) *mock_ssmiface.MockSSMAPI {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -47,6 +47,21 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// GetRecommendedECSAMI mocks base method
+func (m *MockClient) GetRecommendedECSAMI(arg0, arg1 string) (*amimetadata.AMIMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecommendedECSAMI", arg0, arg1)
+	ret0, _ := ret[0].(*amimetadata.AMIMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecommendedECSAMI indicates an expected call of GetRecommendedECSAMI
+func (mr *MockClientMockRecorder) GetRecommendedECSAMI(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecommendedECSAMI", reflect.TypeOf((*MockClient)(nil).GetRecommendedECSAMI), arg0, arg1)
+}
+
 // GetRecommendedECSLinuxAMI mocks base method
 func (m *MockClient) GetRecommendedECSLinuxAMI(arg0 string) (*amimetadata.AMIMetadata, error) {
 	m.ctrl.T.Helper()
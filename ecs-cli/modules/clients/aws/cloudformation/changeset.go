@@ -0,0 +1,343 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cloudformation
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// changeSetNameFormat is the format of the names of the change sets
+	// created by the CLI, which must be unique for their stack
+	changeSetNameFormat = "ecs-cli-%d"
+
+	// maxRetriesChangeSet is the maximum number of DescribeChangeSet API calls made while waiting
+	// for CloudFormation to compute the changes of a change set.
+	maxRetriesChangeSet = 60
+
+	// delayChangeSet is the delay between successive DescribeChangeSet API calls.
+	delayChangeSet = 5 * time.Second
+
+	// renderedStackFileMode is the mode of the files written by RenderStack
+	renderedStackFileMode = 0644
+)
+
+// noChangesReasons are the status reasons of the change sets which failed
+// because they would not change the stack.
+var noChangesReasons = []string{
+	"didn't contain changes",
+	"No updates are to be performed",
+}
+
+// disruptiveResourceTypes maps the types of the resources whose replacement
+// or removal recreates all the container instances of a cluster to their
+// description in the change set summary.
+var disruptiveResourceTypes = map[string]string{
+	"AWS::EC2::VPC":                      "VPC",
+	"AWS::AutoScaling::AutoScalingGroup": "Auto Scaling group",
+}
+
+// ChangeSet is a CloudFormation change set and the changes it makes to the
+// resources of its stack.
+type ChangeSet struct {
+	ID        string
+	StackName string
+	// Type is either cloudformation.ChangeSetTypeCreate or cloudformation.ChangeSetTypeUpdate
+	Type    string
+	Changes []*cloudformation.ResourceChange
+}
+
+// PreviewOptions control how the changes of a change set are reviewed.
+type PreviewOptions struct {
+	// DryRun only prints the changes, without executing them
+	DryRun bool
+	// Yes executes the changes without asking for confirmation
+	Yes bool
+	// In and Out default to the standard input and output
+	In  io.Reader
+	Out io.Writer
+}
+
+// CreateChangeSet creates a change set of the given type for the stack and
+// waits until CloudFormation has computed its changes. An empty template
+// keeps the current template of the stack.
+func (c *cloudformationClient) CreateChangeSet(template, stackName string, params *CfnStackParams, tags []*cloudformation.Tag, changeSetType string) (*ChangeSet, error) {
+	input := &cloudformation.CreateChangeSetInput{
+		Capabilities:  aws.StringSlice([]string{cloudformation.CapabilityCapabilityIam}),
+		ChangeSetName: aws.String(fmt.Sprintf(changeSetNameFormat, time.Now().Unix())),
+		ChangeSetType: aws.String(changeSetType),
+		StackName:     aws.String(stackName),
+		Parameters:    params.Get(),
+		Tags:          tags,
+	}
+	if template == "" {
+		input.UsePreviousTemplate = aws.Bool(true)
+	} else {
+		input.TemplateBody = aws.String(template)
+	}
+
+	output, err := c.client.CreateChangeSet(input)
+	if err != nil {
+		return nil, err
+	}
+	log.WithFields(log.Fields{"changeSetId": output.Id}).Debug("Cloudformation create change set call succeeded")
+
+	changeSet := &ChangeSet{
+		ID:        aws.StringValue(output.Id),
+		StackName: stackName,
+		Type:      changeSetType,
+	}
+	for retryCount := 0; retryCount < maxRetriesChangeSet; retryCount++ {
+		status, reason, changes, err := c.describeChangeSet(changeSet.ID)
+		if err != nil {
+			return nil, err
+		}
+		switch status {
+		case cloudformation.ChangeSetStatusCreateComplete:
+			changeSet.Changes = changes
+			return changeSet, nil
+		case cloudformation.ChangeSetStatusFailed:
+			if isNoChangesReason(reason) {
+				return changeSet, nil
+			}
+			return nil, fmt.Errorf("Could not create change set for stack '%s'. Reason: '%s'", stackName, reason)
+		}
+		log.WithFields(log.Fields{"changeSetStatus": status}).Debug("Cloudformation change set status")
+		c.sleeper.Sleep(delayChangeSet)
+	}
+
+	return nil, fmt.Errorf("Timeout waiting for the changes of stack '%s' to be computed", stackName)
+}
+
+// describeChangeSet returns the status and the changes of the change set.
+func (c *cloudformationClient) describeChangeSet(changeSetID string) (string, string, []*cloudformation.ResourceChange, error) {
+	input := &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(changeSetID),
+	}
+	var changes []*cloudformation.ResourceChange
+	for {
+		output, err := c.client.DescribeChangeSet(input)
+		if err != nil {
+			return "", "", nil, err
+		}
+		for _, change := range output.Changes {
+			if change.ResourceChange != nil {
+				changes = append(changes, change.ResourceChange)
+			}
+		}
+		if output.NextToken == nil {
+			return aws.StringValue(output.Status), aws.StringValue(output.StatusReason), changes, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+func isNoChangesReason(reason string) bool {
+	for _, noChangesReason := range noChangesReasons {
+		if strings.Contains(reason, noChangesReason) {
+			return true
+		}
+	}
+	return false
+}
+
+// ExecuteChangeSet starts the stack operation of the change set.
+func (c *cloudformationClient) ExecuteChangeSet(changeSetID string) error {
+	_, err := c.client.ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+		ChangeSetName: aws.String(changeSetID),
+	})
+	return err
+}
+
+// DeleteChangeSet deletes a change set which was not executed.
+func (c *cloudformationClient) DeleteChangeSet(changeSetID string) error {
+	_, err := c.client.DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+		ChangeSetName: aws.String(changeSetID),
+	})
+	return err
+}
+
+// GetTemplate returns the current template of the stack.
+func (c *cloudformationClient) GetTemplate(stackName string) (string, error) {
+	output, err := c.client.GetTemplate(&cloudformation.GetTemplateInput{
+		StackName:     aws.String(stackName),
+		TemplateStage: aws.String(cloudformation.TemplateStageOriginal),
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(output.TemplateBody), nil
+}
+
+// ReviewChangeSet prints a summary of the change set and returns whether to
+// execute it: never for dry runs or change sets without changes, and without
+// asking if Yes is set. Otherwise the user is asked to confirm the changes,
+// and an error is returned if they do not. Change sets which are not executed
+// are deleted.
+func ReviewChangeSet(client CloudformationClient, changeSet *ChangeSet, options PreviewOptions) (bool, error) {
+	in, out := options.In, options.Out
+	if in == nil {
+		in = os.Stdin
+	}
+	if out == nil {
+		out = os.Stdout
+	}
+
+	PrintChangeSet(out, changeSet)
+
+	execute := false
+	switch {
+	case len(changeSet.Changes) == 0:
+	case options.DryRun:
+		fmt.Fprintln(out, "Dry run: the change set was not executed.")
+	case options.Yes:
+		execute = true
+	default:
+		fmt.Fprintln(out, "Do you want to apply these changes? [y/N]")
+		input, err := bufio.NewReader(in).ReadString('\n')
+		if err != nil && err != io.EOF {
+			return false, fmt.Errorf("Error reading input: %s", err.Error())
+		}
+		answer := strings.ToLower(strings.TrimSpace(input))
+		if answer != "yes" && answer != "y" {
+			if err := discardChangeSet(client, changeSet); err != nil {
+				return false, err
+			}
+			return false, fmt.Errorf("Aborted. The changes to stack '%s' were not applied", changeSet.StackName)
+		}
+		execute = true
+	}
+	if execute {
+		return true, nil
+	}
+	return false, discardChangeSet(client, changeSet)
+}
+
+// discardChangeSet deletes a change set which is not executed. CREATE change
+// sets are deleted with their stack, which has no resources yet.
+func discardChangeSet(client CloudformationClient, changeSet *ChangeSet) error {
+	if changeSet.Type == cloudformation.ChangeSetTypeCreate {
+		return client.DeleteStack(changeSet.StackName)
+	}
+	return client.DeleteChangeSet(changeSet.ID)
+}
+
+// PrintChangeSet writes a table of the resource changes of the change set,
+// followed by warnings about the replacement or removal of the resources
+// which recreate all the container instances of a cluster.
+func PrintChangeSet(out io.Writer, changeSet *ChangeSet) {
+	if len(changeSet.Changes) == 0 {
+		fmt.Fprintf(out, "No changes to stack %s.\n", changeSet.StackName)
+		return
+	}
+
+	fmt.Fprintf(out, "Changes to stack %s:\n", changeSet.StackName)
+	w := tabwriter.NewWriter(out, 10, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "  ACTION\tLOGICAL ID\tTYPE\tREPLACEMENT")
+	counts := make(map[string]int)
+	var warnings []string
+	for _, change := range changeSet.Changes {
+		action := aws.StringValue(change.Action)
+		replacement := aws.StringValue(change.Replacement)
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", action, aws.StringValue(change.LogicalResourceId),
+			aws.StringValue(change.ResourceType), replacement)
+
+		if action == cloudformation.ChangeActionModify && replacement == cloudformation.ReplacementTrue {
+			counts["replace"]++
+		} else {
+			counts[action]++
+		}
+
+		description, ok := disruptiveResourceTypes[aws.StringValue(change.ResourceType)]
+		if !ok {
+			continue
+		}
+		switch {
+		case action == cloudformation.ChangeActionRemove:
+			warnings = append(warnings, fmt.Sprintf("WARNING: The %s %s will be removed.", description, aws.StringValue(change.LogicalResourceId)))
+		case action == cloudformation.ChangeActionModify && replacement == cloudformation.ReplacementTrue:
+			warnings = append(warnings, fmt.Sprintf("WARNING: The %s %s will be replaced, which recreates all your container instances.", description, aws.StringValue(change.LogicalResourceId)))
+		case action == cloudformation.ChangeActionModify && replacement == cloudformation.ReplacementConditional:
+			warnings = append(warnings, fmt.Sprintf("WARNING: The %s %s may be replaced, which would recreate all your container instances.", description, aws.StringValue(change.LogicalResourceId)))
+		}
+	}
+	w.Flush()
+
+	fmt.Fprintf(out, "%d to add, %d to modify, %d to replace, %d to remove.\n", counts[cloudformation.ChangeActionAdd],
+		counts[cloudformation.ChangeActionModify], counts["replace"], counts[cloudformation.ChangeActionRemove])
+	for _, warning := range warnings {
+		fmt.Fprintln(out, warning)
+	}
+}
+
+// renderedStack is a stack template with the values of its parameters.
+type renderedStack struct {
+	StackName  string            `json:"StackName"`
+	Parameters map[string]string `json:"Parameters"`
+	Template   json.RawMessage   `json:"Template"`
+}
+
+// RenderStack writes the template of a stack and the values of its parameters
+// to a file, for offline review. Parameters which keep their previous value
+// take it from existingParams.
+func RenderStack(path, stackName, template string, params *CfnStackParams, existingParams []*cloudformation.Parameter) error {
+	previousValues := make(map[string]string)
+	for _, param := range existingParams {
+		previousValues[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+
+	rendered := renderedStack{
+		StackName:  stackName,
+		Parameters: make(map[string]string),
+		Template:   json.RawMessage(template),
+	}
+	for _, param := range params.Get() {
+		key := aws.StringValue(param.ParameterKey)
+		if aws.BoolValue(param.UsePreviousValue) {
+			rendered.Parameters[key] = previousValues[key]
+		} else {
+			rendered.Parameters[key] = aws.StringValue(param.ParameterValue)
+		}
+	}
+	// templates which are not JSON, such as YAML ones, are kept as a string
+	if !json.Valid([]byte(template)) {
+		quoted, err := json.Marshal(template)
+		if err != nil {
+			return err
+		}
+		rendered.Template = quoted
+	}
+
+	data, err := json.MarshalIndent(rendered, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path, append(data, '\n'), renderedStackFileMode); err != nil {
+		return err
+	}
+	log.Infof("Wrote the template and parameters of stack %s to %s", stackName, path)
+	return nil
+}
@@ -0,0 +1,329 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cloudformation
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	changeSetStackName = "myStack"
+	changeSetID        = "arn:aws:cloudformation:us-west-2:123456789012:changeSet/ecs-cli-1/abc"
+)
+
+func resourceChange(action, logicalID, resourceType, replacement string) *cloudformation.ResourceChange {
+	change := &cloudformation.ResourceChange{
+		Action:            aws.String(action),
+		LogicalResourceId: aws.String(logicalID),
+		ResourceType:      aws.String(resourceType),
+	}
+	if replacement != "" {
+		change.Replacement = aws.String(replacement)
+	}
+	return change
+}
+
+func describeChangeSetOutput(status, reason string, changes ...*cloudformation.ResourceChange) *cloudformation.DescribeChangeSetOutput {
+	output := &cloudformation.DescribeChangeSetOutput{
+		Status:       aws.String(status),
+		StatusReason: aws.String(reason),
+	}
+	for _, change := range changes {
+		output.Changes = append(output.Changes, &cloudformation.Change{ResourceChange: change})
+	}
+	return output
+}
+
+func TestCreateChangeSet(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	params := NewCfnStackParams(nil)
+	params.Add("size", "2")
+	asgChange := resourceChange(cloudformation.ChangeActionModify, "EcsInstanceAsg", "AWS::AutoScaling::AutoScalingGroup", cloudformation.ReplacementFalse)
+	lcChange := resourceChange(cloudformation.ChangeActionModify, "EcsInstanceLc", "AWS::AutoScaling::LaunchConfiguration", cloudformation.ReplacementTrue)
+
+	pagedOutput := describeChangeSetOutput(cloudformation.ChangeSetStatusCreateComplete, "", asgChange)
+	pagedOutput.NextToken = aws.String("token")
+
+	gomock.InOrder(
+		mockCfn.EXPECT().CreateChangeSet(gomock.Any()).Do(func(x interface{}) {
+			input := x.(*cloudformation.CreateChangeSetInput)
+			assert.Equal(t, changeSetStackName, aws.StringValue(input.StackName), "Expected stack name to match")
+			assert.Equal(t, cloudformation.ChangeSetTypeUpdate, aws.StringValue(input.ChangeSetType), "Expected change set type to match")
+			assert.True(t, aws.BoolValue(input.UsePreviousTemplate), "Expected the previous template to be used")
+			assert.Nil(t, input.TemplateBody, "Expected no template body")
+			assert.True(t, strings.HasPrefix(aws.StringValue(input.ChangeSetName), "ecs-cli-"), "Expected change set name to match")
+			assert.Len(t, input.Parameters, 1, "Expected parameters to be passed")
+		}).Return(&cloudformation.CreateChangeSetOutput{Id: aws.String(changeSetID)}, nil),
+		mockCfn.EXPECT().DescribeChangeSet(gomock.Any()).Return(describeChangeSetOutput(cloudformation.ChangeSetStatusCreatePending, ""), nil),
+		mockCfn.EXPECT().DescribeChangeSet(gomock.Any()).Return(pagedOutput, nil),
+		mockCfn.EXPECT().DescribeChangeSet(gomock.Any()).Do(func(x interface{}) {
+			input := x.(*cloudformation.DescribeChangeSetInput)
+			assert.Equal(t, "token", aws.StringValue(input.NextToken), "Expected next page to be requested")
+		}).Return(describeChangeSetOutput(cloudformation.ChangeSetStatusCreateComplete, "", lcChange), nil),
+	)
+
+	changeSet, err := cfnClient.CreateChangeSet("", changeSetStackName, params, nil, cloudformation.ChangeSetTypeUpdate)
+	assert.NoError(t, err, "Unexpected error creating change set")
+	assert.Equal(t, changeSetID, changeSet.ID, "Expected change set ID to match")
+	assert.Equal(t, cloudformation.ChangeSetTypeUpdate, changeSet.Type, "Expected change set type to match")
+	assert.Equal(t, []*cloudformation.ResourceChange{asgChange, lcChange}, changeSet.Changes, "Expected changes of all pages")
+}
+
+func TestCreateChangeSetWithTemplate(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockCfn.EXPECT().CreateChangeSet(gomock.Any()).Do(func(x interface{}) {
+			input := x.(*cloudformation.CreateChangeSetInput)
+			assert.Equal(t, "template", aws.StringValue(input.TemplateBody), "Expected template body to match")
+			assert.Nil(t, input.UsePreviousTemplate, "Expected the previous template not to be used")
+			assert.Equal(t, cloudformation.ChangeSetTypeCreate, aws.StringValue(input.ChangeSetType), "Expected change set type to match")
+		}).Return(&cloudformation.CreateChangeSetOutput{Id: aws.String(changeSetID)}, nil),
+		mockCfn.EXPECT().DescribeChangeSet(gomock.Any()).Return(describeChangeSetOutput(cloudformation.ChangeSetStatusCreateComplete, "",
+			resourceChange(cloudformation.ChangeActionAdd, "Vpc", "AWS::EC2::VPC", "")), nil),
+	)
+
+	changeSet, err := cfnClient.CreateChangeSet("template", changeSetStackName, NewCfnStackParams(nil), nil, cloudformation.ChangeSetTypeCreate)
+	assert.NoError(t, err, "Unexpected error creating change set")
+	assert.Len(t, changeSet.Changes, 1, "Expected change set to have changes")
+}
+
+func TestCreateChangeSetWithoutChanges(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockCfn.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{Id: aws.String(changeSetID)}, nil),
+		mockCfn.EXPECT().DescribeChangeSet(gomock.Any()).Return(describeChangeSetOutput(cloudformation.ChangeSetStatusFailed,
+			"The submitted information didn't contain changes. Submit different information to create a change set."), nil),
+	)
+
+	changeSet, err := cfnClient.CreateChangeSet("", changeSetStackName, NewCfnStackParams(nil), nil, cloudformation.ChangeSetTypeUpdate)
+	assert.NoError(t, err, "Unexpected error creating change set without changes")
+	assert.Empty(t, changeSet.Changes, "Expected no changes")
+}
+
+func TestCreateChangeSetErrorCases(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockCfn.EXPECT().CreateChangeSet(gomock.Any()).Return(nil, errors.New("something failed"))
+	_, err := cfnClient.CreateChangeSet("", changeSetStackName, NewCfnStackParams(nil), nil, cloudformation.ChangeSetTypeUpdate)
+	assert.Error(t, err, "Expected error when CreateChangeSet fails")
+
+	gomock.InOrder(
+		mockCfn.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{Id: aws.String(changeSetID)}, nil),
+		mockCfn.EXPECT().DescribeChangeSet(gomock.Any()).Return(describeChangeSetOutput(cloudformation.ChangeSetStatusFailed, "Template format error"), nil),
+	)
+	_, err = cfnClient.CreateChangeSet("", changeSetStackName, NewCfnStackParams(nil), nil, cloudformation.ChangeSetTypeUpdate)
+	assert.Error(t, err, "Expected error when the change set fails")
+	assert.Contains(t, err.Error(), "Template format error", "Expected error to contain the status reason")
+
+	gomock.InOrder(
+		mockCfn.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{Id: aws.String(changeSetID)}, nil),
+		mockCfn.EXPECT().DescribeChangeSet(gomock.Any()).Return(nil, errors.New("something failed")),
+	)
+	_, err = cfnClient.CreateChangeSet("", changeSetStackName, NewCfnStackParams(nil), nil, cloudformation.ChangeSetTypeUpdate)
+	assert.Error(t, err, "Expected error when DescribeChangeSet fails")
+}
+
+func TestCreateChangeSetTimeout(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockCfn.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{Id: aws.String(changeSetID)}, nil)
+	mockCfn.EXPECT().DescribeChangeSet(gomock.Any()).Return(describeChangeSetOutput(cloudformation.ChangeSetStatusCreateInProgress, ""), nil).Times(maxRetriesChangeSet)
+
+	_, err := cfnClient.CreateChangeSet("", changeSetStackName, NewCfnStackParams(nil), nil, cloudformation.ChangeSetTypeUpdate)
+	assert.Error(t, err, "Expected timeout waiting for the change set")
+}
+
+func TestReviewChangeSet(t *testing.T) {
+	changes := []*cloudformation.ResourceChange{
+		resourceChange(cloudformation.ChangeActionAdd, "EcsInstanceLc2", "AWS::AutoScaling::LaunchConfiguration", ""),
+	}
+
+	testCases := map[string]struct {
+		changeSet       *ChangeSet
+		options         PreviewOptions
+		expectedExecute bool
+		expectedError   bool
+		expectDelete    bool
+		expectDelStack  bool
+	}{
+		"dry run": {
+			changeSet:    &ChangeSet{ID: changeSetID, StackName: changeSetStackName, Type: cloudformation.ChangeSetTypeUpdate, Changes: changes},
+			options:      PreviewOptions{DryRun: true},
+			expectDelete: true,
+		},
+		"dry run of a new stack": {
+			changeSet:      &ChangeSet{ID: changeSetID, StackName: changeSetStackName, Type: cloudformation.ChangeSetTypeCreate, Changes: changes},
+			options:        PreviewOptions{DryRun: true},
+			expectDelStack: true,
+		},
+		"no changes": {
+			changeSet:    &ChangeSet{ID: changeSetID, StackName: changeSetStackName, Type: cloudformation.ChangeSetTypeUpdate},
+			options:      PreviewOptions{Yes: true},
+			expectDelete: true,
+		},
+		"yes": {
+			changeSet:       &ChangeSet{ID: changeSetID, StackName: changeSetStackName, Type: cloudformation.ChangeSetTypeUpdate, Changes: changes},
+			options:         PreviewOptions{Yes: true},
+			expectedExecute: true,
+		},
+		"confirmed": {
+			changeSet:       &ChangeSet{ID: changeSetID, StackName: changeSetStackName, Type: cloudformation.ChangeSetTypeUpdate, Changes: changes},
+			options:         PreviewOptions{In: strings.NewReader("y\n")},
+			expectedExecute: true,
+		},
+		"declined": {
+			changeSet:     &ChangeSet{ID: changeSetID, StackName: changeSetStackName, Type: cloudformation.ChangeSetTypeUpdate, Changes: changes},
+			options:       PreviewOptions{In: strings.NewReader("n\n")},
+			expectedError: true,
+			expectDelete:  true,
+		},
+		"no answer": {
+			changeSet:     &ChangeSet{ID: changeSetID, StackName: changeSetStackName, Type: cloudformation.ChangeSetTypeUpdate, Changes: changes},
+			options:       PreviewOptions{In: strings.NewReader("")},
+			expectedError: true,
+			expectDelete:  true,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockCfn, cfnClient, ctrl := setupTestController(t)
+			defer ctrl.Finish()
+
+			if test.expectDelete {
+				mockCfn.EXPECT().DeleteChangeSet(gomock.Any()).Do(func(x interface{}) {
+					input := x.(*cloudformation.DeleteChangeSetInput)
+					assert.Equal(t, changeSetID, aws.StringValue(input.ChangeSetName), "Expected change set ID to match")
+				}).Return(&cloudformation.DeleteChangeSetOutput{}, nil)
+			}
+			if test.expectDelStack {
+				mockCfn.EXPECT().DeleteStack(gomock.Any()).Do(func(x interface{}) {
+					input := x.(*cloudformation.DeleteStackInput)
+					assert.Equal(t, changeSetStackName, aws.StringValue(input.StackName), "Expected stack name to match")
+				}).Return(&cloudformation.DeleteStackOutput{}, nil)
+			}
+
+			out := &bytes.Buffer{}
+			test.options.Out = out
+			execute, err := ReviewChangeSet(cfnClient, test.changeSet, test.options)
+			if test.expectedError {
+				assert.Error(t, err, "Expected error reviewing change set")
+			} else {
+				assert.NoError(t, err, "Unexpected error reviewing change set")
+			}
+			assert.Equal(t, test.expectedExecute, execute, "Expected execute to match")
+		})
+	}
+}
+
+func TestPrintChangeSet(t *testing.T) {
+	changeSet := &ChangeSet{
+		StackName: changeSetStackName,
+		Changes: []*cloudformation.ResourceChange{
+			resourceChange(cloudformation.ChangeActionAdd, "EcsInstanceLc2", "AWS::AutoScaling::LaunchConfiguration", ""),
+			resourceChange(cloudformation.ChangeActionModify, "EcsInstanceAsg", "AWS::AutoScaling::AutoScalingGroup", cloudformation.ReplacementTrue),
+			resourceChange(cloudformation.ChangeActionModify, "Vpc", "AWS::EC2::VPC", cloudformation.ReplacementConditional),
+			resourceChange(cloudformation.ChangeActionModify, "EcsSecurityGroup", "AWS::EC2::SecurityGroup", cloudformation.ReplacementFalse),
+			resourceChange(cloudformation.ChangeActionRemove, "EcsInstanceLc", "AWS::AutoScaling::LaunchConfiguration", ""),
+		},
+	}
+
+	out := &bytes.Buffer{}
+	PrintChangeSet(out, changeSet)
+	output := out.String()
+
+	assert.Contains(t, output, "Changes to stack myStack:")
+	assert.Contains(t, output, "EcsInstanceLc2")
+	assert.Contains(t, output, "1 to add, 2 to modify, 1 to replace, 1 to remove.")
+	assert.Contains(t, output, "WARNING: The Auto Scaling group EcsInstanceAsg will be replaced")
+	assert.Contains(t, output, "WARNING: The VPC Vpc may be replaced")
+	assert.NotContains(t, output, "EcsSecurityGroup will", "Expected no warning for security groups")
+	assert.NotContains(t, output, "EcsInstanceLc will", "Expected no warning for launch configurations")
+}
+
+func TestPrintChangeSetWithoutChanges(t *testing.T) {
+	out := &bytes.Buffer{}
+	PrintChangeSet(out, &ChangeSet{StackName: changeSetStackName})
+	assert.Equal(t, "No changes to stack myStack.\n", out.String())
+}
+
+func TestRenderStack(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "render")
+	assert.NoError(t, err, "Unexpected error creating temp directory")
+	defer os.RemoveAll(tempDir)
+
+	params := NewCfnStackParams(nil)
+	params.Add("AsgMaxSize", "4")
+	params.AddWithUsePreviousValue("VpcId", true)
+	existingParams := []*cloudformation.Parameter{
+		{ParameterKey: aws.String("VpcId"), ParameterValue: aws.String("vpc-feedface")},
+		{ParameterKey: aws.String("AsgMaxSize"), ParameterValue: aws.String("2")},
+	}
+
+	testCases := map[string]struct {
+		template         string
+		expectedTemplate interface{}
+	}{
+		"JSON template": {
+			template:         `{"Resources": {}}`,
+			expectedTemplate: map[string]interface{}{"Resources": map[string]interface{}{}},
+		},
+		"YAML template": {
+			template:         "Resources: {}\n",
+			expectedTemplate: "Resources: {}\n",
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(tempDir, "stack.json")
+			err := RenderStack(path, changeSetStackName, test.template, params, existingParams)
+			assert.NoError(t, err, "Unexpected error rendering stack")
+
+			data, err := ioutil.ReadFile(path)
+			assert.NoError(t, err, "Unexpected error reading rendered stack")
+			rendered := struct {
+				StackName  string
+				Parameters map[string]string
+				Template   interface{}
+			}{}
+			err = json.Unmarshal(data, &rendered)
+			assert.NoError(t, err, "Expected rendered stack to be JSON")
+
+			assert.Equal(t, changeSetStackName, rendered.StackName, "Expected stack name to match")
+			assert.Equal(t, map[string]string{"AsgMaxSize": "4", "VpcId": "vpc-feedface"}, rendered.Parameters, "Expected parameters to match")
+			assert.Equal(t, test.expectedTemplate, rendered.Template, "Expected template to match")
+		})
+	}
+}
@@ -15,6 +15,8 @@ package cloudformation
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"strings"
 	"time"
 
@@ -94,10 +96,16 @@ type CloudformationClient interface {
 	DescribeStacks(string) (*cloudformation.DescribeStacksOutput, error)
 	WaitUntilDeleteComplete(string) error
 	UpdateStack(string, *CfnStackParams) (string, error)
+	UpdateStackTemplate(string, string, *CfnStackParams) (string, error)
 	WaitUntilUpdateComplete(string) error
 	ValidateStackExists(string) error
 	DescribeNetworkResources(string) error
 	GetStackParameters(string) ([]*cloudformation.Parameter, error)
+	DescribeStackResource(string, string) (*cloudformation.StackResource, error)
+	CreateChangeSet(string, string, *CfnStackParams, []*cloudformation.Tag, string) (*ChangeSet, error)
+	ExecuteChangeSet(string) error
+	DeleteChangeSet(string) error
+	GetTemplate(string) (string, error)
 }
 
 // cloudformationClient implements CloudFormationClient.
@@ -105,6 +113,8 @@ type cloudformationClient struct {
 	client  cloudformationiface.CloudFormationAPI
 	config  *config.CommandConfig
 	sleeper utils.Sleeper
+	// out is where stack events are streamed while waiting for stack operations
+	out io.Writer
 }
 
 // NewCloudformationClient creates an instance of cloudFormationClient object.
@@ -120,6 +130,7 @@ func newClient(config *config.CommandConfig, client cloudformationiface.CloudFor
 		config:  config,
 		client:  client,
 		sleeper: &utils.TimeSleeper{},
+		out:     os.Stdout,
 	}
 }
 
@@ -179,6 +190,23 @@ func (c *cloudformationClient) UpdateStack(stackName string, params *CfnStackPar
 	return aws.StringValue(output.StackId), nil
 }
 
+// UpdateStackTemplate replaces the template of the cloudformation stack by invoking the sdk's UpdateStack API.
+func (c *cloudformationClient) UpdateStackTemplate(template, stackName string, params *CfnStackParams) (string, error) {
+	output, err := c.client.UpdateStack(&cloudformation.UpdateStackInput{
+		Capabilities: aws.StringSlice([]string{cloudformation.CapabilityCapabilityIam}),
+		StackName:    aws.String(stackName),
+		Parameters:   params.Get(),
+		TemplateBody: aws.String(template),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	log.WithFields(log.Fields{"stackId": output.StackId}).Debug("Cloudformation update stack template call succeeded")
+	return aws.StringValue(output.StackId), nil
+}
+
 // ValidateStackExists validates if a stack exists with the specified name.
 func (c *cloudformationClient) ValidateStackExists(stackName string) error {
 	_, err := c.describeStackStatus(stackName)
@@ -233,14 +261,21 @@ func (c *cloudformationClient) WaitUntilUpdateComplete(stackName string) error {
 type failureInStackEvent func(*cloudformation.StackEvent) bool
 
 // waitUntilComplete waits until the function callback indicates completeness or until maxRetries are exhausted.
+// The events of the stack are printed as they happen, followed by a summary of the changed resources.
 func (c *cloudformationClient) waitUntilComplete(stackName string, hasFailed failureInStackEvent, successState string, failureStates map[string]bool, maxRetries int) error {
+	events := newStackEventStream(stackName, c.out)
+	defer events.printSummary()
+
 	for retryCount := 0; retryCount < maxRetries; retryCount++ {
-		event, err := c.latestStackEvent(stackName)
+		event, err := events.poll(c.client)
 		if err != nil {
 			return err
 		}
 		if failed := hasFailed(event); failed {
 			reason := aws.StringValue(event.ResourceStatusReason)
+			if rootCause := events.rootCause(c.client); rootCause != nil {
+				reason = describeEvent(rootCause)
+			}
 			return fmt.Errorf("Cloudformation failure waiting for '%s'. Reason: '%s'", successState, reason)
 		}
 
@@ -249,6 +284,7 @@ func (c *cloudformationClient) waitUntilComplete(stackName string, hasFailed fai
 		if err != nil {
 			return err
 		}
+		events.status = status
 
 		if successState == status {
 			return nil
@@ -256,75 +292,20 @@ func (c *cloudformationClient) waitUntilComplete(stackName string, hasFailed fai
 
 		_, exists := failureStates[status]
 		if exists {
-			log.Debug("Stack creation failed. Getting first failed event")
-			if failureEvent, err := c.firstStackEventWithFailure(stackName, nil, failureStates); err == nil {
-				log.WithFields(log.Fields{
-					"reason":       aws.StringValue(failureEvent.ResourceStatusReason),
-					"resourceType": aws.StringValue(failureEvent.ResourceType),
-				}).Error("Failure event")
+			log.Debug("Stack operation failed. Getting the root cause event")
+			if rootCause := events.rootCause(c.client); rootCause != nil {
+				return fmt.Errorf("Cloudformation failure waiting for '%s'. State is '%s'. Root cause: '%s'", successState, status, describeEvent(rootCause))
 			}
 			return fmt.Errorf("Cloudformation failure waiting for '%s'. State is '%s'", successState, status)
 		}
 
-		if retryCount%2 == 0 {
-			log.WithFields(log.Fields{"stackStatus": status}).Info("Cloudformation stack status")
-		} else {
-			log.WithFields(log.Fields{"stackStatus": status}).Debug("Cloudformation stack status")
-		}
+		log.WithFields(log.Fields{"stackStatus": status}).Debug("Cloudformation stack status")
 		c.sleeper.Sleep(delayWait)
 	}
 
 	return fmt.Errorf("Timeout waiting for stack operation to complete")
 }
 
-// latestStackEvent describes stack events and gets the latest event.
-func (c *cloudformationClient) latestStackEvent(stackName string) (*cloudformation.StackEvent, error) {
-	response, err := c.client.DescribeStackEvents(&cloudformation.DescribeStackEventsInput{StackName: aws.String(stackName)})
-	if err != nil {
-		return nil, err
-	}
-
-	if len(response.StackEvents) == 0 {
-		return nil, fmt.Errorf("Could not describe stack events")
-	}
-
-	return response.StackEvents[0], nil
-}
-
-// firstStackEventWithFailure describes stack events and gets the latest event.
-func (c *cloudformationClient) firstStackEventWithFailure(stackName string, nextToken *string, failureStates map[string]bool) (*cloudformation.StackEvent, error) {
-	response, err := c.client.DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
-		StackName: aws.String(stackName),
-		NextToken: nextToken,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	if len(response.StackEvents) == 0 {
-		return nil, fmt.Errorf("Could not describe stack events")
-	}
-
-	if response.NextToken != nil {
-		return c.firstStackEventWithFailure(stackName, response.NextToken, failureStates)
-	}
-
-	for i := len(response.StackEvents) - 1; i >= 0; i-- {
-		event := response.StackEvents[i]
-		log.WithFields(log.Fields{
-			"status":       aws.StringValue(event.ResourceStatus),
-			"reason":       aws.StringValue(event.ResourceStatusReason),
-			"id":           aws.StringValue(event.EventId),
-			"resourceType": aws.StringValue(event.ResourceType),
-		}).Debug("Parsing event")
-		if _, exists := failureStates[aws.StringValue(event.ResourceStatus)]; exists {
-			return event, nil
-		}
-	}
-
-	return nil, fmt.Errorf("Unable to find failure event in stack '%s'", stackName)
-}
-
 // describeStackStatus describes the stack and gets the stack status.
 func (c *cloudformationClient) describeStackStatus(stackName string) (string, error) {
 	output, err := c.DescribeStacks(stackName)
@@ -340,7 +321,8 @@ func (c *cloudformationClient) describeStackStatus(stackName string) (string, er
 	return aws.StringValue(output.Stacks[0].StackStatus), nil
 }
 
-func (c *cloudformationClient) describeStackResource(stackName string, logicalResourceId string) (*cloudformation.StackResource, error) {
+// DescribeStackResource describes the resource of the stack with the given logical id. It returns nil if the stack has no such resource.
+func (c *cloudformationClient) DescribeStackResource(stackName string, logicalResourceId string) (*cloudformation.StackResource, error) {
 	input := &cloudformation.DescribeStackResourcesInput{
 		StackName:         aws.String(stackName),
 		LogicalResourceId: aws.String(logicalResourceId),
@@ -369,14 +351,14 @@ func displayResourceId(resource *cloudformation.StackResource, name string) {
 
 func (c *cloudformationClient) DescribeNetworkResources(stackName string) error {
 	// Describe EC2::VPC
-	resource, err := c.describeStackResource(stackName, VPCLogicalResourceId)
+	resource, err := c.DescribeStackResource(stackName, VPCLogicalResourceId)
 	if err != nil {
 		return err
 	}
 	displayResourceId(resource, "VPC")
 
 	// Describe EC2::SecurityGroup
-	resource, err = c.describeStackResource(stackName, SecurityGroupLogicalResourceId)
+	resource, err = c.DescribeStackResource(stackName, SecurityGroupLogicalResourceId)
 	if err != nil {
 		return err
 	}
@@ -385,13 +367,23 @@ func (c *cloudformationClient) DescribeNetworkResources(stackName string) error
 	// Describe EC2::Subnets
 	subnets := []string{Subnet1LogicalResourceId, Subnet2LogicalResourceId}
 	for _, id := range subnets {
-		resource, err = c.describeStackResource(stackName, id)
+		resource, err = c.DescribeStackResource(stackName, id)
 		if err != nil {
 			return err
 		}
 		displayResourceId(resource, "Subnet")
 	}
 
+	// Describe the private EC2::Subnets created with --private-subnets
+	privateSubnets := []string{PrivateSubnet1LogicalResourceId, PrivateSubnet2LogicalResourceId}
+	for _, id := range privateSubnets {
+		resource, err = c.DescribeStackResource(stackName, id)
+		if err != nil {
+			return err
+		}
+		displayResourceId(resource, "Private Subnet")
+	}
+
 	return nil
 }
 
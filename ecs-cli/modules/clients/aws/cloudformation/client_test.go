@@ -15,6 +15,7 @@ package cloudformation
 
 import (
 	"errors"
+	"io/ioutil"
 	"testing"
 	"time"
 
@@ -308,6 +309,8 @@ func TestDescribeNetworkResources(t *testing.T) {
 	mockCfn.EXPECT().DescribeStackResources(gomock.Any()).Return(describeStackResourceOutput(SecurityGroupLogicalResourceId, "sg-c0ffeefe"), nil)
 	mockCfn.EXPECT().DescribeStackResources(gomock.Any()).Return(describeStackResourceOutput(Subnet1LogicalResourceId, "subnet-baff1ed"), nil)
 	mockCfn.EXPECT().DescribeStackResources(gomock.Any()).Return(describeStackResourceOutput(Subnet2LogicalResourceId, "subnet-baff2ed"), nil)
+	mockCfn.EXPECT().DescribeStackResources(gomock.Any()).Return(describeStackResourceOutput(PrivateSubnet1LogicalResourceId, "subnet-deadbee1"), nil)
+	mockCfn.EXPECT().DescribeStackResources(gomock.Any()).Return(describeStackResourceOutput(PrivateSubnet2LogicalResourceId, "subnet-deadbee2"), nil)
 
 	err := cfnClient.DescribeNetworkResources("myStack")
 	if err != nil {
@@ -325,6 +328,7 @@ func setupTestController(t *testing.T) (*mock_cloudformationiface.MockCloudForma
 
 	client := newClient(&config.CommandConfig{Session: mockSession}, mockCfn)
 	client.(*cloudformationClient).sleeper = &noopsleeper{}
+	client.(*cloudformationClient).out = ioutil.Discard
 
 	return mockCfn, client, ctrl
 }
@@ -21,7 +21,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecs"
 )
 
-func GetClusterTemplate(tags []*ecs.Tag, stackName string) (string, error) {
+// GetClusterTemplate returns the template of the cluster stack. The Auto
+// Scaling group launches the given instance types, if any, with a mixed
+// instances policy; otherwise it launches the EcsInstanceType parameter.
+func GetClusterTemplate(tags []*ecs.Tag, stackName string, instanceTypes []string) (string, error) {
 	tagJSON, err := json.Marshal(tags)
 	if err != nil {
 		return "", err
@@ -33,7 +36,18 @@ func GetClusterTemplate(tags []*ecs.Tag, stackName string) (string, error) {
 		return "", err
 	}
 
-	return fmt.Sprintf(clusterTemplate, string(tagJSON), string(asgTagJSON)), nil
+	// CloudFormation can not map the InstanceTypes parameter to the overrides
+	// of the mixed instances policy, so they are part of the template
+	overrides := []launchTemplateOverride{}
+	for _, instanceType := range instanceTypes {
+		overrides = append(overrides, launchTemplateOverride{InstanceType: instanceType})
+	}
+	overridesJSON, err := json.Marshal(overrides)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(clusterTemplate, string(tagJSON), string(asgTagJSON), string(overridesJSON)), nil
 }
 
 // Autoscaling CFN tags have an additional field that determines if they are
@@ -74,6 +88,11 @@ type autoscalingTag struct {
 	PropagateAtLaunch bool
 }
 
+// launchTemplateOverride is an instance type of a mixed instances policy
+type launchTemplateOverride struct {
+	InstanceType string
+}
+
 // TODO: Improvements:
 // 1. Auto detect default vpc
 // 2. Auto detect existing key pairs
@@ -83,11 +102,20 @@ type autoscalingTag struct {
 // These are used to display CFN resources in the CreateCluster callback.
 // TODO: Find better way to use constants in template string itself.
 const (
-	Subnet1LogicalResourceId       = "PubSubnetAz1"
-	Subnet2LogicalResourceId       = "PubSubnetAz2"
-	VPCLogicalResourceId           = "Vpc"
-	SecurityGroupLogicalResourceId = "EcsSecurityGroup"
-	DefaultECSInstanceType         = "t2.micro"
+	Subnet1LogicalResourceId        = "PubSubnetAz1"
+	Subnet2LogicalResourceId        = "PubSubnetAz2"
+	PrivateSubnet1LogicalResourceId = "PrivSubnetAz1"
+	PrivateSubnet2LogicalResourceId = "PrivSubnetAz2"
+	VPCLogicalResourceId            = "Vpc"
+	SecurityGroupLogicalResourceId  = "EcsSecurityGroup"
+	DefaultECSInstanceType          = "t2.micro"
+
+	// LaunchConfigurationLogicalResourceId is the resource which stacks
+	// created before the template moved to a launch template launched
+	// instances with
+	LaunchConfigurationLogicalResourceId = "EcsInstanceLc"
+
+	CapacityProviderLogicalResourceId = "EcsCapacityProvider"
 )
 
 var clusterTemplate = `
@@ -98,7 +126,9 @@ var clusterTemplate = `
     "VpcCidrs": {
       "vpc": {"cidr" : "10.0.0.0/16"},
       "pubsubnet1": {"cidr" : "10.0.0.0/24"},
-      "pubsubnet2": {"cidr" :"10.0.1.0/24"}
+      "pubsubnet2": {"cidr" :"10.0.1.0/24"},
+      "privsubnet1": {"cidr" : "10.0.2.0/24"},
+      "privsubnet2": {"cidr" : "10.0.3.0/24"}
     }
   },
   "Parameters": {
@@ -117,6 +147,30 @@ var clusterTemplate = `
       "Description": "If greater than 0, then a EC2 Spot instance will be requested",
       "Default": "0"
     },
+    "InstanceTypes": {
+      "Type": "CommaDelimitedList",
+      "Description": "Optional - Comma separated list of EC2 instance types for the Auto Scaling group to launch with a mixed instances policy, instead of EcsInstanceType.",
+      "Default": ""
+    },
+    "OnDemandBaseCapacity": {
+      "Type": "Number",
+      "Description": "Optional - Number of On-Demand instances to launch before launching Spot instances. Only used with InstanceTypes.",
+      "Default": "0",
+      "MinValue": "0"
+    },
+    "OnDemandPercentage": {
+      "Type": "Number",
+      "Description": "Optional - Percentage of the instances above OnDemandBaseCapacity to launch On-Demand; the others are Spot instances. Only used with InstanceTypes.",
+      "Default": "100",
+      "MinValue": "0",
+      "MaxValue": "100"
+    },
+    "SpotAllocationStrategy": {
+      "Type": "String",
+      "Description": "Optional - How to allocate Spot instances across InstanceTypes.",
+      "Default": "price-capacity-optimized",
+      "AllowedValues": [ "lowest-price", "capacity-optimized", "capacity-optimized-prioritized", "price-capacity-optimized" ]
+    },
     "KeyName": {
       "Type": "String",
       "Description": "Optional - Name of an existing EC2 KeyPair to enable SSH access to the ECS instances",
@@ -159,6 +213,24 @@ var clusterTemplate = `
       "Description": "Optional - Comma-delimited list of VPC availability zones in which to create subnets.  Required if setting VpcId.",
       "Default": ""
     },
+    "IsPrivateSubnets": {
+      "Type": "String",
+      "Description": "Optional - Whether to also create private subnets in the new VPC, and to launch the ECS instances in them.",
+      "Default": "false",
+      "AllowedValues": [ "true", "false" ]
+    },
+    "NatGateway": {
+      "Type": "String",
+      "Description": "Optional - Whether the private subnets reach the internet through a single NAT gateway, one NAT gateway per availability zone, or none.",
+      "Default": "single",
+      "AllowedValues": [ "single", "per-az", "none" ]
+    },
+    "IsVpcEndpoints": {
+      "Type": "String",
+      "Description": "Optional - Whether to create VPC endpoints for ECR, ECS, CloudWatch Logs, SSM and S3 in the private subnets.",
+      "Default": "false",
+      "AllowedValues": [ "true", "false" ]
+    },
     "AssociatePublicIpAddress": {
       "Type": "String",
       "Description": "Optional - Automatically assign public IP addresses to new instances in this VPC.",
@@ -182,7 +254,81 @@ var clusterTemplate = `
     "IsIMDSv2": {
       "Type": "String",
       "Description": "Optional - Disable IMDSv1.",
+      "Default": "false"
+    },
+    "MetadataHopLimit": {
+      "Type": "Number",
+      "Description": "Optional - Number of network hops instance metadata requests can travel. Containers using the bridge network mode need at least 2 with IMDSv2.",
+      "Default": "1",
+      "MinValue": "1",
+      "MaxValue": "64"
+    },
+    "IsDetailedMonitoring": {
+      "Type": "String",
+      "Description": "Optional - Whether to enable detailed (1-minute) CloudWatch monitoring of the EC2 instances.",
+      "Default": "false",
+      "AllowedValues": [ "true", "false" ]
+    },
+    "RootVolumeSize": {
+      "Type": "Number",
+      "Description": "Optional - Size in GiB of the root EBS volume of the EC2 instances. Defaults to the size of the AMI's snapshot.",
+      "Default": "0",
+      "MinValue": "0"
+    },
+    "RootVolumeType": {
+      "Type": "String",
+      "Description": "Optional - Type of the root EBS volume of the EC2 instances. Defaults to the type of the AMI's block device mapping.",
+      "Default": "",
+      "AllowedValues": [ "", "standard", "gp2", "gp3", "io1", "io2", "st1", "sc1" ]
+    },
+    "IsEncryptedVolume": {
+      "Type": "String",
+      "Description": "Optional - Whether to encrypt the root EBS volume of the EC2 instances with the default EBS key.",
+      "Default": "false",
+      "AllowedValues": [ "true", "false" ]
+    },
+    "RootDeviceName": {
+      "Type": "String",
+      "Description": "Optional - Device name of the EBS volume configured by the root volume parameters. Bottlerocket AMIs store container images on /dev/xvdb rather than on their root volume.",
+      "Default": "/dev/xvda",
+      "AllowedValues": [ "/dev/xvda", "/dev/xvdb" ]
+    },
+    "AmiFamily": {
+      "Type": "String",
+      "Description": "Optional - Family of the recommended ECS optimized AMI of the container instances, which the ecs-cli upgrade command looks up the latest AMI of.",
+      "Default": "al2"
+    },
+    "IsCapacityProvider": {
+      "Type": "String",
+      "Description": "Optional - Whether to scale the EC2 instances with an ECS capacity provider instead of a fixed Desired Capacity.",
       "Default": "false",
+      "AllowedValues": [ "true", "false" ]
+    },
+    "TargetCapacity": {
+      "Type": "Number",
+      "Description": "Optional - Percentage of the capacity provider's EC2 instances which tasks should use.",
+      "Default": "100",
+      "MinValue": "1",
+      "MaxValue": "100"
+    },
+    "MinimumScalingStepSize": {
+      "Type": "Number",
+      "Description": "Optional - Minimum number of EC2 instances the capacity provider launches or terminates at a time.",
+      "Default": "1",
+      "MinValue": "1",
+      "MaxValue": "10000"
+    },
+    "MaximumScalingStepSize": {
+      "Type": "Number",
+      "Description": "Optional - Maximum number of EC2 instances the capacity provider launches or terminates at a time.",
+      "Default": "10000",
+      "MinValue": "1",
+      "MaxValue": "10000"
+    },
+    "FargateCapacityProviders": {
+      "Type": "CommaDelimitedList",
+      "Description": "Optional - FARGATE and/or FARGATE_SPOT, to also attach to the cluster.",
+      "Default": ""
     },
     "UserData" : {
       "Type" : "String",
@@ -211,6 +357,50 @@ var clusterTemplate = `
         ""
       ]
     },
+    "CreatePrivateSubnets": {
+      "Fn::And": [
+        {
+          "Condition": "CreateVpcResources"
+        },
+        {
+          "Fn::Equals": [ { "Ref": "IsPrivateSubnets" }, "true" ]
+        }
+      ]
+    },
+    "CreateNatGateway": {
+      "Fn::And": [
+        {
+          "Condition": "CreatePrivateSubnets"
+        },
+        {
+          "Fn::Not": [
+            {
+              "Fn::Equals": [ { "Ref": "NatGateway" }, "none" ]
+            }
+          ]
+        }
+      ]
+    },
+    "CreateNatGatewayPerAz": {
+      "Fn::And": [
+        {
+          "Condition": "CreatePrivateSubnets"
+        },
+        {
+          "Fn::Equals": [ { "Ref": "NatGateway" }, "per-az" ]
+        }
+      ]
+    },
+    "CreateVpcEndpoints": {
+      "Fn::And": [
+        {
+          "Condition": "CreatePrivateSubnets"
+        },
+        {
+          "Fn::Equals": [ { "Ref": "IsVpcEndpoints" }, "true" ]
+        }
+      ]
+    },
     "CreateSecurityGroup": {
       "Fn::And":[
         {
@@ -231,7 +421,7 @@ var clusterTemplate = `
         }
       ]
     },
-    "CreateEC2LCWithKeyPair": {
+    "CreateEC2LTWithKeyPair": {
       "Fn::And":[
         {
           "Condition": "LaunchInstances"
@@ -282,7 +472,110 @@ var clusterTemplate = `
         }
       ]
     },
-    "UseSpotInstances": {
+    "SetRootVolumeSize": {
+      "Fn::Not": [
+        {
+          "Fn::Equals": [
+            {
+              "Ref": "RootVolumeSize"
+            },
+            0
+          ]
+        }
+      ]
+    },
+    "SetRootVolumeType": {
+      "Fn::Not": [
+        {
+          "Fn::Equals": [
+            {
+              "Ref": "RootVolumeType"
+            },
+            ""
+          ]
+        }
+      ]
+    },
+    "CustomizeRootVolume": {
+      "Fn::Or": [
+        {
+          "Condition": "SetRootVolumeSize"
+        },
+        {
+          "Condition": "SetRootVolumeType"
+        },
+        {
+          "Fn::Equals": [
+            {
+              "Ref": "IsEncryptedVolume"
+            },
+            "true"
+          ]
+        }
+      ]
+    },
+    "UseCapacityProvider": {
+      "Fn::And": [
+        {
+          "Condition": "LaunchInstances"
+        },
+        {
+          "Fn::Equals": [ { "Ref": "IsCapacityProvider" }, "true" ]
+        }
+      ]
+    },
+    "UseFargateCapacityProviders": {
+      "Fn::Not": [
+        {
+          "Fn::Equals": [
+            {
+              "Fn::Join": [
+                "",
+                {
+                  "Ref": "FargateCapacityProviders"
+                }
+              ]
+            },
+            ""
+          ]
+        }
+      ]
+    },
+    "AssociateCapacityProviders": {
+      "Fn::Or": [
+        {
+          "Condition": "UseCapacityProvider"
+        },
+        {
+          "Condition": "UseFargateCapacityProviders"
+        }
+      ]
+    },
+    "UseMixedInstances": {
+      "Fn::And": [
+        {
+          "Condition": "LaunchInstances"
+        },
+        {
+          "Fn::Not": [
+            {
+              "Fn::Equals": [
+                {
+                  "Fn::Join": [
+                    "",
+                    {
+                      "Ref": "InstanceTypes"
+                    }
+                  ]
+                },
+                ""
+              ]
+            }
+          ]
+        }
+      ]
+    },
+    "SetSpotPrice": {
       "Fn::Not": [
       {
         "Fn::Equals": [
@@ -293,6 +586,20 @@ var clusterTemplate = `
         ]
       }
       ]
+    },
+    "UseSpotInstances": {
+      "Fn::And": [
+        {
+          "Condition": "SetSpotPrice"
+        },
+        {
+          "Fn::Not": [
+            {
+              "Condition": "UseMixedInstances"
+            }
+          ]
+        }
+      ]
     }
   },
   "Resources": {
@@ -447,141 +754,648 @@ var clusterTemplate = `
         }
       }
     },
-    "EcsSecurityGroup": {
-      "Condition": "CreateSecurityGroup",
-      "Type": "AWS::EC2::SecurityGroup",
+    "PrivSubnetAz1": {
+      "Condition": "CreatePrivateSubnets",
+      "Type": "AWS::EC2::Subnet",
       "Properties": {
-        "GroupDescription": "ECS Allowed Ports",
-        "Tags": %[1]s,
         "VpcId": {
-          "Fn::If": [
-            "CreateVpcResources",
-            {
-              "Ref": "Vpc"
-            },
-            {
-              "Ref": "VpcId"
-            }
-          ]
+          "Ref": "Vpc"
         },
-        "SecurityGroupIngress" : [ {
-            "IpProtocol" : "tcp",
-            "FromPort" : { "Ref" : "EcsPort" },
-            "ToPort" : { "Ref" : "EcsPort" },
-            "CidrIp" : { "Ref" : "SourceCidr" }
-        } ]
+        "CidrBlock": {
+          "Fn::FindInMap": ["VpcCidrs", "privsubnet1", "cidr"]
+        },
+        "MapPublicIpOnLaunch": false,
+        "Tags": %[1]s,
+        "AvailabilityZone": {
+          "Fn::GetAtt": [ "PubSubnetAz1", "AvailabilityZone" ]
+        }
       }
     },
-    "EcsInstanceRole": {
-      "Condition": "CreateEcsInstanceRole",
-      "Type": "AWS::IAM::Role",
+    "PrivSubnetAz2": {
+      "Condition": "CreatePrivateSubnets",
+      "Type": "AWS::EC2::Subnet",
       "Properties": {
-        "AssumeRolePolicyDocument": {
-          "Version": "2012-10-17",
-          "Statement": [
-            {
-              "Effect": "Allow",
-              "Principal": {
-                "Service": [
-                  "Fn::If": [
-                    "IsCNRegion",
-                    "ec2.amazonaws.com.cn",
-                    "ec2.amazonaws.com"
-                  ]
-                ]
-              },
-              "Action": [
-                "sts:AssumeRole"
-              ]
-            }
-          ]
+        "VpcId": {
+          "Ref": "Vpc"
         },
-        "Path": "/",
-        "ManagedPolicyArns": [
-          "arn:aws:iam::aws:policy/service-role/AmazonEC2ContainerServiceforEC2Role"
-        ]
+        "CidrBlock": {
+          "Fn::FindInMap": ["VpcCidrs", "privsubnet2", "cidr"]
+        },
+        "MapPublicIpOnLaunch": false,
+        "Tags": %[1]s,
+        "AvailabilityZone": {
+          "Fn::GetAtt": [ "PubSubnetAz2", "AvailabilityZone" ]
+        }
       }
     },
-    "EcsInstanceProfile": {
-      "Condition": "LaunchInstances",
-      "Type": "AWS::IAM::InstanceProfile",
+    "NatEipAz1": {
+      "Condition": "CreateNatGateway",
+      "DependsOn": "AttachGateway",
+      "Type": "AWS::EC2::EIP",
       "Properties": {
-        "Path": "/",
-        "Roles": [
-          "Fn::If": [
-            "CreateEcsInstanceRole",
-            {
-              "Ref": "EcsInstanceRole"
-            },
-            {
-              "Ref": "InstanceRole"
-            }
-          ]
-        ]
+        "Domain": "vpc",
+        "Tags": %[1]s
       }
     },
-    "EcsInstanceLc": {
-      "Condition": "LaunchInstances",
-      "Type": "AWS::AutoScaling::LaunchConfiguration",
+    "NatGatewayAz1": {
+      "Condition": "CreateNatGateway",
+      "Type": "AWS::EC2::NatGateway",
       "Properties": {
-        "ImageId": { "Ref" : "EcsAmiId" },
-        "InstanceType": {
-          "Ref": "EcsInstanceType"
+        "AllocationId": {
+          "Fn::GetAtt": [ "NatEipAz1", "AllocationId" ]
         },
-        "SpotPrice": {
-          "Fn::If": [
-            "UseSpotInstances",
-            {
-              "Ref": "SpotPrice"
-            },
-            {
-              "Ref": "AWS::NoValue"
-            }
-          ]
+        "SubnetId": {
+          "Ref": "PubSubnetAz1"
         },
-        "AssociatePublicIpAddress": {
-          "Ref": "AssociatePublicIpAddress"
+        "Tags": %[1]s
+      }
+    },
+    "NatEipAz2": {
+      "Condition": "CreateNatGatewayPerAz",
+      "DependsOn": "AttachGateway",
+      "Type": "AWS::EC2::EIP",
+      "Properties": {
+        "Domain": "vpc",
+        "Tags": %[1]s
+      }
+    },
+    "NatGatewayAz2": {
+      "Condition": "CreateNatGatewayPerAz",
+      "Type": "AWS::EC2::NatGateway",
+      "Properties": {
+        "AllocationId": {
+          "Fn::GetAtt": [ "NatEipAz2", "AllocationId" ]
+        },
+        "SubnetId": {
+          "Ref": "PubSubnetAz2"
+        },
+        "Tags": %[1]s
+      }
+    },
+    "PrivateRouteTableAz1": {
+      "Condition": "CreatePrivateSubnets",
+      "Type": "AWS::EC2::RouteTable",
+      "Properties": {
+        "VpcId": {
+          "Ref": "Vpc"
+        },
+        "Tags": %[1]s
+      }
+    },
+    "PrivateRouteViaNatAz1": {
+      "Condition": "CreateNatGateway",
+      "Type": "AWS::EC2::Route",
+      "Properties": {
+        "RouteTableId": {
+          "Ref": "PrivateRouteTableAz1"
+        },
+        "DestinationCidrBlock": "0.0.0.0/0",
+        "NatGatewayId": {
+          "Ref": "NatGatewayAz1"
+        }
+      }
+    },
+    "PrivSubnet1RouteTableAssociation": {
+      "Condition": "CreatePrivateSubnets",
+      "Type": "AWS::EC2::SubnetRouteTableAssociation",
+      "Properties": {
+        "SubnetId": {
+          "Ref": "PrivSubnetAz1"
         },
-        "IamInstanceProfile": {
-          "Ref": "EcsInstanceProfile"
+        "RouteTableId": {
+          "Ref": "PrivateRouteTableAz1"
+        }
+      }
+    },
+    "PrivateRouteTableAz2": {
+      "Condition": "CreatePrivateSubnets",
+      "Type": "AWS::EC2::RouteTable",
+      "Properties": {
+        "VpcId": {
+          "Ref": "Vpc"
+        },
+        "Tags": %[1]s
+      }
+    },
+    "PrivateRouteViaNatAz2": {
+      "Condition": "CreateNatGateway",
+      "Type": "AWS::EC2::Route",
+      "Properties": {
+        "RouteTableId": {
+          "Ref": "PrivateRouteTableAz2"
         },
-        "KeyName": {
+        "DestinationCidrBlock": "0.0.0.0/0",
+        "NatGatewayId": {
           "Fn::If": [
-            "CreateEC2LCWithKeyPair",
+            "CreateNatGatewayPerAz",
             {
-              "Ref": "KeyName"
+              "Ref": "NatGatewayAz2"
             },
             {
-              "Ref": "AWS::NoValue"
+              "Ref": "NatGatewayAz1"
             }
           ]
+        }
+      }
+    },
+    "PrivSubnet2RouteTableAssociation": {
+      "Condition": "CreatePrivateSubnets",
+      "Type": "AWS::EC2::SubnetRouteTableAssociation",
+      "Properties": {
+        "SubnetId": {
+          "Ref": "PrivSubnetAz2"
+        },
+        "RouteTableId": {
+          "Ref": "PrivateRouteTableAz2"
+        }
+      }
+    },
+    "VpcEndpointSecurityGroup": {
+      "Condition": "CreateVpcEndpoints",
+      "Type": "AWS::EC2::SecurityGroup",
+      "Properties": {
+        "GroupDescription": "HTTPS from the VPC to its endpoints",
+        "Tags": %[1]s,
+        "VpcId": {
+          "Ref": "Vpc"
+        },
+        "SecurityGroupIngress" : [ {
+            "IpProtocol" : "tcp",
+            "FromPort" : 443,
+            "ToPort" : 443,
+            "CidrIp" : { "Fn::FindInMap": ["VpcCidrs", "vpc", "cidr"] }
+        } ]
+      }
+    },
+    "EcrApiVpcEndpoint": {
+      "Condition": "CreateVpcEndpoints",
+      "Type": "AWS::EC2::VPCEndpoint",
+      "Properties": {
+        "VpcId": {
+          "Ref": "Vpc"
+        },
+        "ServiceName": {
+          "Fn::Sub": "com.amazonaws.${AWS::Region}.ecr.api"
         },
-        "MetadataOptions": {
+        "VpcEndpointType": "Interface",
+        "PrivateDnsEnabled": true,
+        "SubnetIds": [
+          {
+            "Ref": "PrivSubnetAz1"
+          },
+          {
+            "Ref": "PrivSubnetAz2"
+          }
+        ],
+        "SecurityGroupIds": [
+          {
+            "Ref": "VpcEndpointSecurityGroup"
+          }
+        ]
+      }
+    },
+    "EcrDkrVpcEndpoint": {
+      "Condition": "CreateVpcEndpoints",
+      "Type": "AWS::EC2::VPCEndpoint",
+      "Properties": {
+        "VpcId": {
+          "Ref": "Vpc"
+        },
+        "ServiceName": {
+          "Fn::Sub": "com.amazonaws.${AWS::Region}.ecr.dkr"
+        },
+        "VpcEndpointType": "Interface",
+        "PrivateDnsEnabled": true,
+        "SubnetIds": [
+          {
+            "Ref": "PrivSubnetAz1"
+          },
+          {
+            "Ref": "PrivSubnetAz2"
+          }
+        ],
+        "SecurityGroupIds": [
+          {
+            "Ref": "VpcEndpointSecurityGroup"
+          }
+        ]
+      }
+    },
+    "EcsVpcEndpoint": {
+      "Condition": "CreateVpcEndpoints",
+      "Type": "AWS::EC2::VPCEndpoint",
+      "Properties": {
+        "VpcId": {
+          "Ref": "Vpc"
+        },
+        "ServiceName": {
+          "Fn::Sub": "com.amazonaws.${AWS::Region}.ecs"
+        },
+        "VpcEndpointType": "Interface",
+        "PrivateDnsEnabled": true,
+        "SubnetIds": [
+          {
+            "Ref": "PrivSubnetAz1"
+          },
+          {
+            "Ref": "PrivSubnetAz2"
+          }
+        ],
+        "SecurityGroupIds": [
+          {
+            "Ref": "VpcEndpointSecurityGroup"
+          }
+        ]
+      }
+    },
+    "EcsAgentVpcEndpoint": {
+      "Condition": "CreateVpcEndpoints",
+      "Type": "AWS::EC2::VPCEndpoint",
+      "Properties": {
+        "VpcId": {
+          "Ref": "Vpc"
+        },
+        "ServiceName": {
+          "Fn::Sub": "com.amazonaws.${AWS::Region}.ecs-agent"
+        },
+        "VpcEndpointType": "Interface",
+        "PrivateDnsEnabled": true,
+        "SubnetIds": [
+          {
+            "Ref": "PrivSubnetAz1"
+          },
+          {
+            "Ref": "PrivSubnetAz2"
+          }
+        ],
+        "SecurityGroupIds": [
+          {
+            "Ref": "VpcEndpointSecurityGroup"
+          }
+        ]
+      }
+    },
+    "EcsTelemetryVpcEndpoint": {
+      "Condition": "CreateVpcEndpoints",
+      "Type": "AWS::EC2::VPCEndpoint",
+      "Properties": {
+        "VpcId": {
+          "Ref": "Vpc"
+        },
+        "ServiceName": {
+          "Fn::Sub": "com.amazonaws.${AWS::Region}.ecs-telemetry"
+        },
+        "VpcEndpointType": "Interface",
+        "PrivateDnsEnabled": true,
+        "SubnetIds": [
+          {
+            "Ref": "PrivSubnetAz1"
+          },
+          {
+            "Ref": "PrivSubnetAz2"
+          }
+        ],
+        "SecurityGroupIds": [
+          {
+            "Ref": "VpcEndpointSecurityGroup"
+          }
+        ]
+      }
+    },
+    "LogsVpcEndpoint": {
+      "Condition": "CreateVpcEndpoints",
+      "Type": "AWS::EC2::VPCEndpoint",
+      "Properties": {
+        "VpcId": {
+          "Ref": "Vpc"
+        },
+        "ServiceName": {
+          "Fn::Sub": "com.amazonaws.${AWS::Region}.logs"
+        },
+        "VpcEndpointType": "Interface",
+        "PrivateDnsEnabled": true,
+        "SubnetIds": [
+          {
+            "Ref": "PrivSubnetAz1"
+          },
+          {
+            "Ref": "PrivSubnetAz2"
+          }
+        ],
+        "SecurityGroupIds": [
+          {
+            "Ref": "VpcEndpointSecurityGroup"
+          }
+        ]
+      }
+    },
+    "SsmVpcEndpoint": {
+      "Condition": "CreateVpcEndpoints",
+      "Type": "AWS::EC2::VPCEndpoint",
+      "Properties": {
+        "VpcId": {
+          "Ref": "Vpc"
+        },
+        "ServiceName": {
+          "Fn::Sub": "com.amazonaws.${AWS::Region}.ssm"
+        },
+        "VpcEndpointType": "Interface",
+        "PrivateDnsEnabled": true,
+        "SubnetIds": [
+          {
+            "Ref": "PrivSubnetAz1"
+          },
+          {
+            "Ref": "PrivSubnetAz2"
+          }
+        ],
+        "SecurityGroupIds": [
+          {
+            "Ref": "VpcEndpointSecurityGroup"
+          }
+        ]
+      }
+    },
+    "SsmMessagesVpcEndpoint": {
+      "Condition": "CreateVpcEndpoints",
+      "Type": "AWS::EC2::VPCEndpoint",
+      "Properties": {
+        "VpcId": {
+          "Ref": "Vpc"
+        },
+        "ServiceName": {
+          "Fn::Sub": "com.amazonaws.${AWS::Region}.ssmmessages"
+        },
+        "VpcEndpointType": "Interface",
+        "PrivateDnsEnabled": true,
+        "SubnetIds": [
+          {
+            "Ref": "PrivSubnetAz1"
+          },
+          {
+            "Ref": "PrivSubnetAz2"
+          }
+        ],
+        "SecurityGroupIds": [
+          {
+            "Ref": "VpcEndpointSecurityGroup"
+          }
+        ]
+      }
+    },
+    "Ec2MessagesVpcEndpoint": {
+      "Condition": "CreateVpcEndpoints",
+      "Type": "AWS::EC2::VPCEndpoint",
+      "Properties": {
+        "VpcId": {
+          "Ref": "Vpc"
+        },
+        "ServiceName": {
+          "Fn::Sub": "com.amazonaws.${AWS::Region}.ec2messages"
+        },
+        "VpcEndpointType": "Interface",
+        "PrivateDnsEnabled": true,
+        "SubnetIds": [
+          {
+            "Ref": "PrivSubnetAz1"
+          },
+          {
+            "Ref": "PrivSubnetAz2"
+          }
+        ],
+        "SecurityGroupIds": [
+          {
+            "Ref": "VpcEndpointSecurityGroup"
+          }
+        ]
+      }
+    },
+    "S3VpcEndpoint": {
+      "Condition": "CreateVpcEndpoints",
+      "Type": "AWS::EC2::VPCEndpoint",
+      "Properties": {
+        "VpcId": {
+          "Ref": "Vpc"
+        },
+        "ServiceName": {
+          "Fn::Sub": "com.amazonaws.${AWS::Region}.s3"
+        },
+        "VpcEndpointType": "Gateway",
+        "RouteTableIds": [
+          {
+            "Ref": "PrivateRouteTableAz1"
+          },
+          {
+            "Ref": "PrivateRouteTableAz2"
+          }
+        ]
+      }
+    },
+    "EcsSecurityGroup": {
+      "Condition": "CreateSecurityGroup",
+      "Type": "AWS::EC2::SecurityGroup",
+      "Properties": {
+        "GroupDescription": "ECS Allowed Ports",
+        "Tags": %[1]s,
+        "VpcId": {
           "Fn::If": [
-            "EnableIMDSv2",
+            "CreateVpcResources",
             {
-              "HttpEndpoint": "enabled",
-              "HttpTokens": "required"
+              "Ref": "Vpc"
             },
             {
-              "Ref": "AWS::NoValue"
+              "Ref": "VpcId"
             }
           ]
         },
-        "SecurityGroups": {
-          "Fn::If": [
-            "CreateSecurityGroup",
-            [ {
-              "Ref": "EcsSecurityGroup"
-            } ],
+        "SecurityGroupIngress" : [ {
+            "IpProtocol" : "tcp",
+            "FromPort" : { "Ref" : "EcsPort" },
+            "ToPort" : { "Ref" : "EcsPort" },
+            "CidrIp" : { "Ref" : "SourceCidr" }
+        } ]
+      }
+    },
+    "EcsInstanceRole": {
+      "Condition": "CreateEcsInstanceRole",
+      "Type": "AWS::IAM::Role",
+      "Properties": {
+        "AssumeRolePolicyDocument": {
+          "Version": "2012-10-17",
+          "Statement": [
             {
-              "Ref": "SecurityGroupIds"
+              "Effect": "Allow",
+              "Principal": {
+                "Service": [
+                  {
+                    "Fn::If": [
+                      "IsCNRegion",
+                      "ec2.amazonaws.com.cn",
+                      "ec2.amazonaws.com"
+                    ]
+                  }
+                ]
+              },
+              "Action": [
+                "sts:AssumeRole"
+              ]
             }
           ]
         },
-        "UserData": {
-          "Fn::Base64": {
-            "Ref": "UserData"
+        "Path": "/",
+        "ManagedPolicyArns": [
+          "arn:aws:iam::aws:policy/service-role/AmazonEC2ContainerServiceforEC2Role"
+        ]
+      }
+    },
+    "EcsInstanceProfile": {
+      "Condition": "LaunchInstances",
+      "Type": "AWS::IAM::InstanceProfile",
+      "Properties": {
+        "Path": "/",
+        "Roles": [
+          {
+            "Fn::If": [
+              "CreateEcsInstanceRole",
+              {
+                "Ref": "EcsInstanceRole"
+              },
+              {
+                "Ref": "InstanceRole"
+              }
+            ]
+          }
+        ]
+      }
+    },
+    "EcsInstanceLt": {
+      "Condition": "LaunchInstances",
+      "Type": "AWS::EC2::LaunchTemplate",
+      "Properties": {
+        "LaunchTemplateData": {
+          "ImageId": { "Ref" : "EcsAmiId" },
+          "InstanceType": {
+            "Ref": "EcsInstanceType"
+          },
+          "InstanceMarketOptions": {
+            "Fn::If": [
+              "UseSpotInstances",
+              {
+                "MarketType": "spot",
+                "SpotOptions": {
+                  "MaxPrice": {
+                    "Ref": "SpotPrice"
+                  }
+                }
+              },
+              {
+                "Ref": "AWS::NoValue"
+              }
+            ]
+          },
+          "IamInstanceProfile": {
+            "Name": {
+              "Ref": "EcsInstanceProfile"
+            }
+          },
+          "KeyName": {
+            "Fn::If": [
+              "CreateEC2LTWithKeyPair",
+              {
+                "Ref": "KeyName"
+              },
+              {
+                "Ref": "AWS::NoValue"
+              }
+            ]
+          },
+          "MetadataOptions": {
+            "HttpEndpoint": "enabled",
+            "HttpTokens": {
+              "Fn::If": [
+                "EnableIMDSv2",
+                "required",
+                "optional"
+              ]
+            },
+            "HttpPutResponseHopLimit": {
+              "Ref": "MetadataHopLimit"
+            }
+          },
+          "Monitoring": {
+            "Enabled": {
+              "Ref": "IsDetailedMonitoring"
+            }
+          },
+          "BlockDeviceMappings": {
+            "Fn::If": [
+              "CustomizeRootVolume",
+              [ {
+                "DeviceName": {
+                  "Ref": "RootDeviceName"
+                },
+                "Ebs": {
+                  "VolumeSize": {
+                    "Fn::If": [
+                      "SetRootVolumeSize",
+                      {
+                        "Ref": "RootVolumeSize"
+                      },
+                      {
+                        "Ref": "AWS::NoValue"
+                      }
+                    ]
+                  },
+                  "VolumeType": {
+                    "Fn::If": [
+                      "SetRootVolumeType",
+                      {
+                        "Ref": "RootVolumeType"
+                      },
+                      {
+                        "Ref": "AWS::NoValue"
+                      }
+                    ]
+                  },
+                  "Encrypted": {
+                    "Ref": "IsEncryptedVolume"
+                  },
+                  "DeleteOnTermination": true
+                }
+              } ],
+              {
+                "Ref": "AWS::NoValue"
+              }
+            ]
+          },
+          "NetworkInterfaces": [ {
+            "DeviceIndex": 0,
+            "AssociatePublicIpAddress": {
+              "Fn::If": [
+                "CreatePrivateSubnets",
+                false,
+                {
+                  "Ref": "AssociatePublicIpAddress"
+                }
+              ]
+            },
+            "DeleteOnTermination": true,
+            "Groups": {
+              "Fn::If": [
+                "CreateSecurityGroup",
+                [ {
+                  "Ref": "EcsSecurityGroup"
+                } ],
+                {
+                  "Ref": "SecurityGroupIds"
+                }
+              ]
+            }
+          } ],
+          "UserData": {
+            "Fn::Base64": {
+              "Ref": "UserData"
+            }
           }
         }
       }
@@ -595,36 +1409,269 @@ var clusterTemplate = `
             "CreateVpcResources",
             [
               {
-                "Fn::Join": [
-                  ",",
-                  [
+                "Fn::If": [
+                  "CreatePrivateSubnets",
+                  {
+                    "Fn::Join": [
+                      ",",
+                      [
+                        {
+                          "Ref": "PrivSubnetAz1"
+                        },
+                        {
+                          "Ref": "PrivSubnetAz2"
+                        }
+                      ]
+                    ]
+                  },
+                  {
+                    "Fn::Join": [
+                      ",",
+                      [
+                        {
+                          "Ref": "PubSubnetAz1"
+                        },
+                        {
+                          "Ref": "PubSubnetAz2"
+                        }
+                      ]
+                    ]
+                  }
+                ]
+              }
+            ],
+            {
+              "Ref": "SubnetIds"
+            }
+          ]
+        },
+        "LaunchTemplate": {
+          "Fn::If": [
+            "UseMixedInstances",
+            {
+              "Ref": "AWS::NoValue"
+            },
+            {
+              "LaunchTemplateId": {
+                "Ref": "EcsInstanceLt"
+              },
+              "Version": {
+                "Fn::GetAtt": [ "EcsInstanceLt", "LatestVersionNumber" ]
+              }
+            }
+          ]
+        },
+        "MixedInstancesPolicy": {
+          "Fn::If": [
+            "UseMixedInstances",
+            {
+              "InstancesDistribution": {
+                "OnDemandBaseCapacity": {
+                  "Ref": "OnDemandBaseCapacity"
+                },
+                "OnDemandPercentageAboveBaseCapacity": {
+                  "Ref": "OnDemandPercentage"
+                },
+                "SpotAllocationStrategy": {
+                  "Ref": "SpotAllocationStrategy"
+                },
+                "SpotMaxPrice": {
+                  "Fn::If": [
+                    "SetSpotPrice",
                     {
-                      "Ref": "PubSubnetAz1"
+                      "Ref": "SpotPrice"
                     },
                     {
-                      "Ref": "PubSubnetAz2"
+                      "Ref": "AWS::NoValue"
                     }
                   ]
-                ]
+                }
+              },
+              "LaunchTemplate": {
+                "LaunchTemplateSpecification": {
+                  "LaunchTemplateId": {
+                    "Ref": "EcsInstanceLt"
+                  },
+                  "Version": {
+                    "Fn::GetAtt": [ "EcsInstanceLt", "LatestVersionNumber" ]
+                  }
+                },
+                "Overrides": %[3]s
               }
-            ],
+            },
             {
-              "Ref": "SubnetIds"
+              "Ref": "AWS::NoValue"
             }
           ]
         },
-        "LaunchConfigurationName": {
-          "Ref": "EcsInstanceLc"
-        },
         "MinSize": "0",
         "MaxSize": {
           "Ref": "AsgMaxSize"
         },
         "DesiredCapacity": {
-          "Ref": "AsgMaxSize"
+          "Fn::If": [
+            "UseCapacityProvider",
+            {
+              "Ref": "AWS::NoValue"
+            },
+            {
+              "Ref": "AsgMaxSize"
+            }
+          ]
+        },
+        "NewInstancesProtectedFromScaleIn": {
+          "Fn::If": [
+            "UseCapacityProvider",
+            true,
+            {
+              "Ref": "AWS::NoValue"
+            }
+          ]
         },
         "Tags": %[2]s
       }
+    },
+    "EcsCapacityProvider": {
+      "Condition": "UseCapacityProvider",
+      "Type": "AWS::ECS::CapacityProvider",
+      "Properties": {
+        "AutoScalingGroupProvider": {
+          "AutoScalingGroupArn": {
+            "Ref": "EcsInstanceAsg"
+          },
+          "ManagedScaling": {
+            "Status": "ENABLED",
+            "TargetCapacity": {
+              "Ref": "TargetCapacity"
+            },
+            "MinimumScalingStepSize": {
+              "Ref": "MinimumScalingStepSize"
+            },
+            "MaximumScalingStepSize": {
+              "Ref": "MaximumScalingStepSize"
+            }
+          },
+          "ManagedTerminationProtection": "ENABLED"
+        },
+        "Tags": %[1]s
+      }
+    },
+    "EcsClusterCapacityProviders": {
+      "Condition": "AssociateCapacityProviders",
+      "Type": "AWS::ECS::ClusterCapacityProviderAssociations",
+      "Properties": {
+        "Cluster": {
+          "Ref": "EcsCluster"
+        },
+        "CapacityProviders": {
+          "Fn::If": [
+            "UseCapacityProvider",
+            {
+              "Fn::If": [
+                "UseFargateCapacityProviders",
+                {
+                  "Fn::Split": [
+                    ",",
+                    {
+                      "Fn::Join": [
+                        ",",
+                        [
+                          {
+                            "Ref": "EcsCapacityProvider"
+                          },
+                          {
+                            "Fn::Join": [
+                              ",",
+                              {
+                                "Ref": "FargateCapacityProviders"
+                              }
+                            ]
+                          }
+                        ]
+                      ]
+                    }
+                  ]
+                },
+                [ {
+                  "Ref": "EcsCapacityProvider"
+                } ]
+              ]
+            },
+            {
+              "Ref": "FargateCapacityProviders"
+            }
+          ]
+        },
+        "DefaultCapacityProviderStrategy": [ {
+          "CapacityProvider": {
+            "Fn::If": [
+              "UseCapacityProvider",
+              {
+                "Ref": "EcsCapacityProvider"
+              },
+              {
+                "Fn::Select": [
+                  "0",
+                  {
+                    "Ref": "FargateCapacityProviders"
+                  }
+                ]
+              }
+            ]
+          },
+          "Weight": 1
+        } ]
+      }
+    }
+  },
+  "Outputs": {
+    "VpcId": {
+      "Condition": "CreateVpcResources",
+      "Description": "The VPC of the cluster",
+      "Value": {
+        "Ref": "Vpc"
+      }
+    },
+    "PublicSubnetIds": {
+      "Condition": "CreateVpcResources",
+      "Description": "The public subnets of the VPC",
+      "Value": {
+        "Fn::Join": [
+          ",",
+          [
+            {
+              "Ref": "PubSubnetAz1"
+            },
+            {
+              "Ref": "PubSubnetAz2"
+            }
+          ]
+        ]
+      }
+    },
+    "PrivateSubnetIds": {
+      "Condition": "CreatePrivateSubnets",
+      "Description": "The private subnets of the VPC, for the awsvpc network configuration of tasks in ecs-params.yml",
+      "Value": {
+        "Fn::Join": [
+          ",",
+          [
+            {
+              "Ref": "PrivSubnetAz1"
+            },
+            {
+              "Ref": "PrivSubnetAz2"
+            }
+          ]
+        ]
+      }
+    },
+    "SecurityGroupId": {
+      "Condition": "CreateSecurityGroup",
+      "Description": "The security group of the container instances",
+      "Value": {
+        "Ref": "EcsSecurityGroup"
+      }
     }
   }
 }
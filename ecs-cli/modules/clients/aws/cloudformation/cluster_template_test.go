@@ -0,0 +1,59 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cloudformation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetClusterTemplate(t *testing.T) {
+	tags := []*ecs.Tag{{Key: aws.String("team"), Value: aws.String("green")}}
+	template, err := GetClusterTemplate(tags, "my-stack", nil)
+	assert.NoError(t, err, "Unexpected error building cluster template")
+
+	assert.Contains(t, template, `"Type": "AWS::EC2::LaunchTemplate"`)
+	assert.NotContains(t, template, "AWS::AutoScaling::LaunchConfiguration")
+	assert.NotContains(t, template, "LaunchConfigurationName")
+	assert.Contains(t, template, `"Fn::GetAtt": [ "EcsInstanceLt", "LatestVersionNumber" ]`, "Expected the Auto Scaling group to use the latest launch template version")
+	assert.Contains(t, template, `{"Key":"team","Value":"green","PropagateAtLaunch":true}`)
+	assert.Contains(t, template, `{"Key":"Name","Value":"ECS Instance - my-stack","PropagateAtLaunch":true}`)
+
+	assert.Contains(t, template, `"Type": "AWS::ECS::CapacityProvider"`)
+	assert.Contains(t, template, `"Type": "AWS::ECS::ClusterCapacityProviderAssociations"`)
+	assert.Contains(t, template, `"ManagedTerminationProtection": "ENABLED"`)
+	assert.Contains(t, template, `"Overrides": []`)
+
+	assert.Contains(t, template, `"Type": "AWS::EC2::NatGateway"`)
+	assert.Contains(t, template, `"Fn::Sub": "com.amazonaws.${AWS::Region}.ecr.dkr"`)
+	assert.Contains(t, template, `"PrivateSubnetIds": {`)
+}
+
+func TestGetClusterTemplateWithInstanceTypes(t *testing.T) {
+	template, err := GetClusterTemplate(nil, "my-stack", []string{"m5.large", "m5a.large"})
+	assert.NoError(t, err, "Unexpected error building cluster template")
+	assert.Contains(t, template, `"Overrides": [{"InstanceType":"m5.large"},{"InstanceType":"m5a.large"}]`)
+}
+
+func TestGetClusterTemplateIsValidJSON(t *testing.T) {
+	template, err := GetClusterTemplate(nil, "my-stack", []string{"m5.large"})
+	assert.NoError(t, err, "Unexpected error building cluster template")
+
+	var parsed map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(template), &parsed), "Expected the cluster template to be valid JSON")
+}
@@ -48,6 +48,21 @@ func (m *MockCloudformationClient) EXPECT() *MockCloudformationClientMockRecorde
 	return m.recorder
 }
 
+// CreateChangeSet mocks base method
+func (m *MockCloudformationClient) CreateChangeSet(arg0, arg1 string, arg2 *cloudformation.CfnStackParams, arg3 []*cloudformation0.Tag, arg4 string) (*cloudformation.ChangeSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChangeSet", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*cloudformation.ChangeSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChangeSet indicates an expected call of CreateChangeSet
+func (mr *MockCloudformationClientMockRecorder) CreateChangeSet(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChangeSet", reflect.TypeOf((*MockCloudformationClient)(nil).CreateChangeSet), arg0, arg1, arg2, arg3, arg4)
+}
+
 // CreateStack mocks base method
 func (m *MockCloudformationClient) CreateStack(arg0, arg1 string, arg2 bool, arg3 *cloudformation.CfnStackParams, arg4 []*cloudformation0.Tag) (string, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +78,20 @@ func (mr *MockCloudformationClientMockRecorder) CreateStack(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStack", reflect.TypeOf((*MockCloudformationClient)(nil).CreateStack), arg0, arg1, arg2, arg3, arg4)
 }
 
+// DeleteChangeSet mocks base method
+func (m *MockCloudformationClient) DeleteChangeSet(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet
+func (mr *MockCloudformationClientMockRecorder) DeleteChangeSet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*MockCloudformationClient)(nil).DeleteChangeSet), arg0)
+}
+
 // DeleteStack mocks base method
 func (m *MockCloudformationClient) DeleteStack(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -91,6 +120,21 @@ func (mr *MockCloudformationClientMockRecorder) DescribeNetworkResources(arg0 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNetworkResources", reflect.TypeOf((*MockCloudformationClient)(nil).DescribeNetworkResources), arg0)
 }
 
+// DescribeStackResource mocks base method
+func (m *MockCloudformationClient) DescribeStackResource(arg0, arg1 string) (*cloudformation0.StackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeStackResource", arg0, arg1)
+	ret0, _ := ret[0].(*cloudformation0.StackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStackResource indicates an expected call of DescribeStackResource
+func (mr *MockCloudformationClientMockRecorder) DescribeStackResource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStackResource", reflect.TypeOf((*MockCloudformationClient)(nil).DescribeStackResource), arg0, arg1)
+}
+
 // DescribeStacks mocks base method
 func (m *MockCloudformationClient) DescribeStacks(arg0 string) (*cloudformation0.DescribeStacksOutput, error) {
 	m.ctrl.T.Helper()
@@ -106,6 +150,20 @@ func (mr *MockCloudformationClientMockRecorder) DescribeStacks(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStacks", reflect.TypeOf((*MockCloudformationClient)(nil).DescribeStacks), arg0)
 }
 
+// ExecuteChangeSet mocks base method
+func (m *MockCloudformationClient) ExecuteChangeSet(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet
+func (mr *MockCloudformationClientMockRecorder) ExecuteChangeSet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*MockCloudformationClient)(nil).ExecuteChangeSet), arg0)
+}
+
 // GetStackParameters mocks base method
 func (m *MockCloudformationClient) GetStackParameters(arg0 string) ([]*cloudformation0.Parameter, error) {
 	m.ctrl.T.Helper()
@@ -121,6 +179,21 @@ func (mr *MockCloudformationClientMockRecorder) GetStackParameters(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStackParameters", reflect.TypeOf((*MockCloudformationClient)(nil).GetStackParameters), arg0)
 }
 
+// GetTemplate mocks base method
+func (m *MockCloudformationClient) GetTemplate(arg0 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplate", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplate indicates an expected call of GetTemplate
+func (mr *MockCloudformationClientMockRecorder) GetTemplate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplate", reflect.TypeOf((*MockCloudformationClient)(nil).GetTemplate), arg0)
+}
+
 // UpdateStack mocks base method
 func (m *MockCloudformationClient) UpdateStack(arg0 string, arg1 *cloudformation.CfnStackParams) (string, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +209,21 @@ func (mr *MockCloudformationClientMockRecorder) UpdateStack(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStack", reflect.TypeOf((*MockCloudformationClient)(nil).UpdateStack), arg0, arg1)
 }
 
+// UpdateStackTemplate mocks base method
+func (m *MockCloudformationClient) UpdateStackTemplate(arg0, arg1 string, arg2 *cloudformation.CfnStackParams) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStackTemplate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStackTemplate indicates an expected call of UpdateStackTemplate
+func (mr *MockCloudformationClientMockRecorder) UpdateStackTemplate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStackTemplate", reflect.TypeOf((*MockCloudformationClient)(nil).UpdateStackTemplate), arg0, arg1, arg2)
+}
+
 // ValidateStackExists mocks base method
 func (m *MockCloudformationClient) ValidateStackExists(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,246 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cloudformation
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+)
+
+const (
+	stackResourceType = "AWS::CloudFormation::Stack"
+
+	// maxNestedStackDepth is the maximum number of nested stacks followed to
+	// find the root cause of a failure.
+	maxNestedStackDepth = 5
+
+	stackEventFormat = "%-8s  %-32s  %-38s  %-28s  %s\n"
+)
+
+// stackStartStatuses are the statuses of the event which a stack logs for
+// itself when an operation on it starts.
+var stackStartStatuses = map[string]bool{
+	cloudformation.ResourceStatusCreateInProgress: true,
+	cloudformation.ResourceStatusUpdateInProgress: true,
+	cloudformation.ResourceStatusDeleteInProgress: true,
+}
+
+// stackEventStream prints the events of a stack operation as they happen,
+// and keeps them to summarize the operation and explain its failure.
+type stackEventStream struct {
+	stackName string
+	// stackID is used once known, since deleted stacks can only be described by ID
+	stackID string
+	out     io.Writer
+	// status is the last known status of the stack
+	status string
+	polled bool
+	start  time.Time
+	seen   map[string]bool
+	// events are in chronological order
+	events []*cloudformation.StackEvent
+}
+
+func newStackEventStream(stackName string, out io.Writer) *stackEventStream {
+	return &stackEventStream{
+		stackName: stackName,
+		out:       out,
+		seen:      make(map[string]bool),
+	}
+}
+
+// poll prints the events logged since the last poll and returns the latest
+// event of the stack. The first poll starts at the event the stack logged
+// when the current operation started.
+func (s *stackEventStream) poll(client cloudformationiface.CloudFormationAPI) (*cloudformation.StackEvent, error) {
+	input := &cloudformation.DescribeStackEventsInput{StackName: aws.String(s.stackName)}
+	if s.stackID != "" {
+		input.StackName = aws.String(s.stackID)
+	}
+
+	var latest *cloudformation.StackEvent
+	var newEvents []*cloudformation.StackEvent
+pages:
+	for {
+		output, err := client.DescribeStackEvents(input)
+		if err != nil {
+			return nil, err
+		}
+		if latest == nil {
+			if len(output.StackEvents) == 0 {
+				return nil, fmt.Errorf("Could not describe stack events")
+			}
+			latest = output.StackEvents[0]
+		}
+		// events are listed from the most recent one
+		for _, event := range output.StackEvents {
+			if s.seen[aws.StringValue(event.EventId)] {
+				break pages
+			}
+			newEvents = append(newEvents, event)
+			if !s.polled && s.isStackEvent(event) && stackStartStatuses[aws.StringValue(event.ResourceStatus)] {
+				s.start = aws.TimeValue(event.Timestamp)
+				break pages
+			}
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	if !s.polled {
+		s.polled = true
+		s.stackID = aws.StringValue(latest.StackId)
+	}
+	if len(s.events) == 0 && len(newEvents) > 0 {
+		fmt.Fprintf(s.out, stackEventFormat, "ELAPSED", "LOGICAL ID", "TYPE", "STATUS", "REASON")
+	}
+	for i := len(newEvents) - 1; i >= 0; i-- {
+		event := newEvents[i]
+		s.seen[aws.StringValue(event.EventId)] = true
+		if s.start.IsZero() {
+			s.start = aws.TimeValue(event.Timestamp)
+		}
+		s.events = append(s.events, event)
+		fmt.Fprintf(s.out, stackEventFormat, s.elapsed(event.Timestamp), aws.StringValue(event.LogicalResourceId),
+			aws.StringValue(event.ResourceType), aws.StringValue(event.ResourceStatus), aws.StringValue(event.ResourceStatusReason))
+	}
+
+	return latest, nil
+}
+
+// isStackEvent returns whether the event was logged for the stack itself,
+// rather than for one of its resources.
+func (s *stackEventStream) isStackEvent(event *cloudformation.StackEvent) bool {
+	return aws.StringValue(event.ResourceType) == stackResourceType && aws.StringValue(event.LogicalResourceId) == s.stackName
+}
+
+func (s *stackEventStream) elapsed(timestamp *time.Time) string {
+	if timestamp == nil || s.start.IsZero() || timestamp.Before(s.start) {
+		return "0s"
+	}
+	return timestamp.Sub(s.start).Round(time.Second).String()
+}
+
+// rootCause returns the first resource failure of the operation, which
+// usually caused the others. The failures of nested stacks are followed to the
+// resource which failed in them.
+func (s *stackEventStream) rootCause(client cloudformationiface.CloudFormationAPI) *cloudformation.StackEvent {
+	event := firstFailure(s.events)
+	for depth := 0; event != nil && depth < maxNestedStackDepth; depth++ {
+		if aws.StringValue(event.ResourceType) != stackResourceType || aws.StringValue(event.PhysicalResourceId) == "" {
+			break
+		}
+		output, err := client.DescribeStackEvents(&cloudformation.DescribeStackEventsInput{StackName: event.PhysicalResourceId})
+		if err != nil {
+			break
+		}
+		chronological := make([]*cloudformation.StackEvent, 0, len(output.StackEvents))
+		for i := len(output.StackEvents) - 1; i >= 0; i-- {
+			chronological = append(chronological, output.StackEvents[i])
+		}
+		nested := firstFailure(chronological)
+		if nested == nil {
+			break
+		}
+		event = nested
+	}
+	return event
+}
+
+// firstFailure returns the first failed resource event, skipping the events
+// of the stacks themselves and the resources cancelled because of another
+// failure when possible.
+func firstFailure(events []*cloudformation.StackEvent) *cloudformation.StackEvent {
+	var cancelled *cloudformation.StackEvent
+	for _, event := range events {
+		if !strings.HasSuffix(aws.StringValue(event.ResourceStatus), "_FAILED") {
+			continue
+		}
+		if stackID := aws.StringValue(event.StackId); stackID != "" && stackID == aws.StringValue(event.PhysicalResourceId) {
+			continue
+		}
+		if strings.Contains(strings.ToLower(aws.StringValue(event.ResourceStatusReason)), "cancelled") {
+			if cancelled == nil {
+				cancelled = event
+			}
+			continue
+		}
+		return event
+	}
+	return cancelled
+}
+
+// describeEvent formats a resource event for error messages.
+func describeEvent(event *cloudformation.StackEvent) string {
+	return fmt.Sprintf("%s (%s) %s: %s", aws.StringValue(event.LogicalResourceId), aws.StringValue(event.ResourceType),
+		aws.StringValue(event.ResourceStatus), aws.StringValue(event.ResourceStatusReason))
+}
+
+// printSummary writes a table of the final status of each resource changed by
+// the operation and how long it took, followed by the status of the stack.
+func (s *stackEventStream) printSummary() {
+	if len(s.events) == 0 {
+		return
+	}
+
+	type resourceSummary struct {
+		logicalID, resourceType, status string
+		first, last                     time.Time
+	}
+	var resources []*resourceSummary
+	byLogicalID := make(map[string]*resourceSummary)
+	// the events of the stack itself include its final status, which deleted
+	// stacks can no longer be described for
+	status := s.status
+	for _, event := range s.events {
+		if s.isStackEvent(event) {
+			status = aws.StringValue(event.ResourceStatus)
+			continue
+		}
+		logicalID := aws.StringValue(event.LogicalResourceId)
+		resource, ok := byLogicalID[logicalID]
+		if !ok {
+			resource = &resourceSummary{
+				logicalID:    logicalID,
+				resourceType: aws.StringValue(event.ResourceType),
+				first:        aws.TimeValue(event.Timestamp),
+			}
+			byLogicalID[logicalID] = resource
+			resources = append(resources, resource)
+		}
+		resource.status = aws.StringValue(event.ResourceStatus)
+		resource.last = aws.TimeValue(event.Timestamp)
+	}
+
+	fmt.Fprintln(s.out)
+	w := tabwriter.NewWriter(s.out, 10, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "LOGICAL ID\tTYPE\tSTATUS\tDURATION")
+	for _, resource := range resources {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", resource.logicalID, resource.resourceType, resource.status,
+			resource.last.Sub(resource.first).Round(time.Second))
+	}
+	w.Flush()
+
+	last := s.events[len(s.events)-1]
+	fmt.Fprintf(s.out, "Stack %s: %s after %s\n", s.stackName, status, s.elapsed(last.Timestamp))
+}
@@ -0,0 +1,175 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cloudformation
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	eventsStackName = "myStack"
+	eventsStackID   = "arn:aws:cloudformation:us-west-2:123456789012:stack/myStack/1"
+	nestedStackID   = "arn:aws:cloudformation:us-west-2:123456789012:stack/myStack-Nested/2"
+)
+
+var eventsStart = time.Date(2020, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+func stackEvent(id, logicalID, resourceType, status, reason string, elapsed time.Duration) *cloudformation.StackEvent {
+	event := &cloudformation.StackEvent{
+		EventId:           aws.String(id),
+		StackId:           aws.String(eventsStackID),
+		StackName:         aws.String(eventsStackName),
+		LogicalResourceId: aws.String(logicalID),
+		ResourceType:      aws.String(resourceType),
+		ResourceStatus:    aws.String(status),
+		Timestamp:         aws.Time(eventsStart.Add(elapsed)),
+	}
+	if resourceType == stackResourceType && logicalID == eventsStackName {
+		event.PhysicalResourceId = aws.String(eventsStackID)
+	}
+	if reason != "" {
+		event.ResourceStatusReason = aws.String(reason)
+	}
+	return event
+}
+
+// describeStackEventsOutput lists the events from the most recent one, like DescribeStackEvents.
+func describeStackEventsOutput(chronological ...*cloudformation.StackEvent) *cloudformation.DescribeStackEventsOutput {
+	output := &cloudformation.DescribeStackEventsOutput{}
+	for i := len(chronological) - 1; i >= 0; i-- {
+		output.StackEvents = append(output.StackEvents, chronological[i])
+	}
+	return output
+}
+
+func TestWaitUntilCreateCompleteStreamsEvents(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+	out := &bytes.Buffer{}
+	cfnClient.(*cloudformationClient).out = out
+
+	// events of a previous operation on the stack are not printed
+	previousEvent := stackEvent("0", eventsStackName, stackResourceType, cloudformation.ResourceStatusDeleteComplete, "", -time.Hour)
+	stackStarted := stackEvent("1", eventsStackName, stackResourceType, cloudformation.ResourceStatusCreateInProgress, "User Initiated", 0)
+	vpcStarted := stackEvent("2", "Vpc", "AWS::EC2::VPC", cloudformation.ResourceStatusCreateInProgress, "", 5*time.Second)
+	vpcCreated := stackEvent("3", "Vpc", "AWS::EC2::VPC", cloudformation.ResourceStatusCreateComplete, "", 25*time.Second)
+	stackCreated := stackEvent("4", eventsStackName, stackResourceType, cloudformation.ResourceStatusCreateComplete, "", 90*time.Second)
+
+	gomock.InOrder(
+		mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Do(func(x interface{}) {
+			input := x.(*cloudformation.DescribeStackEventsInput)
+			assert.Equal(t, eventsStackName, aws.StringValue(input.StackName), "Expected the first poll to use the stack name")
+		}).Return(describeStackEventsOutput(previousEvent, stackStarted, vpcStarted), nil),
+		mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusCreateInProgress), nil),
+		mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Do(func(x interface{}) {
+			input := x.(*cloudformation.DescribeStackEventsInput)
+			assert.Equal(t, eventsStackID, aws.StringValue(input.StackName), "Expected the next polls to use the stack ID")
+		}).Return(describeStackEventsOutput(previousEvent, stackStarted, vpcStarted, vpcCreated, stackCreated), nil),
+		mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusCreateComplete), nil),
+	)
+
+	err := cfnClient.WaitUntilCreateComplete(eventsStackName)
+	assert.NoError(t, err, "Unexpected error waiting for create completion")
+
+	output := out.String()
+	assert.NotContains(t, output, cloudformation.ResourceStatusDeleteComplete, "Expected events of previous operations to be skipped")
+	assert.Equal(t, 1, strings.Count(output, "User Initiated"), "Expected each event to be printed once")
+	assert.Regexp(t, `25s\s+Vpc\s+AWS::EC2::VPC\s+CREATE_COMPLETE`, output, "Expected event to be printed with its elapsed time")
+	assert.Regexp(t, `Vpc\s+AWS::EC2::VPC\s+CREATE_COMPLETE\s+20s\n`, output, "Expected resource summary to be printed")
+	assert.Contains(t, output, "Stack myStack: CREATE_COMPLETE after 1m30s")
+}
+
+func TestWaitUntilUpdateCompleteReportsRootCause(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+	cfnClient.(*cloudformationClient).out = &bytes.Buffer{}
+
+	events := describeStackEventsOutput(
+		stackEvent("1", eventsStackName, stackResourceType, cloudformation.ResourceStatusUpdateInProgress, "User Initiated", 0),
+		stackEvent("2", "EcsInstanceLaunchTemplate", "AWS::EC2::LaunchTemplate", cloudformation.ResourceStatusUpdateFailed, "Resource update cancelled", 10*time.Second),
+		stackEvent("3", "EcsInstanceAsg", "AWS::AutoScaling::AutoScalingGroup", cloudformation.ResourceStatusUpdateFailed, "Max size must be at least min size", 11*time.Second),
+		stackEvent("4", eventsStackName, stackResourceType, cloudformation.StackStatusUpdateRollbackComplete, "", time.Minute),
+	)
+	gomock.InOrder(
+		mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Return(events, nil),
+		mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusUpdateRollbackComplete), nil),
+	)
+
+	err := cfnClient.WaitUntilUpdateComplete(eventsStackName)
+	assert.Error(t, err, "Expected error waiting for update completion")
+	assert.Contains(t, err.Error(), "State is 'UPDATE_ROLLBACK_COMPLETE'")
+	assert.Contains(t, err.Error(), "EcsInstanceAsg (AWS::AutoScaling::AutoScalingGroup) UPDATE_FAILED: Max size must be at least min size")
+}
+
+func TestRootCauseInNestedStack(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	nestedStackFailed := stackEvent("2", "Nested", stackResourceType, cloudformation.ResourceStatusCreateFailed, "Embedded stack was not successfully created", 30*time.Second)
+	nestedStackFailed.PhysicalResourceId = aws.String(nestedStackID)
+	stream := newStackEventStream(eventsStackName, &bytes.Buffer{})
+	stream.events = []*cloudformation.StackEvent{
+		stackEvent("1", eventsStackName, stackResourceType, cloudformation.ResourceStatusCreateInProgress, "User Initiated", 0),
+		nestedStackFailed,
+	}
+
+	nestedResourceFailed := stackEvent("n2", "Bucket", "AWS::S3::Bucket", cloudformation.ResourceStatusCreateFailed, "Bucket already exists", 20*time.Second)
+	nestedResourceFailed.StackId = aws.String(nestedStackID)
+	nestedStackSelfFailed := stackEvent("n3", "myStack-Nested", stackResourceType, cloudformation.ResourceStatusCreateFailed, "", 25*time.Second)
+	nestedStackSelfFailed.StackId = aws.String(nestedStackID)
+	nestedStackSelfFailed.PhysicalResourceId = aws.String(nestedStackID)
+
+	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Do(func(x interface{}) {
+		input := x.(*cloudformation.DescribeStackEventsInput)
+		assert.Equal(t, nestedStackID, aws.StringValue(input.StackName), "Expected the events of the nested stack to be described")
+	}).Return(describeStackEventsOutput(nestedResourceFailed, nestedStackSelfFailed), nil)
+
+	rootCause := stream.rootCause(cfnClient.(*cloudformationClient).client)
+	assert.Equal(t, nestedResourceFailed, rootCause, "Expected the failure in the nested stack to be the root cause")
+
+	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Return(nil, errors.New("something failed"))
+	rootCause = stream.rootCause(cfnClient.(*cloudformationClient).client)
+	assert.Equal(t, nestedStackFailed, rootCause, "Expected the nested stack failure when its events cannot be described")
+}
+
+func TestWaitUntilDeleteCompletePrintsSummary(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+	out := &bytes.Buffer{}
+	cfnClient.(*cloudformationClient).out = out
+
+	stackDeleting := stackEvent("1", eventsStackName, stackResourceType, cloudformation.ResourceStatusDeleteInProgress, "User Initiated", 0)
+	vpcDeleted := stackEvent("2", "Vpc", "AWS::EC2::VPC", cloudformation.ResourceStatusDeleteComplete, "", 40*time.Second)
+	stackDeleted := stackEvent("3", eventsStackName, stackResourceType, cloudformation.ResourceStatusDeleteComplete, "", time.Minute)
+
+	gomock.InOrder(
+		mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Return(describeStackEventsOutput(stackDeleting), nil),
+		mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusDeleteInProgress), nil),
+		mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Return(describeStackEventsOutput(stackDeleting, vpcDeleted, stackDeleted), nil),
+		mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusDeleteComplete), nil),
+	)
+
+	err := cfnClient.WaitUntilDeleteComplete(eventsStackName)
+	assert.NoError(t, err, "Unexpected error waiting for delete completion")
+	assert.Contains(t, out.String(), "Stack myStack: DELETE_COMPLETE after 1m0s")
+}
@@ -0,0 +1,171 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cloudformation
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	templateSectionParameters = "Parameters"
+	templateSectionResources  = "Resources"
+	templateSectionOutputs    = "Outputs"
+)
+
+// templateExtensionSections are the sections of a template extension, which
+// are merged into the same sections of the cluster template.
+var templateExtensionSections = []string{templateSectionParameters, templateSectionResources, templateSectionOutputs}
+
+// shortFormIntrinsicFunction matches the YAML tags of the short form of the
+// intrinsic functions, such as !Ref, which the YAML parser drops.
+var shortFormIntrinsicFunction = regexp.MustCompile(`(^|[\s\[,:-])!(Ref|Sub|GetAtt|Join|Select|Split|FindInMap|If|Equals|And|Or|Not|Base64|Cidr|GetAZs|ImportValue|Condition|Transform)\b`)
+
+// MergeTemplateExtension adds the Parameters, Resources and Outputs of a
+// template extension, in YAML or JSON, to the template of a stack. The
+// extension can not redefine the logical IDs or outputs of the template, and
+// its parameters need a default value, since only the parameters of the
+// template are given values.
+func MergeTemplateExtension(template string, extension []byte) (string, error) {
+	var merged map[string]interface{}
+	if err := json.Unmarshal([]byte(template), &merged); err != nil {
+		return "", errors.Wrap(err, "Error parsing cloudformation template")
+	}
+
+	if match := shortFormIntrinsicFunction.FindSubmatch(extension); match != nil {
+		function := string(match[2])
+		fullForm := "Fn::" + function
+		if function == "Ref" || function == "Condition" {
+			fullForm = function
+		}
+		return "", fmt.Errorf("Template extensions must use the full form of intrinsic functions: use '%s' instead of '!%s'", fullForm, function)
+	}
+	var raw interface{}
+	if err := yaml.Unmarshal(extension, &raw); err != nil {
+		return "", errors.Wrap(err, "Error parsing template extension")
+	}
+	sections, ok := convertYAMLValue(raw).(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("The template extension must be a mapping of template sections")
+	}
+	for _, section := range sortedKeys(sections) {
+		if !isTemplateExtensionSection(section) {
+			return "", fmt.Errorf("Unsupported section '%s' in template extension. Template extensions can only contain %v", section, templateExtensionSections)
+		}
+	}
+
+	// Parameters and resources share the logical IDs of the template
+	logicalIDs := make(map[string]bool)
+	for _, section := range []string{templateSectionParameters, templateSectionResources} {
+		entries, _ := merged[section].(map[string]interface{})
+		for name := range entries {
+			logicalIDs[name] = true
+		}
+	}
+
+	for _, section := range templateExtensionSections {
+		value, ok := sections[section]
+		if !ok {
+			continue
+		}
+		entries, ok := value.(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("The %s section of the template extension must be a mapping", section)
+		}
+		existing, ok := merged[section].(map[string]interface{})
+		if !ok {
+			existing = make(map[string]interface{})
+			merged[section] = existing
+		}
+
+		for _, name := range sortedKeys(entries) {
+			entry, ok := entries[name].(map[string]interface{})
+			if !ok {
+				return "", fmt.Errorf("%s '%s' of the template extension must be a mapping", section, name)
+			}
+			switch section {
+			case templateSectionOutputs:
+				if _, exists := existing[name]; exists {
+					return "", fmt.Errorf("Output '%s' of the template extension is already defined by the cluster template", name)
+				}
+			default:
+				if logicalIDs[name] {
+					return "", fmt.Errorf("Logical ID '%s' of the template extension is already used by the cluster template", name)
+				}
+				logicalIDs[name] = true
+			}
+			if section == templateSectionParameters && entry["Default"] == nil {
+				return "", fmt.Errorf("Parameter '%s' of the template extension must have a Default value", name)
+			}
+			if section == templateSectionResources {
+				if _, ok := entry["Type"].(string); !ok {
+					return "", fmt.Errorf("Resource '%s' of the template extension must have a Type", name)
+				}
+			}
+			existing[name] = entry
+		}
+	}
+
+	// The compact form leaves room for extensions within the size limit of
+	// template bodies
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func isTemplateExtensionSection(section string) bool {
+	for _, s := range templateExtensionSections {
+		if section == s {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// convertYAMLValue converts the maps decoded by the YAML parser, which have
+// interface{} keys, to maps with string keys which can be encoded to JSON.
+func convertYAMLValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			converted[fmt.Sprintf("%v", key)] = convertYAMLValue(item)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, item := range v {
+			converted[i] = convertYAMLValue(item)
+		}
+		return converted
+	default:
+		return v
+	}
+}
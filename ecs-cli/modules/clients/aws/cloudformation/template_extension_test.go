@@ -0,0 +1,163 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cloudformation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const efsExtension = `
+Parameters:
+  PerformanceMode:
+    Type: String
+    Default: generalPurpose
+Resources:
+  FileSystem:
+    Type: AWS::EFS::FileSystem
+    Properties:
+      PerformanceMode:
+        Ref: PerformanceMode
+  MountTarget1:
+    Type: AWS::EFS::MountTarget
+    Properties:
+      FileSystemId:
+        Ref: FileSystem
+      SubnetId:
+        Ref: PubSubnetAz1
+      SecurityGroups:
+        - Fn::GetAtt: [EcsSecurityGroup, GroupId]
+Outputs:
+  FileSystemId:
+    Value:
+      Ref: FileSystem
+`
+
+func TestMergeTemplateExtension(t *testing.T) {
+	template, err := GetClusterTemplate(nil, "my-stack", nil)
+	assert.NoError(t, err, "Unexpected error building cluster template")
+
+	merged, err := MergeTemplateExtension(template, []byte(efsExtension))
+	assert.NoError(t, err, "Unexpected error merging template extension")
+
+	var parsed map[string]interface{}
+	err = json.Unmarshal([]byte(merged), &parsed)
+	assert.NoError(t, err, "Expected merged template to be JSON")
+	mergedTemplate := make(map[string]map[string]interface{})
+	for _, section := range []string{"Parameters", "Resources", "Outputs", "Conditions"} {
+		mergedTemplate[section], _ = parsed[section].(map[string]interface{})
+	}
+
+	assert.Contains(t, mergedTemplate["Resources"], "FileSystem")
+	assert.Contains(t, mergedTemplate["Resources"], VPCLogicalResourceId, "Expected the resources of the cluster template to be kept")
+	assert.Contains(t, mergedTemplate["Parameters"], "PerformanceMode")
+	assert.Contains(t, mergedTemplate["Parameters"], "EcsAmiId", "Expected the parameters of the cluster template to be kept")
+	assert.Contains(t, mergedTemplate["Outputs"], "FileSystemId")
+	assert.Contains(t, mergedTemplate["Outputs"], "PrivateSubnetIds", "Expected the outputs of the cluster template to be kept")
+	assert.NotEmpty(t, mergedTemplate["Conditions"], "Expected the other sections of the cluster template to be kept")
+
+	mountTarget := mergedTemplate["Resources"]["MountTarget1"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"FileSystemId":   map[string]interface{}{"Ref": "FileSystem"},
+		"SubnetId":       map[string]interface{}{"Ref": "PubSubnetAz1"},
+		"SecurityGroups": []interface{}{map[string]interface{}{"Fn::GetAtt": []interface{}{"EcsSecurityGroup", "GroupId"}}},
+	}, mountTarget["Properties"], "Expected the properties of the extension resources to be kept")
+}
+
+func TestMergeTemplateExtensionWithJSON(t *testing.T) {
+	template, err := GetClusterTemplate(nil, "my-stack", nil)
+	assert.NoError(t, err, "Unexpected error building cluster template")
+
+	merged, err := MergeTemplateExtension(template, []byte(`{"Resources": {"Topic": {"Type": "AWS::SNS::Topic"}}}`))
+	assert.NoError(t, err, "Unexpected error merging template extension")
+	assert.Contains(t, merged, `"Topic":{"Type":"AWS::SNS::Topic"}`)
+}
+
+func TestMergeTemplateExtensionErrorCases(t *testing.T) {
+	testCases := map[string]struct {
+		extension     string
+		expectedError string
+	}{
+		"VPC resource": {
+			extension:     "Resources:\n  Vpc:\n    Type: AWS::EC2::VPC\n",
+			expectedError: "Logical ID 'Vpc'",
+		},
+		"security group resource": {
+			extension:     "Resources:\n  EcsSecurityGroup:\n    Type: AWS::EC2::SecurityGroup\n",
+			expectedError: "Logical ID 'EcsSecurityGroup'",
+		},
+		"resource named like a parameter": {
+			extension:     "Resources:\n  EcsAmiId:\n    Type: AWS::SNS::Topic\n",
+			expectedError: "Logical ID 'EcsAmiId'",
+		},
+		"parameter": {
+			extension:     "Parameters:\n  EcsInstanceType:\n    Type: String\n    Default: t3.micro\n",
+			expectedError: "Logical ID 'EcsInstanceType'",
+		},
+		"parameter named like an extension resource": {
+			extension:     "Parameters:\n  Topic:\n    Type: String\n    Default: topic\nResources:\n  Topic:\n    Type: AWS::SNS::Topic\n",
+			expectedError: "Logical ID 'Topic'",
+		},
+		"output": {
+			extension:     "Outputs:\n  PrivateSubnetIds:\n    Value: subnets\n",
+			expectedError: "Output 'PrivateSubnetIds'",
+		},
+		"parameter without default": {
+			extension:     "Parameters:\n  TopicName:\n    Type: String\n",
+			expectedError: "Parameter 'TopicName' of the template extension must have a Default value",
+		},
+		"resource without type": {
+			extension:     "Resources:\n  Topic:\n    Properties: {}\n",
+			expectedError: "Resource 'Topic' of the template extension must have a Type",
+		},
+		"unsupported section": {
+			extension:     "Conditions:\n  IsProd:\n    Fn::Equals: [a, b]\n",
+			expectedError: "Unsupported section 'Conditions'",
+		},
+		"short form intrinsic function": {
+			extension:     "Resources:\n  Topic:\n    Type: AWS::SNS::Topic\n    Properties:\n      TopicName: !Sub '${AWS::StackName}-alarms'\n",
+			expectedError: "use 'Fn::Sub' instead of '!Sub'",
+		},
+		"short form Ref": {
+			extension:     "Outputs:\n  TopicArn:\n    Value: !Ref Topic\n",
+			expectedError: "use 'Ref' instead of '!Ref'",
+		},
+		"section which is not a mapping": {
+			extension:     "Resources:\n  - Topic\n",
+			expectedError: "The Resources section of the template extension must be a mapping",
+		},
+		"not a mapping": {
+			extension:     "- Resources\n",
+			expectedError: "must be a mapping of template sections",
+		},
+		"invalid YAML": {
+			extension:     "Resources: [\n",
+			expectedError: "Error parsing template extension",
+		},
+	}
+
+	template, err := GetClusterTemplate(nil, "my-stack", nil)
+	assert.NoError(t, err, "Unexpected error building cluster template")
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := MergeTemplateExtension(template, []byte(test.extension))
+			if assert.Error(t, err, "Expected error merging template extension") {
+				assert.Contains(t, err.Error(), test.expectedError)
+			}
+		})
+	}
+}
@@ -29,6 +29,7 @@ type EC2Client interface {
 	DescribeInstances(ec2InstanceIds []*string) (map[string]*ec2.Instance, error)
 	DescribeNetworkInterfaces(networkInterfaceIDs []*string) ([]*ec2.NetworkInterface, error)
 	DescribeInstanceTypeOfferings(location string) ([]string, error)
+	TerminateInstances(ec2InstanceIds []*string) error
 }
 
 // ec2Client implements EC2Client
@@ -113,3 +114,15 @@ func (c *ec2Client) DescribeInstanceTypeOfferings(region string) ([]string, erro
 	}
 	return instanceTypes, nil
 }
+
+// TerminateInstances terminates the given EC2 instances. Instances of an Auto
+// Scaling group are replaced by the group to keep its desired capacity.
+func (c *ec2Client) TerminateInstances(ec2InstanceIds []*string) error {
+	if len(ec2InstanceIds) == 0 {
+		return nil
+	}
+	_, err := c.client.TerminateInstances(&ec2.TerminateInstancesInput{
+		InstanceIds: ec2InstanceIds,
+	})
+	return err
+}
@@ -169,6 +169,26 @@ func TestDescribeInstanceTypeOfferingsWithEmptyResult(t *testing.T) {
 	assert.Error(t, err, "Expected error while no region found")
 }
 
+func TestTerminateInstances(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	ids := []*string{aws.String("id1"), aws.String("id2")}
+	mockEC2.EXPECT().TerminateInstances(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ec2.TerminateInstancesInput)
+		assert.Equal(t, ids, req.InstanceIds, "Expected instance ids to match")
+	}).Return(&ec2.TerminateInstancesOutput{}, nil)
+
+	err := client.TerminateInstances(ids)
+	assert.NoError(t, err, "Unexpected error when calling TerminateInstances")
+}
+
+func TestTerminateInstancesWithEmptyList(t *testing.T) {
+	_, client := setupTest(t)
+
+	err := client.TerminateInstances([]*string{})
+	assert.NoError(t, err, "Expected no error for empty input")
+}
+
 func setupTest(t *testing.T) (*mock_ec2iface.MockEC2API, EC2Client) {
 	ctrl := gomock.NewController(t)
 	// TODO will having defer within scope of this function call the
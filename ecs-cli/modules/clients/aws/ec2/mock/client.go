@@ -91,3 +91,17 @@ func (mr *MockEC2ClientMockRecorder) DescribeNetworkInterfaces(arg0 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNetworkInterfaces", reflect.TypeOf((*MockEC2Client)(nil).DescribeNetworkInterfaces), arg0)
 }
+
+// TerminateInstances mocks base method
+func (m *MockEC2Client) TerminateInstances(arg0 []*string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminateInstances", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TerminateInstances indicates an expected call of TerminateInstances
+func (mr *MockEC2ClientMockRecorder) TerminateInstances(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateInstances", reflect.TypeOf((*MockEC2Client)(nil).TerminateInstances), arg0)
+}
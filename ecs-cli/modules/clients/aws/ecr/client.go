@@ -14,11 +14,16 @@
 package ecr
 
 import (
+	"encoding/base64"
+	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
@@ -29,21 +34,66 @@ import (
 
 const (
 	CacheDir = "~/.ecs"
+
+	// batchDeleteImageLimit is the maximum number of images in a BatchDeleteImage call
+	batchDeleteImageLimit = 100
 )
 
+// registryDomainRegexp matches the domain of an ECR registry, capturing the account and region
+var registryDomainRegexp = regexp.MustCompile(`^(\d{12})\.dkr\.ecr(?:-fips)?\.([a-z0-9-]+)\.amazonaws\.com(?:\.cn)?$`)
+
+// ParseRegistryDomain returns the registry ID and region of an ECR registry
+// domain, and false if the domain is not that of an ECR registry
+func ParseRegistryDomain(domain string) (registryID, region string, ok bool) {
+	matches := registryDomainRegexp.FindStringSubmatch(domain)
+	if matches == nil {
+		return "", "", false
+	}
+	return matches[1], matches[2], true
+}
+
 // ProcessImageDetails callback function for describe images
 type ProcessImageDetails func(images []*ecr.ImageDetail) error
 
 // ProcessRepositories callback function for describe repositories
 type ProcessRepositories func(repositories []*string) error
 
+// ProcessRepositoryDetails callback function for listing repositories
+type ProcessRepositoryDetails func(repositories []*ecr.Repository) error
+
+// RepositorySettings contains the settings of a new repository
+type RepositorySettings struct {
+	ImageTagMutability string
+	ScanOnPush         bool
+	KMSKey             string // repositories are encrypted with AES-256 if no key is given
+	Policy             string
+	Tags               map[string]*string
+}
+
 // Client ECR interface
 type Client interface {
 	GetAuthorizationToken(registryURI string) (*Auth, error)
 	GetAuthorizationTokenByID(registryID string) (*Auth, error)
+	GetAuthorizationData(registryID string) (*Auth, error)
 	CreateRepository(repositoryName string) (string, error)
 	RepositoryExists(repositoryName string) bool
 	GetImages(repositoryNames []*string, tagStatus string, registryID string, processFn ProcessImageDetails) error
+	GetImageDigest(registryID, repositoryName, tag string) (string, error)
+	CreateRepositoryWithSettings(repositoryName string, settings RepositorySettings) (*ecr.Repository, error)
+	DescribeRepository(registryID, repositoryName string) (*ecr.Repository, error)
+	ListRepositories(registryID string, processFn ProcessRepositoryDetails) error
+	DeleteRepository(registryID, repositoryName string) error
+	GetRepositoryPolicy(registryID, repositoryName string) (string, error)
+	ListRepositoryTags(repositoryARN string) ([]*ecr.Tag, error)
+	DeleteImages(registryID, repositoryName string, imageIDs []*ecr.ImageIdentifier) ([]*ecr.ImageFailure, error)
+	GetLifecyclePolicy(registryID, repositoryName string) (string, error)
+	PutLifecyclePolicy(registryID, repositoryName, policy string) error
+	PreviewLifecyclePolicy(registryID, repositoryName, policy string) ([]*ecr.LifecyclePolicyPreviewResult, error)
+	ScanImage(registryID, repositoryName string, imageID *ecr.ImageIdentifier) error
+	GetImageScanFindings(registryID, repositoryName string, imageID *ecr.ImageIdentifier) (*ImageScanResult, error)
+	CreatePullThroughCacheRule(registryID string, rule PullThroughCacheRule) (*ecr.CreatePullThroughCacheRuleOutput, error)
+	ListPullThroughCacheRules(registryID string) ([]*ecr.PullThroughCacheRule, error)
+	DeletePullThroughCacheRule(registryID, repositoryPrefix string) error
 }
 
 // ecrClient implements Client
@@ -119,6 +169,7 @@ type Auth struct {
 	Registry      string
 	Username      string
 	Password      string
+	ExpiresAt     time.Time // only set by GetAuthorizationData
 }
 
 func (c *ecrClient) GetAuthorizationTokenByID(registryID string) (*Auth, error) {
@@ -138,6 +189,44 @@ func (c *ecrClient) GetAuthorizationTokenByID(registryID string) (*Auth, error)
 	}, nil
 }
 
+// GetAuthorizationData gets an authorization token for a registry from the
+// ECR API, bypassing the token cache of the login client, so that the expiry
+// of the token is known
+func (c *ecrClient) GetAuthorizationData(registryID string) (*Auth, error) {
+	log.Debug("Getting authorization data...")
+
+	input := &ecr.GetAuthorizationTokenInput{}
+	if registryID != "" {
+		input.SetRegistryIds(aws.StringSlice([]string{registryID}))
+	}
+	resp, err := c.client.GetAuthorizationToken(input)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get authorization token")
+	}
+	if len(resp.AuthorizationData) == 0 {
+		return nil, errors.New("no authorization data returned")
+	}
+
+	data := resp.AuthorizationData[0]
+	decoded, err := base64.StdEncoding.DecodeString(aws.StringValue(data.AuthorizationToken))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decode authorization token")
+	}
+	parts := strings.SplitN(string(decoded), ":", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("invalid authorization token")
+	}
+
+	proxyEndpoint := aws.StringValue(data.ProxyEndpoint)
+	return &Auth{
+		Username:      parts[0],
+		Password:      parts[1],
+		ProxyEndpoint: proxyEndpoint,
+		Registry:      strings.Replace(proxyEndpoint, "https://", "", -1),
+		ExpiresAt:     aws.TimeValue(data.ExpiresAt),
+	}, nil
+}
+
 func (c *ecrClient) GetAuthorizationToken(registryURI string) (*Auth, error) {
 	log.Debug("Getting authorization token...")
 	auth, err := c.loginClient.GetCredentials(registryURI)
@@ -180,6 +269,266 @@ func (c *ecrClient) CreateRepository(repositoryName string) (string, error) {
 	return aws.StringValue(resp.Repository.RepositoryName), nil
 }
 
+// CreateRepositoryWithSettings creates a repository with the given settings
+// and sets its policy, if one is given
+func (c *ecrClient) CreateRepositoryWithSettings(repositoryName string, settings RepositorySettings) (*ecr.Repository, error) {
+	log.WithFields(log.Fields{
+		"repository": repositoryName,
+	}).Info("Creating repository")
+
+	input := &ecr.CreateRepositoryInput{
+		RepositoryName: aws.String(repositoryName),
+		ImageScanningConfiguration: &ecr.ImageScanningConfiguration{
+			ScanOnPush: aws.Bool(settings.ScanOnPush),
+		},
+	}
+	if settings.ImageTagMutability != "" {
+		input.ImageTagMutability = aws.String(settings.ImageTagMutability)
+	}
+	if settings.KMSKey != "" {
+		input.EncryptionConfiguration = &ecr.EncryptionConfiguration{
+			EncryptionType: aws.String(ecr.EncryptionTypeKms),
+			KmsKey:         aws.String(settings.KMSKey),
+		}
+	}
+	tagKeys := make([]string, 0, len(settings.Tags))
+	for key := range settings.Tags {
+		tagKeys = append(tagKeys, key)
+	}
+	sort.Strings(tagKeys)
+	for _, key := range tagKeys {
+		input.Tags = append(input.Tags, &ecr.Tag{
+			Key:   aws.String(key),
+			Value: settings.Tags[key],
+		})
+	}
+
+	resp, err := c.client.CreateRepository(input)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create repository")
+	}
+	if resp == nil || resp.Repository == nil {
+		return nil, errors.New("create repository response is empty")
+	}
+	log.Info("Repository created")
+
+	if settings.Policy != "" {
+		_, err = c.client.SetRepositoryPolicy(&ecr.SetRepositoryPolicyInput{
+			RepositoryName: aws.String(repositoryName),
+			PolicyText:     aws.String(settings.Policy),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to set policy of repository %s", repositoryName)
+		}
+		log.Info("Repository policy set")
+	}
+	return resp.Repository, nil
+}
+
+// DescribeRepository returns the details of a repository
+func (c *ecrClient) DescribeRepository(registryID, repositoryName string) (*ecr.Repository, error) {
+	input := &ecr.DescribeRepositoriesInput{
+		RepositoryNames: aws.StringSlice([]string{repositoryName}),
+	}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+
+	resp, err := c.client.DescribeRepositories(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to describe repository %s", repositoryName)
+	}
+	if len(resp.Repositories) == 0 {
+		return nil, errors.Errorf("repository %s not found", repositoryName)
+	}
+	return resp.Repositories[0], nil
+}
+
+// ListRepositories calls processFn with each page of the repositories in the registry
+func (c *ecrClient) ListRepositories(registryID string, processFn ProcessRepositoryDetails) error {
+	var outErr error
+	input := &ecr.DescribeRepositoriesInput{}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+
+	err := c.client.DescribeRepositoriesPages(input, func(resp *ecr.DescribeRepositoriesOutput, lastPage bool) bool {
+		if outErr = processFn(resp.Repositories); outErr != nil {
+			return false
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to list repositories")
+	}
+	return outErr
+}
+
+// DeleteRepository deletes a repository along with any images in it
+func (c *ecrClient) DeleteRepository(registryID, repositoryName string) error {
+	log.WithFields(log.Fields{
+		"repository": repositoryName,
+	}).Info("Deleting repository")
+
+	input := &ecr.DeleteRepositoryInput{
+		RepositoryName: aws.String(repositoryName),
+		Force:          aws.Bool(true),
+	}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+
+	if _, err := c.client.DeleteRepository(input); err != nil {
+		return errors.Wrapf(err, "unable to delete repository %s", repositoryName)
+	}
+	log.Info("Repository deleted")
+	return nil
+}
+
+// GetRepositoryPolicy returns the policy of a repository, or an empty string
+// if it has none
+func (c *ecrClient) GetRepositoryPolicy(registryID, repositoryName string) (string, error) {
+	input := &ecr.GetRepositoryPolicyInput{
+		RepositoryName: aws.String(repositoryName),
+	}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+
+	resp, err := c.client.GetRepositoryPolicy(input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ecr.ErrCodeRepositoryPolicyNotFoundException {
+			return "", nil
+		}
+		return "", errors.Wrapf(err, "unable to get policy of repository %s", repositoryName)
+	}
+	return aws.StringValue(resp.PolicyText), nil
+}
+
+// ListRepositoryTags returns the tags of a repository
+func (c *ecrClient) ListRepositoryTags(repositoryARN string) ([]*ecr.Tag, error) {
+	resp, err := c.client.ListTagsForResource(&ecr.ListTagsForResourceInput{
+		ResourceArn: aws.String(repositoryARN),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list tags of repository %s", repositoryARN)
+	}
+	return resp.Tags, nil
+}
+
+// DeleteImages deletes images from a repository in batches of at most
+// batchDeleteImageLimit, and returns the images which could not be deleted
+func (c *ecrClient) DeleteImages(registryID, repositoryName string, imageIDs []*ecr.ImageIdentifier) ([]*ecr.ImageFailure, error) {
+	failures := []*ecr.ImageFailure{}
+	for start := 0; start < len(imageIDs); start += batchDeleteImageLimit {
+		end := start + batchDeleteImageLimit
+		if end > len(imageIDs) {
+			end = len(imageIDs)
+		}
+		log.WithFields(log.Fields{
+			"repository": repositoryName,
+			"count":      end - start,
+		}).Debug("Deleting images")
+
+		input := &ecr.BatchDeleteImageInput{
+			RepositoryName: aws.String(repositoryName),
+			ImageIds:       imageIDs[start:end],
+		}
+		if registryID != "" {
+			input.SetRegistryId(registryID)
+		}
+
+		resp, err := c.client.BatchDeleteImage(input)
+		if err != nil {
+			return failures, errors.Wrapf(err, "unable to delete images from repository %s", repositoryName)
+		}
+		failures = append(failures, resp.Failures...)
+	}
+	return failures, nil
+}
+
+// GetLifecyclePolicy returns the lifecycle policy of a repository, or an
+// empty string if it has none
+func (c *ecrClient) GetLifecyclePolicy(registryID, repositoryName string) (string, error) {
+	input := &ecr.GetLifecyclePolicyInput{
+		RepositoryName: aws.String(repositoryName),
+	}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+
+	resp, err := c.client.GetLifecyclePolicy(input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ecr.ErrCodeLifecyclePolicyNotFoundException {
+			return "", nil
+		}
+		return "", errors.Wrapf(err, "unable to get lifecycle policy of repository %s", repositoryName)
+	}
+	return aws.StringValue(resp.LifecyclePolicyText), nil
+}
+
+// PutLifecyclePolicy sets the lifecycle policy of a repository
+func (c *ecrClient) PutLifecyclePolicy(registryID, repositoryName, policy string) error {
+	log.WithFields(log.Fields{
+		"repository": repositoryName,
+	}).Info("Setting lifecycle policy")
+
+	input := &ecr.PutLifecyclePolicyInput{
+		RepositoryName:      aws.String(repositoryName),
+		LifecyclePolicyText: aws.String(policy),
+	}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+
+	if _, err := c.client.PutLifecyclePolicy(input); err != nil {
+		return errors.Wrapf(err, "unable to set lifecycle policy of repository %s", repositoryName)
+	}
+	return nil
+}
+
+// PreviewLifecyclePolicy returns the images which would expire if the given
+// lifecycle policy were set on a repository. If the policy is empty, the
+// current lifecycle policy of the repository is previewed.
+func (c *ecrClient) PreviewLifecyclePolicy(registryID, repositoryName, policy string) ([]*ecr.LifecyclePolicyPreviewResult, error) {
+	log.WithFields(log.Fields{
+		"repository": repositoryName,
+	}).Info("Previewing lifecycle policy")
+
+	startInput := &ecr.StartLifecyclePolicyPreviewInput{
+		RepositoryName: aws.String(repositoryName),
+	}
+	if policy != "" {
+		startInput.SetLifecyclePolicyText(policy)
+	}
+	if registryID != "" {
+		startInput.SetRegistryId(registryID)
+	}
+	if _, err := c.client.StartLifecyclePolicyPreview(startInput); err != nil {
+		return nil, errors.Wrapf(err, "unable to start lifecycle policy preview of repository %s", repositoryName)
+	}
+
+	input := &ecr.GetLifecyclePolicyPreviewInput{
+		RepositoryName: aws.String(repositoryName),
+	}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+	if err := c.client.WaitUntilLifecyclePolicyPreviewComplete(input); err != nil {
+		return nil, errors.Wrapf(err, "lifecycle policy preview of repository %s did not complete", repositoryName)
+	}
+
+	results := []*ecr.LifecyclePolicyPreviewResult{}
+	err := c.client.GetLifecyclePolicyPreviewPages(input, func(resp *ecr.GetLifecyclePolicyPreviewOutput, lastPage bool) bool {
+		results = append(results, resp.PreviewResults...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get lifecycle policy preview of repository %s", repositoryName)
+	}
+	return results, nil
+}
+
 func (c *ecrClient) GetImages(repositoryNames []*string, tagStatus string, registryID string, processFn ProcessImageDetails) error {
 	log.Debug("Getting images from ECR...")
 	pageNumber := 0
@@ -197,6 +546,31 @@ func (c *ecrClient) GetImages(repositoryNames []*string, tagStatus string, regis
 	return err
 }
 
+// GetImageDigest returns the digest of the image with the given tag in a repository
+func (c *ecrClient) GetImageDigest(registryID, repositoryName, tag string) (string, error) {
+	log.WithFields(log.Fields{
+		"repository": repositoryName,
+		"tag":        tag,
+	}).Debug("Getting image digest")
+
+	input := &ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repositoryName),
+		ImageIds:       []*ecr.ImageIdentifier{{ImageTag: aws.String(tag)}},
+	}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+
+	resp, err := c.client.DescribeImages(input)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to describe image %s:%s", repositoryName, tag)
+	}
+	if len(resp.ImageDetails) == 0 || aws.StringValue(resp.ImageDetails[0].ImageDigest) == "" {
+		return "", errors.Errorf("image %s:%s not found", repositoryName, tag)
+	}
+	return aws.StringValue(resp.ImageDetails[0].ImageDigest), nil
+}
+
 func (c *ecrClient) describeRepositories(repositoryNames []*string, registryID string, outputFn ProcessRepositories) error {
 	var outErr error
 
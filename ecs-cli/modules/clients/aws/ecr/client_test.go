@@ -14,13 +14,17 @@
 package ecr
 
 import (
+	"encoding/base64"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	mock_login "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr/mock/credential-helper"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr/mock/sdk"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	login "github.com/awslabs/amazon-ecr-credential-helper/ecr-login/api"
@@ -99,6 +103,49 @@ func TestGetAuthorizationTokenByIDErrorCase(t *testing.T) {
 	assert.Error(t, err, "Expected error while GetAuthorizationToken is called")
 }
 
+func TestGetAuthorizationData(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	expiresAt := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+	mockEcr.EXPECT().GetAuthorizationToken(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecr.GetAuthorizationTokenInput)
+		assert.Equal(t, []*string{aws.String(registryID)}, req.RegistryIds, "Expected registryID to match")
+	}).Return(&ecr.GetAuthorizationTokenOutput{
+		AuthorizationData: []*ecr.AuthorizationData{
+			{
+				AuthorizationToken: aws.String(base64.StdEncoding.EncodeToString([]byte("AWS:secret"))),
+				ProxyEndpoint:      aws.String("https://" + registryID + ".dkr.ecr.us-west-2.amazonaws.com"),
+				ExpiresAt:          &expiresAt,
+			},
+		},
+	}, nil)
+
+	auth, err := client.GetAuthorizationData(registryID)
+	assert.NoError(t, err, "Get Authorization Data should not fail")
+	assert.Equal(t, &Auth{
+		Username:      "AWS",
+		Password:      "secret",
+		ProxyEndpoint: "https://" + registryID + ".dkr.ecr.us-west-2.amazonaws.com",
+		Registry:      registryID + ".dkr.ecr.us-west-2.amazonaws.com",
+		ExpiresAt:     expiresAt,
+	}, auth)
+}
+
+func TestGetAuthorizationDataInvalidToken(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().GetAuthorizationToken(gomock.Any()).Return(&ecr.GetAuthorizationTokenOutput{
+		AuthorizationData: []*ecr.AuthorizationData{
+			{AuthorizationToken: aws.String(base64.StdEncoding.EncodeToString([]byte("no-separator")))},
+		},
+	}, nil)
+
+	_, err := client.GetAuthorizationData(registryID)
+	assert.Error(t, err, "Expected error for an invalid token")
+}
+
 func TestRepositoryExists(t *testing.T) {
 	mockEcr, _, client, ctrl := setupTestController(t)
 	defer ctrl.Finish()
@@ -278,6 +325,270 @@ func TestDescribeImageErrorCase(t *testing.T) {
 	assert.Error(t, err, "Get Images should fail")
 }
 
+func TestGetImageDigest(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().DescribeImages(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecr.DescribeImagesInput)
+		assert.Equal(t, repositoryName, aws.StringValue(req.RepositoryName), "Expected repositoryName to match")
+		assert.Equal(t, registryID, aws.StringValue(req.RegistryId), "Expected registryID to match")
+		assert.Equal(t, "v1", aws.StringValue(req.ImageIds[0].ImageTag), "Expected tag to match")
+	}).Return(&ecr.DescribeImagesOutput{
+		ImageDetails: []*ecr.ImageDetail{{ImageDigest: aws.String("sha256:abc")}},
+	}, nil)
+
+	digest, err := client.GetImageDigest("123456789012", repositoryName, "v1")
+	assert.NoError(t, err, "Unexpected error when getting image digest")
+	assert.Equal(t, "sha256:abc", digest)
+}
+
+func TestGetImageDigestErrorCase(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().DescribeImages(gomock.Any()).Return(nil, errors.New("something failed"))
+
+	_, err := client.GetImageDigest("", repositoryName, "v1")
+	assert.Error(t, err, "Expected error when DescribeImages fails")
+}
+
+func TestCreateRepositoryWithSettings(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	policy := `{"Version":"2012-10-17","Statement":[]}`
+	gomock.InOrder(
+		mockEcr.EXPECT().CreateRepository(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecr.CreateRepositoryInput)
+			assert.Equal(t, repositoryName, aws.StringValue(req.RepositoryName), "Expected repositoryName to match")
+			assert.Equal(t, ecr.ImageTagMutabilityImmutable, aws.StringValue(req.ImageTagMutability), "Expected tag mutability to match")
+			assert.True(t, aws.BoolValue(req.ImageScanningConfiguration.ScanOnPush), "Expected scan on push")
+			assert.Equal(t, ecr.EncryptionTypeKms, aws.StringValue(req.EncryptionConfiguration.EncryptionType), "Expected KMS encryption")
+			assert.Equal(t, "alias/ecr", aws.StringValue(req.EncryptionConfiguration.KmsKey), "Expected KMS key to match")
+			assert.Equal(t, []*ecr.Tag{{Key: aws.String("team"), Value: aws.String("green")}}, req.Tags, "Expected tags to match")
+		}).Return(&ecr.CreateRepositoryOutput{Repository: &ecr.Repository{RepositoryName: aws.String(repositoryName)}}, nil),
+		mockEcr.EXPECT().SetRepositoryPolicy(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecr.SetRepositoryPolicyInput)
+			assert.Equal(t, repositoryName, aws.StringValue(req.RepositoryName), "Expected repositoryName to match")
+			assert.Equal(t, policy, aws.StringValue(req.PolicyText), "Expected policy to match")
+		}).Return(&ecr.SetRepositoryPolicyOutput{}, nil),
+	)
+
+	repository, err := client.CreateRepositoryWithSettings(repositoryName, RepositorySettings{
+		ImageTagMutability: ecr.ImageTagMutabilityImmutable,
+		ScanOnPush:         true,
+		KMSKey:             "alias/ecr",
+		Policy:             policy,
+		Tags:               map[string]*string{"team": aws.String("green")},
+	})
+	assert.NoError(t, err, "Create Repository should not fail")
+	assert.Equal(t, repositoryName, aws.StringValue(repository.RepositoryName))
+}
+
+func TestCreateRepositoryWithSettingsDefaults(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().CreateRepository(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecr.CreateRepositoryInput)
+		assert.Nil(t, req.ImageTagMutability, "Expected default tag mutability")
+		assert.Nil(t, req.EncryptionConfiguration, "Expected default encryption")
+		assert.False(t, aws.BoolValue(req.ImageScanningConfiguration.ScanOnPush), "Expected no scan on push")
+	}).Return(&ecr.CreateRepositoryOutput{Repository: &ecr.Repository{RepositoryName: aws.String(repositoryName)}}, nil)
+
+	_, err := client.CreateRepositoryWithSettings(repositoryName, RepositorySettings{})
+	assert.NoError(t, err, "Create Repository should not fail")
+}
+
+func TestDescribeRepository(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().DescribeRepositories(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecr.DescribeRepositoriesInput)
+		assert.Equal(t, repositoryName, aws.StringValue(req.RepositoryNames[0]), "Expected repositoryName to match")
+		assert.Equal(t, registryID, aws.StringValue(req.RegistryId), "Expected registryID to match")
+	}).Return(&ecr.DescribeRepositoriesOutput{Repositories: []*ecr.Repository{{RepositoryName: aws.String(repositoryName)}}}, nil)
+
+	repository, err := client.DescribeRepository("123456789012", repositoryName)
+	assert.NoError(t, err, "Describe Repository should not fail")
+	assert.Equal(t, repositoryName, aws.StringValue(repository.RepositoryName))
+}
+
+func TestListRepositories(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().DescribeRepositoriesPages(gomock.Any(), gomock.Any()).Do(func(_, fn interface{}) {
+		pageFn := fn.(func(*ecr.DescribeRepositoriesOutput, bool) bool)
+		pageFn(&ecr.DescribeRepositoriesOutput{Repositories: []*ecr.Repository{{RepositoryName: aws.String("a")}}}, false)
+		pageFn(&ecr.DescribeRepositoriesOutput{Repositories: []*ecr.Repository{{RepositoryName: aws.String("b")}}}, true)
+	}).Return(nil)
+
+	var names []string
+	err := client.ListRepositories("", func(repositories []*ecr.Repository) error {
+		for _, repository := range repositories {
+			names = append(names, aws.StringValue(repository.RepositoryName))
+		}
+		return nil
+	})
+	assert.NoError(t, err, "List Repositories should not fail")
+	assert.Equal(t, []string{"a", "b"}, names)
+}
+
+func TestDeleteRepository(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().DeleteRepository(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecr.DeleteRepositoryInput)
+		assert.Equal(t, repositoryName, aws.StringValue(req.RepositoryName), "Expected repositoryName to match")
+		assert.True(t, aws.BoolValue(req.Force), "Expected images to be deleted with the repository")
+	}).Return(&ecr.DeleteRepositoryOutput{}, nil)
+
+	err := client.DeleteRepository("", repositoryName)
+	assert.NoError(t, err, "Delete Repository should not fail")
+}
+
+func TestGetRepositoryPolicyNotFound(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().GetRepositoryPolicy(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeRepositoryPolicyNotFoundException, "no policy", nil))
+
+	policy, err := client.GetRepositoryPolicy("", repositoryName)
+	assert.NoError(t, err, "Expected no error for a repository without a policy")
+	assert.Empty(t, policy)
+}
+
+func TestGetRepositoryPolicyErrorCase(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().GetRepositoryPolicy(gomock.Any()).Return(nil, errors.New("something failed"))
+
+	_, err := client.GetRepositoryPolicy("", repositoryName)
+	assert.Error(t, err, "Expected error while GetRepositoryPolicy is called")
+}
+
+func TestDeleteImagesBatches(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	imageIDs := []*ecr.ImageIdentifier{}
+	for i := 0; i < 150; i++ {
+		imageIDs = append(imageIDs, &ecr.ImageIdentifier{ImageDigest: aws.String(fmt.Sprintf("sha256:%d", i))})
+	}
+	failure := &ecr.ImageFailure{ImageId: imageIDs[120], FailureCode: aws.String(ecr.ImageFailureCodeImageNotFound)}
+
+	gomock.InOrder(
+		mockEcr.EXPECT().BatchDeleteImage(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecr.BatchDeleteImageInput)
+			assert.Equal(t, repositoryName, aws.StringValue(req.RepositoryName), "Expected repositoryName to match")
+			assert.Equal(t, imageIDs[:100], req.ImageIds, "Expected first batch of images")
+		}).Return(&ecr.BatchDeleteImageOutput{}, nil),
+		mockEcr.EXPECT().BatchDeleteImage(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecr.BatchDeleteImageInput)
+			assert.Equal(t, imageIDs[100:], req.ImageIds, "Expected second batch of images")
+		}).Return(&ecr.BatchDeleteImageOutput{Failures: []*ecr.ImageFailure{failure}}, nil),
+	)
+
+	failures, err := client.DeleteImages("", repositoryName, imageIDs)
+	assert.NoError(t, err, "Delete Images should not fail")
+	assert.Equal(t, []*ecr.ImageFailure{failure}, failures)
+}
+
+func TestDeleteImagesErrorCase(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().BatchDeleteImage(gomock.Any()).Return(nil, errors.New("something failed"))
+
+	_, err := client.DeleteImages("", repositoryName, []*ecr.ImageIdentifier{{ImageDigest: aws.String("sha256:0")}})
+	assert.Error(t, err, "Expected error while BatchDeleteImage is called")
+}
+
+func TestGetLifecyclePolicy(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().GetLifecyclePolicy(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecr.GetLifecyclePolicyInput)
+		assert.Equal(t, repositoryName, aws.StringValue(req.RepositoryName), "Expected repositoryName to match")
+		assert.Equal(t, registryID, aws.StringValue(req.RegistryId), "Expected registryID to match")
+	}).Return(&ecr.GetLifecyclePolicyOutput{LifecyclePolicyText: aws.String(`{"rules":[]}`)}, nil)
+
+	policy, err := client.GetLifecyclePolicy(registryID, repositoryName)
+	assert.NoError(t, err, "Get Lifecycle Policy should not fail")
+	assert.Equal(t, `{"rules":[]}`, policy)
+}
+
+func TestGetLifecyclePolicyNotFound(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().GetLifecyclePolicy(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeLifecyclePolicyNotFoundException, "no policy", nil))
+
+	policy, err := client.GetLifecyclePolicy("", repositoryName)
+	assert.NoError(t, err, "Expected no error for a repository without a lifecycle policy")
+	assert.Empty(t, policy)
+}
+
+func TestPutLifecyclePolicy(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().PutLifecyclePolicy(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecr.PutLifecyclePolicyInput)
+		assert.Equal(t, repositoryName, aws.StringValue(req.RepositoryName), "Expected repositoryName to match")
+		assert.Equal(t, `{"rules":[]}`, aws.StringValue(req.LifecyclePolicyText), "Expected policy to match")
+	}).Return(&ecr.PutLifecyclePolicyOutput{}, nil)
+
+	err := client.PutLifecyclePolicy("", repositoryName, `{"rules":[]}`)
+	assert.NoError(t, err, "Put Lifecycle Policy should not fail")
+}
+
+func TestPreviewLifecyclePolicy(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	result := &ecr.LifecyclePolicyPreviewResult{ImageDigest: aws.String("sha256:0")}
+	gomock.InOrder(
+		mockEcr.EXPECT().StartLifecyclePolicyPreview(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecr.StartLifecyclePolicyPreviewInput)
+			assert.Equal(t, repositoryName, aws.StringValue(req.RepositoryName), "Expected repositoryName to match")
+			assert.Equal(t, `{"rules":[]}`, aws.StringValue(req.LifecyclePolicyText), "Expected policy to match")
+		}).Return(&ecr.StartLifecyclePolicyPreviewOutput{}, nil),
+		mockEcr.EXPECT().WaitUntilLifecyclePolicyPreviewComplete(gomock.Any()).Return(nil),
+		mockEcr.EXPECT().GetLifecyclePolicyPreviewPages(gomock.Any(), gomock.Any()).Do(func(_ interface{}, fn interface{}) {
+			fn.(func(*ecr.GetLifecyclePolicyPreviewOutput, bool) bool)(&ecr.GetLifecyclePolicyPreviewOutput{
+				PreviewResults: []*ecr.LifecyclePolicyPreviewResult{result},
+			}, true)
+		}).Return(nil),
+	)
+
+	results, err := client.PreviewLifecyclePolicy("", repositoryName, `{"rules":[]}`)
+	assert.NoError(t, err, "Preview Lifecycle Policy should not fail")
+	assert.Equal(t, []*ecr.LifecyclePolicyPreviewResult{result}, results)
+}
+
+func TestPreviewLifecyclePolicyCurrentPolicy(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockEcr.EXPECT().StartLifecyclePolicyPreview(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecr.StartLifecyclePolicyPreviewInput)
+			assert.Nil(t, req.LifecyclePolicyText, "Expected the current policy to be previewed")
+		}).Return(&ecr.StartLifecyclePolicyPreviewOutput{}, nil),
+		mockEcr.EXPECT().WaitUntilLifecyclePolicyPreviewComplete(gomock.Any()).Return(errors.New("preview failed")),
+	)
+
+	_, err := client.PreviewLifecyclePolicy("", repositoryName, "")
+	assert.Error(t, err, "Expected error when the preview fails")
+}
+
 func setupTestController(t *testing.T) (*mock_ecriface.MockECRAPI, *mock_login.MockClient, Client, *gomock.Controller) {
 	ctrl := gomock.NewController(t)
 	mockEcr := mock_ecriface.NewMockECRAPI(ctrl)
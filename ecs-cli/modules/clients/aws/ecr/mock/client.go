@@ -21,6 +21,7 @@ import (
 	reflect "reflect"
 
 	ecr "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	ecr0 "github.com/aws/aws-sdk-go/service/ecr"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -47,6 +48,21 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CreatePullThroughCacheRule mocks base method
+func (m *MockClient) CreatePullThroughCacheRule(arg0 string, arg1 ecr.PullThroughCacheRule) (*ecr0.CreatePullThroughCacheRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePullThroughCacheRule", arg0, arg1)
+	ret0, _ := ret[0].(*ecr0.CreatePullThroughCacheRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePullThroughCacheRule indicates an expected call of CreatePullThroughCacheRule
+func (mr *MockClientMockRecorder) CreatePullThroughCacheRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullThroughCacheRule", reflect.TypeOf((*MockClient)(nil).CreatePullThroughCacheRule), arg0, arg1)
+}
+
 // CreateRepository mocks base method
 func (m *MockClient) CreateRepository(arg0 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -62,6 +78,94 @@ func (mr *MockClientMockRecorder) CreateRepository(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRepository", reflect.TypeOf((*MockClient)(nil).CreateRepository), arg0)
 }
 
+// CreateRepositoryWithSettings mocks base method
+func (m *MockClient) CreateRepositoryWithSettings(arg0 string, arg1 ecr.RepositorySettings) (*ecr0.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRepositoryWithSettings", arg0, arg1)
+	ret0, _ := ret[0].(*ecr0.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRepositoryWithSettings indicates an expected call of CreateRepositoryWithSettings
+func (mr *MockClientMockRecorder) CreateRepositoryWithSettings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRepositoryWithSettings", reflect.TypeOf((*MockClient)(nil).CreateRepositoryWithSettings), arg0, arg1)
+}
+
+// DeleteImages mocks base method
+func (m *MockClient) DeleteImages(arg0, arg1 string, arg2 []*ecr0.ImageIdentifier) ([]*ecr0.ImageFailure, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImages", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*ecr0.ImageFailure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImages indicates an expected call of DeleteImages
+func (mr *MockClientMockRecorder) DeleteImages(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImages", reflect.TypeOf((*MockClient)(nil).DeleteImages), arg0, arg1, arg2)
+}
+
+// DeletePullThroughCacheRule mocks base method
+func (m *MockClient) DeletePullThroughCacheRule(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePullThroughCacheRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePullThroughCacheRule indicates an expected call of DeletePullThroughCacheRule
+func (mr *MockClientMockRecorder) DeletePullThroughCacheRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePullThroughCacheRule", reflect.TypeOf((*MockClient)(nil).DeletePullThroughCacheRule), arg0, arg1)
+}
+
+// DeleteRepository mocks base method
+func (m *MockClient) DeleteRepository(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRepository", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRepository indicates an expected call of DeleteRepository
+func (mr *MockClientMockRecorder) DeleteRepository(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepository", reflect.TypeOf((*MockClient)(nil).DeleteRepository), arg0, arg1)
+}
+
+// DescribeRepository mocks base method
+func (m *MockClient) DescribeRepository(arg0, arg1 string) (*ecr0.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRepository", arg0, arg1)
+	ret0, _ := ret[0].(*ecr0.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRepository indicates an expected call of DescribeRepository
+func (mr *MockClientMockRecorder) DescribeRepository(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRepository", reflect.TypeOf((*MockClient)(nil).DescribeRepository), arg0, arg1)
+}
+
+// GetAuthorizationData mocks base method
+func (m *MockClient) GetAuthorizationData(arg0 string) (*ecr.Auth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorizationData", arg0)
+	ret0, _ := ret[0].(*ecr.Auth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorizationData indicates an expected call of GetAuthorizationData
+func (mr *MockClientMockRecorder) GetAuthorizationData(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizationData", reflect.TypeOf((*MockClient)(nil).GetAuthorizationData), arg0)
+}
+
 // GetAuthorizationToken mocks base method
 func (m *MockClient) GetAuthorizationToken(arg0 string) (*ecr.Auth, error) {
 	m.ctrl.T.Helper()
@@ -92,6 +196,36 @@ func (mr *MockClientMockRecorder) GetAuthorizationTokenByID(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizationTokenByID", reflect.TypeOf((*MockClient)(nil).GetAuthorizationTokenByID), arg0)
 }
 
+// GetImageDigest mocks base method
+func (m *MockClient) GetImageDigest(arg0, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImageDigest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImageDigest indicates an expected call of GetImageDigest
+func (mr *MockClientMockRecorder) GetImageDigest(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageDigest", reflect.TypeOf((*MockClient)(nil).GetImageDigest), arg0, arg1, arg2)
+}
+
+// GetImageScanFindings mocks base method
+func (m *MockClient) GetImageScanFindings(arg0, arg1 string, arg2 *ecr0.ImageIdentifier) (*ecr.ImageScanResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImageScanFindings", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*ecr.ImageScanResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImageScanFindings indicates an expected call of GetImageScanFindings
+func (mr *MockClientMockRecorder) GetImageScanFindings(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageScanFindings", reflect.TypeOf((*MockClient)(nil).GetImageScanFindings), arg0, arg1, arg2)
+}
+
 // GetImages mocks base method
 func (m *MockClient) GetImages(arg0 []*string, arg1, arg2 string, arg3 ecr.ProcessImageDetails) error {
 	m.ctrl.T.Helper()
@@ -106,6 +240,109 @@ func (mr *MockClientMockRecorder) GetImages(arg0, arg1, arg2, arg3 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImages", reflect.TypeOf((*MockClient)(nil).GetImages), arg0, arg1, arg2, arg3)
 }
 
+// GetLifecyclePolicy mocks base method
+func (m *MockClient) GetLifecyclePolicy(arg0, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLifecyclePolicy", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLifecyclePolicy indicates an expected call of GetLifecyclePolicy
+func (mr *MockClientMockRecorder) GetLifecyclePolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLifecyclePolicy", reflect.TypeOf((*MockClient)(nil).GetLifecyclePolicy), arg0, arg1)
+}
+
+// GetRepositoryPolicy mocks base method
+func (m *MockClient) GetRepositoryPolicy(arg0, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepositoryPolicy", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepositoryPolicy indicates an expected call of GetRepositoryPolicy
+func (mr *MockClientMockRecorder) GetRepositoryPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositoryPolicy", reflect.TypeOf((*MockClient)(nil).GetRepositoryPolicy), arg0, arg1)
+}
+
+// ListPullThroughCacheRules mocks base method
+func (m *MockClient) ListPullThroughCacheRules(arg0 string) ([]*ecr0.PullThroughCacheRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPullThroughCacheRules", arg0)
+	ret0, _ := ret[0].([]*ecr0.PullThroughCacheRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPullThroughCacheRules indicates an expected call of ListPullThroughCacheRules
+func (mr *MockClientMockRecorder) ListPullThroughCacheRules(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPullThroughCacheRules", reflect.TypeOf((*MockClient)(nil).ListPullThroughCacheRules), arg0)
+}
+
+// ListRepositories mocks base method
+func (m *MockClient) ListRepositories(arg0 string, arg1 ecr.ProcessRepositoryDetails) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositories", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListRepositories indicates an expected call of ListRepositories
+func (mr *MockClientMockRecorder) ListRepositories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositories", reflect.TypeOf((*MockClient)(nil).ListRepositories), arg0, arg1)
+}
+
+// ListRepositoryTags mocks base method
+func (m *MockClient) ListRepositoryTags(arg0 string) ([]*ecr0.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositoryTags", arg0)
+	ret0, _ := ret[0].([]*ecr0.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRepositoryTags indicates an expected call of ListRepositoryTags
+func (mr *MockClientMockRecorder) ListRepositoryTags(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoryTags", reflect.TypeOf((*MockClient)(nil).ListRepositoryTags), arg0)
+}
+
+// PreviewLifecyclePolicy mocks base method
+func (m *MockClient) PreviewLifecyclePolicy(arg0, arg1, arg2 string) ([]*ecr0.LifecyclePolicyPreviewResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewLifecyclePolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*ecr0.LifecyclePolicyPreviewResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewLifecyclePolicy indicates an expected call of PreviewLifecyclePolicy
+func (mr *MockClientMockRecorder) PreviewLifecyclePolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewLifecyclePolicy", reflect.TypeOf((*MockClient)(nil).PreviewLifecyclePolicy), arg0, arg1, arg2)
+}
+
+// PutLifecyclePolicy mocks base method
+func (m *MockClient) PutLifecyclePolicy(arg0, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutLifecyclePolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutLifecyclePolicy indicates an expected call of PutLifecyclePolicy
+func (mr *MockClientMockRecorder) PutLifecyclePolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutLifecyclePolicy", reflect.TypeOf((*MockClient)(nil).PutLifecyclePolicy), arg0, arg1, arg2)
+}
+
 // RepositoryExists mocks base method
 func (m *MockClient) RepositoryExists(arg0 string) bool {
 	m.ctrl.T.Helper()
@@ -119,3 +356,17 @@ func (mr *MockClientMockRecorder) RepositoryExists(arg0 interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepositoryExists", reflect.TypeOf((*MockClient)(nil).RepositoryExists), arg0)
 }
+
+// ScanImage mocks base method
+func (m *MockClient) ScanImage(arg0, arg1 string, arg2 *ecr0.ImageIdentifier) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanImage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScanImage indicates an expected call of ScanImage
+func (mr *MockClientMockRecorder) ScanImage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanImage", reflect.TypeOf((*MockClient)(nil).ScanImage), arg0, arg1, arg2)
+}
@@ -199,6 +199,56 @@ func (mr *MockECRAPIMockRecorder) BatchGetImageWithContext(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetImageWithContext", reflect.TypeOf((*MockECRAPI)(nil).BatchGetImageWithContext), varargs...)
 }
 
+// BatchGetRepositoryScanningConfiguration mocks base method
+func (m *MockECRAPI) BatchGetRepositoryScanningConfiguration(arg0 *ecr.BatchGetRepositoryScanningConfigurationInput) (*ecr.BatchGetRepositoryScanningConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetRepositoryScanningConfiguration", arg0)
+	ret0, _ := ret[0].(*ecr.BatchGetRepositoryScanningConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetRepositoryScanningConfiguration indicates an expected call of BatchGetRepositoryScanningConfiguration
+func (mr *MockECRAPIMockRecorder) BatchGetRepositoryScanningConfiguration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetRepositoryScanningConfiguration", reflect.TypeOf((*MockECRAPI)(nil).BatchGetRepositoryScanningConfiguration), arg0)
+}
+
+// BatchGetRepositoryScanningConfigurationRequest mocks base method
+func (m *MockECRAPI) BatchGetRepositoryScanningConfigurationRequest(arg0 *ecr.BatchGetRepositoryScanningConfigurationInput) (*request.Request, *ecr.BatchGetRepositoryScanningConfigurationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetRepositoryScanningConfigurationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.BatchGetRepositoryScanningConfigurationOutput)
+	return ret0, ret1
+}
+
+// BatchGetRepositoryScanningConfigurationRequest indicates an expected call of BatchGetRepositoryScanningConfigurationRequest
+func (mr *MockECRAPIMockRecorder) BatchGetRepositoryScanningConfigurationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetRepositoryScanningConfigurationRequest", reflect.TypeOf((*MockECRAPI)(nil).BatchGetRepositoryScanningConfigurationRequest), arg0)
+}
+
+// BatchGetRepositoryScanningConfigurationWithContext mocks base method
+func (m *MockECRAPI) BatchGetRepositoryScanningConfigurationWithContext(arg0 context.Context, arg1 *ecr.BatchGetRepositoryScanningConfigurationInput, arg2 ...request.Option) (*ecr.BatchGetRepositoryScanningConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchGetRepositoryScanningConfigurationWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.BatchGetRepositoryScanningConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetRepositoryScanningConfigurationWithContext indicates an expected call of BatchGetRepositoryScanningConfigurationWithContext
+func (mr *MockECRAPIMockRecorder) BatchGetRepositoryScanningConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetRepositoryScanningConfigurationWithContext", reflect.TypeOf((*MockECRAPI)(nil).BatchGetRepositoryScanningConfigurationWithContext), varargs...)
+}
+
 // CompleteLayerUpload mocks base method
 func (m *MockECRAPI) CompleteLayerUpload(arg0 *ecr.CompleteLayerUploadInput) (*ecr.CompleteLayerUploadOutput, error) {
 	m.ctrl.T.Helper()
@@ -249,6 +299,56 @@ func (mr *MockECRAPIMockRecorder) CompleteLayerUploadWithContext(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteLayerUploadWithContext", reflect.TypeOf((*MockECRAPI)(nil).CompleteLayerUploadWithContext), varargs...)
 }
 
+// CreatePullThroughCacheRule mocks base method
+func (m *MockECRAPI) CreatePullThroughCacheRule(arg0 *ecr.CreatePullThroughCacheRuleInput) (*ecr.CreatePullThroughCacheRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePullThroughCacheRule", arg0)
+	ret0, _ := ret[0].(*ecr.CreatePullThroughCacheRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePullThroughCacheRule indicates an expected call of CreatePullThroughCacheRule
+func (mr *MockECRAPIMockRecorder) CreatePullThroughCacheRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullThroughCacheRule", reflect.TypeOf((*MockECRAPI)(nil).CreatePullThroughCacheRule), arg0)
+}
+
+// CreatePullThroughCacheRuleRequest mocks base method
+func (m *MockECRAPI) CreatePullThroughCacheRuleRequest(arg0 *ecr.CreatePullThroughCacheRuleInput) (*request.Request, *ecr.CreatePullThroughCacheRuleOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePullThroughCacheRuleRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.CreatePullThroughCacheRuleOutput)
+	return ret0, ret1
+}
+
+// CreatePullThroughCacheRuleRequest indicates an expected call of CreatePullThroughCacheRuleRequest
+func (mr *MockECRAPIMockRecorder) CreatePullThroughCacheRuleRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullThroughCacheRuleRequest", reflect.TypeOf((*MockECRAPI)(nil).CreatePullThroughCacheRuleRequest), arg0)
+}
+
+// CreatePullThroughCacheRuleWithContext mocks base method
+func (m *MockECRAPI) CreatePullThroughCacheRuleWithContext(arg0 context.Context, arg1 *ecr.CreatePullThroughCacheRuleInput, arg2 ...request.Option) (*ecr.CreatePullThroughCacheRuleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePullThroughCacheRuleWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.CreatePullThroughCacheRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePullThroughCacheRuleWithContext indicates an expected call of CreatePullThroughCacheRuleWithContext
+func (mr *MockECRAPIMockRecorder) CreatePullThroughCacheRuleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullThroughCacheRuleWithContext", reflect.TypeOf((*MockECRAPI)(nil).CreatePullThroughCacheRuleWithContext), varargs...)
+}
+
 // CreateRepository mocks base method
 func (m *MockECRAPI) CreateRepository(arg0 *ecr.CreateRepositoryInput) (*ecr.CreateRepositoryOutput, error) {
 	m.ctrl.T.Helper()
@@ -264,6 +364,56 @@ func (mr *MockECRAPIMockRecorder) CreateRepository(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRepository", reflect.TypeOf((*MockECRAPI)(nil).CreateRepository), arg0)
 }
 
+// CreateRepositoryCreationTemplate mocks base method
+func (m *MockECRAPI) CreateRepositoryCreationTemplate(arg0 *ecr.CreateRepositoryCreationTemplateInput) (*ecr.CreateRepositoryCreationTemplateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRepositoryCreationTemplate", arg0)
+	ret0, _ := ret[0].(*ecr.CreateRepositoryCreationTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRepositoryCreationTemplate indicates an expected call of CreateRepositoryCreationTemplate
+func (mr *MockECRAPIMockRecorder) CreateRepositoryCreationTemplate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRepositoryCreationTemplate", reflect.TypeOf((*MockECRAPI)(nil).CreateRepositoryCreationTemplate), arg0)
+}
+
+// CreateRepositoryCreationTemplateRequest mocks base method
+func (m *MockECRAPI) CreateRepositoryCreationTemplateRequest(arg0 *ecr.CreateRepositoryCreationTemplateInput) (*request.Request, *ecr.CreateRepositoryCreationTemplateOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRepositoryCreationTemplateRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.CreateRepositoryCreationTemplateOutput)
+	return ret0, ret1
+}
+
+// CreateRepositoryCreationTemplateRequest indicates an expected call of CreateRepositoryCreationTemplateRequest
+func (mr *MockECRAPIMockRecorder) CreateRepositoryCreationTemplateRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRepositoryCreationTemplateRequest", reflect.TypeOf((*MockECRAPI)(nil).CreateRepositoryCreationTemplateRequest), arg0)
+}
+
+// CreateRepositoryCreationTemplateWithContext mocks base method
+func (m *MockECRAPI) CreateRepositoryCreationTemplateWithContext(arg0 context.Context, arg1 *ecr.CreateRepositoryCreationTemplateInput, arg2 ...request.Option) (*ecr.CreateRepositoryCreationTemplateOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateRepositoryCreationTemplateWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.CreateRepositoryCreationTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRepositoryCreationTemplateWithContext indicates an expected call of CreateRepositoryCreationTemplateWithContext
+func (mr *MockECRAPIMockRecorder) CreateRepositoryCreationTemplateWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRepositoryCreationTemplateWithContext", reflect.TypeOf((*MockECRAPI)(nil).CreateRepositoryCreationTemplateWithContext), varargs...)
+}
+
 // CreateRepositoryRequest mocks base method
 func (m *MockECRAPI) CreateRepositoryRequest(arg0 *ecr.CreateRepositoryInput) (*request.Request, *ecr.CreateRepositoryOutput) {
 	m.ctrl.T.Helper()
@@ -349,6 +499,106 @@ func (mr *MockECRAPIMockRecorder) DeleteLifecyclePolicyWithContext(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLifecyclePolicyWithContext", reflect.TypeOf((*MockECRAPI)(nil).DeleteLifecyclePolicyWithContext), varargs...)
 }
 
+// DeletePullThroughCacheRule mocks base method
+func (m *MockECRAPI) DeletePullThroughCacheRule(arg0 *ecr.DeletePullThroughCacheRuleInput) (*ecr.DeletePullThroughCacheRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePullThroughCacheRule", arg0)
+	ret0, _ := ret[0].(*ecr.DeletePullThroughCacheRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePullThroughCacheRule indicates an expected call of DeletePullThroughCacheRule
+func (mr *MockECRAPIMockRecorder) DeletePullThroughCacheRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePullThroughCacheRule", reflect.TypeOf((*MockECRAPI)(nil).DeletePullThroughCacheRule), arg0)
+}
+
+// DeletePullThroughCacheRuleRequest mocks base method
+func (m *MockECRAPI) DeletePullThroughCacheRuleRequest(arg0 *ecr.DeletePullThroughCacheRuleInput) (*request.Request, *ecr.DeletePullThroughCacheRuleOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePullThroughCacheRuleRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.DeletePullThroughCacheRuleOutput)
+	return ret0, ret1
+}
+
+// DeletePullThroughCacheRuleRequest indicates an expected call of DeletePullThroughCacheRuleRequest
+func (mr *MockECRAPIMockRecorder) DeletePullThroughCacheRuleRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePullThroughCacheRuleRequest", reflect.TypeOf((*MockECRAPI)(nil).DeletePullThroughCacheRuleRequest), arg0)
+}
+
+// DeletePullThroughCacheRuleWithContext mocks base method
+func (m *MockECRAPI) DeletePullThroughCacheRuleWithContext(arg0 context.Context, arg1 *ecr.DeletePullThroughCacheRuleInput, arg2 ...request.Option) (*ecr.DeletePullThroughCacheRuleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePullThroughCacheRuleWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.DeletePullThroughCacheRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePullThroughCacheRuleWithContext indicates an expected call of DeletePullThroughCacheRuleWithContext
+func (mr *MockECRAPIMockRecorder) DeletePullThroughCacheRuleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePullThroughCacheRuleWithContext", reflect.TypeOf((*MockECRAPI)(nil).DeletePullThroughCacheRuleWithContext), varargs...)
+}
+
+// DeleteRegistryPolicy mocks base method
+func (m *MockECRAPI) DeleteRegistryPolicy(arg0 *ecr.DeleteRegistryPolicyInput) (*ecr.DeleteRegistryPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRegistryPolicy", arg0)
+	ret0, _ := ret[0].(*ecr.DeleteRegistryPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRegistryPolicy indicates an expected call of DeleteRegistryPolicy
+func (mr *MockECRAPIMockRecorder) DeleteRegistryPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRegistryPolicy", reflect.TypeOf((*MockECRAPI)(nil).DeleteRegistryPolicy), arg0)
+}
+
+// DeleteRegistryPolicyRequest mocks base method
+func (m *MockECRAPI) DeleteRegistryPolicyRequest(arg0 *ecr.DeleteRegistryPolicyInput) (*request.Request, *ecr.DeleteRegistryPolicyOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRegistryPolicyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.DeleteRegistryPolicyOutput)
+	return ret0, ret1
+}
+
+// DeleteRegistryPolicyRequest indicates an expected call of DeleteRegistryPolicyRequest
+func (mr *MockECRAPIMockRecorder) DeleteRegistryPolicyRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRegistryPolicyRequest", reflect.TypeOf((*MockECRAPI)(nil).DeleteRegistryPolicyRequest), arg0)
+}
+
+// DeleteRegistryPolicyWithContext mocks base method
+func (m *MockECRAPI) DeleteRegistryPolicyWithContext(arg0 context.Context, arg1 *ecr.DeleteRegistryPolicyInput, arg2 ...request.Option) (*ecr.DeleteRegistryPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRegistryPolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.DeleteRegistryPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRegistryPolicyWithContext indicates an expected call of DeleteRegistryPolicyWithContext
+func (mr *MockECRAPIMockRecorder) DeleteRegistryPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRegistryPolicyWithContext", reflect.TypeOf((*MockECRAPI)(nil).DeleteRegistryPolicyWithContext), varargs...)
+}
+
 // DeleteRepository mocks base method
 func (m *MockECRAPI) DeleteRepository(arg0 *ecr.DeleteRepositoryInput) (*ecr.DeleteRepositoryOutput, error) {
 	m.ctrl.T.Helper()
@@ -364,6 +614,56 @@ func (mr *MockECRAPIMockRecorder) DeleteRepository(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepository", reflect.TypeOf((*MockECRAPI)(nil).DeleteRepository), arg0)
 }
 
+// DeleteRepositoryCreationTemplate mocks base method
+func (m *MockECRAPI) DeleteRepositoryCreationTemplate(arg0 *ecr.DeleteRepositoryCreationTemplateInput) (*ecr.DeleteRepositoryCreationTemplateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRepositoryCreationTemplate", arg0)
+	ret0, _ := ret[0].(*ecr.DeleteRepositoryCreationTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRepositoryCreationTemplate indicates an expected call of DeleteRepositoryCreationTemplate
+func (mr *MockECRAPIMockRecorder) DeleteRepositoryCreationTemplate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepositoryCreationTemplate", reflect.TypeOf((*MockECRAPI)(nil).DeleteRepositoryCreationTemplate), arg0)
+}
+
+// DeleteRepositoryCreationTemplateRequest mocks base method
+func (m *MockECRAPI) DeleteRepositoryCreationTemplateRequest(arg0 *ecr.DeleteRepositoryCreationTemplateInput) (*request.Request, *ecr.DeleteRepositoryCreationTemplateOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRepositoryCreationTemplateRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.DeleteRepositoryCreationTemplateOutput)
+	return ret0, ret1
+}
+
+// DeleteRepositoryCreationTemplateRequest indicates an expected call of DeleteRepositoryCreationTemplateRequest
+func (mr *MockECRAPIMockRecorder) DeleteRepositoryCreationTemplateRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepositoryCreationTemplateRequest", reflect.TypeOf((*MockECRAPI)(nil).DeleteRepositoryCreationTemplateRequest), arg0)
+}
+
+// DeleteRepositoryCreationTemplateWithContext mocks base method
+func (m *MockECRAPI) DeleteRepositoryCreationTemplateWithContext(arg0 context.Context, arg1 *ecr.DeleteRepositoryCreationTemplateInput, arg2 ...request.Option) (*ecr.DeleteRepositoryCreationTemplateOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRepositoryCreationTemplateWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.DeleteRepositoryCreationTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRepositoryCreationTemplateWithContext indicates an expected call of DeleteRepositoryCreationTemplateWithContext
+func (mr *MockECRAPIMockRecorder) DeleteRepositoryCreationTemplateWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepositoryCreationTemplateWithContext", reflect.TypeOf((*MockECRAPI)(nil).DeleteRepositoryCreationTemplateWithContext), varargs...)
+}
+
 // DeleteRepositoryPolicy mocks base method
 func (m *MockECRAPI) DeleteRepositoryPolicy(arg0 *ecr.DeleteRepositoryPolicyInput) (*ecr.DeleteRepositoryPolicyOutput, error) {
 	m.ctrl.T.Helper()
@@ -449,6 +749,56 @@ func (mr *MockECRAPIMockRecorder) DeleteRepositoryWithContext(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepositoryWithContext", reflect.TypeOf((*MockECRAPI)(nil).DeleteRepositoryWithContext), varargs...)
 }
 
+// DescribeImageReplicationStatus mocks base method
+func (m *MockECRAPI) DescribeImageReplicationStatus(arg0 *ecr.DescribeImageReplicationStatusInput) (*ecr.DescribeImageReplicationStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeImageReplicationStatus", arg0)
+	ret0, _ := ret[0].(*ecr.DescribeImageReplicationStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeImageReplicationStatus indicates an expected call of DescribeImageReplicationStatus
+func (mr *MockECRAPIMockRecorder) DescribeImageReplicationStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImageReplicationStatus", reflect.TypeOf((*MockECRAPI)(nil).DescribeImageReplicationStatus), arg0)
+}
+
+// DescribeImageReplicationStatusRequest mocks base method
+func (m *MockECRAPI) DescribeImageReplicationStatusRequest(arg0 *ecr.DescribeImageReplicationStatusInput) (*request.Request, *ecr.DescribeImageReplicationStatusOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeImageReplicationStatusRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.DescribeImageReplicationStatusOutput)
+	return ret0, ret1
+}
+
+// DescribeImageReplicationStatusRequest indicates an expected call of DescribeImageReplicationStatusRequest
+func (mr *MockECRAPIMockRecorder) DescribeImageReplicationStatusRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImageReplicationStatusRequest", reflect.TypeOf((*MockECRAPI)(nil).DescribeImageReplicationStatusRequest), arg0)
+}
+
+// DescribeImageReplicationStatusWithContext mocks base method
+func (m *MockECRAPI) DescribeImageReplicationStatusWithContext(arg0 context.Context, arg1 *ecr.DescribeImageReplicationStatusInput, arg2 ...request.Option) (*ecr.DescribeImageReplicationStatusOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeImageReplicationStatusWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.DescribeImageReplicationStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeImageReplicationStatusWithContext indicates an expected call of DescribeImageReplicationStatusWithContext
+func (mr *MockECRAPIMockRecorder) DescribeImageReplicationStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImageReplicationStatusWithContext", reflect.TypeOf((*MockECRAPI)(nil).DescribeImageReplicationStatusWithContext), varargs...)
+}
+
 // DescribeImageScanFindings mocks base method
 func (m *MockECRAPI) DescribeImageScanFindings(arg0 *ecr.DescribeImageScanFindingsInput) (*ecr.DescribeImageScanFindingsOutput, error) {
 	m.ctrl.T.Helper()
@@ -615,6 +965,139 @@ func (mr *MockECRAPIMockRecorder) DescribeImagesWithContext(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImagesWithContext", reflect.TypeOf((*MockECRAPI)(nil).DescribeImagesWithContext), varargs...)
 }
 
+// DescribePullThroughCacheRules mocks base method
+func (m *MockECRAPI) DescribePullThroughCacheRules(arg0 *ecr.DescribePullThroughCacheRulesInput) (*ecr.DescribePullThroughCacheRulesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePullThroughCacheRules", arg0)
+	ret0, _ := ret[0].(*ecr.DescribePullThroughCacheRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePullThroughCacheRules indicates an expected call of DescribePullThroughCacheRules
+func (mr *MockECRAPIMockRecorder) DescribePullThroughCacheRules(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePullThroughCacheRules", reflect.TypeOf((*MockECRAPI)(nil).DescribePullThroughCacheRules), arg0)
+}
+
+// DescribePullThroughCacheRulesPages mocks base method
+func (m *MockECRAPI) DescribePullThroughCacheRulesPages(arg0 *ecr.DescribePullThroughCacheRulesInput, arg1 func(*ecr.DescribePullThroughCacheRulesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePullThroughCacheRulesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribePullThroughCacheRulesPages indicates an expected call of DescribePullThroughCacheRulesPages
+func (mr *MockECRAPIMockRecorder) DescribePullThroughCacheRulesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePullThroughCacheRulesPages", reflect.TypeOf((*MockECRAPI)(nil).DescribePullThroughCacheRulesPages), arg0, arg1)
+}
+
+// DescribePullThroughCacheRulesPagesWithContext mocks base method
+func (m *MockECRAPI) DescribePullThroughCacheRulesPagesWithContext(arg0 context.Context, arg1 *ecr.DescribePullThroughCacheRulesInput, arg2 func(*ecr.DescribePullThroughCacheRulesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePullThroughCacheRulesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribePullThroughCacheRulesPagesWithContext indicates an expected call of DescribePullThroughCacheRulesPagesWithContext
+func (mr *MockECRAPIMockRecorder) DescribePullThroughCacheRulesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePullThroughCacheRulesPagesWithContext", reflect.TypeOf((*MockECRAPI)(nil).DescribePullThroughCacheRulesPagesWithContext), varargs...)
+}
+
+// DescribePullThroughCacheRulesRequest mocks base method
+func (m *MockECRAPI) DescribePullThroughCacheRulesRequest(arg0 *ecr.DescribePullThroughCacheRulesInput) (*request.Request, *ecr.DescribePullThroughCacheRulesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePullThroughCacheRulesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.DescribePullThroughCacheRulesOutput)
+	return ret0, ret1
+}
+
+// DescribePullThroughCacheRulesRequest indicates an expected call of DescribePullThroughCacheRulesRequest
+func (mr *MockECRAPIMockRecorder) DescribePullThroughCacheRulesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePullThroughCacheRulesRequest", reflect.TypeOf((*MockECRAPI)(nil).DescribePullThroughCacheRulesRequest), arg0)
+}
+
+// DescribePullThroughCacheRulesWithContext mocks base method
+func (m *MockECRAPI) DescribePullThroughCacheRulesWithContext(arg0 context.Context, arg1 *ecr.DescribePullThroughCacheRulesInput, arg2 ...request.Option) (*ecr.DescribePullThroughCacheRulesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePullThroughCacheRulesWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.DescribePullThroughCacheRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePullThroughCacheRulesWithContext indicates an expected call of DescribePullThroughCacheRulesWithContext
+func (mr *MockECRAPIMockRecorder) DescribePullThroughCacheRulesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePullThroughCacheRulesWithContext", reflect.TypeOf((*MockECRAPI)(nil).DescribePullThroughCacheRulesWithContext), varargs...)
+}
+
+// DescribeRegistry mocks base method
+func (m *MockECRAPI) DescribeRegistry(arg0 *ecr.DescribeRegistryInput) (*ecr.DescribeRegistryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRegistry", arg0)
+	ret0, _ := ret[0].(*ecr.DescribeRegistryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRegistry indicates an expected call of DescribeRegistry
+func (mr *MockECRAPIMockRecorder) DescribeRegistry(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRegistry", reflect.TypeOf((*MockECRAPI)(nil).DescribeRegistry), arg0)
+}
+
+// DescribeRegistryRequest mocks base method
+func (m *MockECRAPI) DescribeRegistryRequest(arg0 *ecr.DescribeRegistryInput) (*request.Request, *ecr.DescribeRegistryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRegistryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.DescribeRegistryOutput)
+	return ret0, ret1
+}
+
+// DescribeRegistryRequest indicates an expected call of DescribeRegistryRequest
+func (mr *MockECRAPIMockRecorder) DescribeRegistryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRegistryRequest", reflect.TypeOf((*MockECRAPI)(nil).DescribeRegistryRequest), arg0)
+}
+
+// DescribeRegistryWithContext mocks base method
+func (m *MockECRAPI) DescribeRegistryWithContext(arg0 context.Context, arg1 *ecr.DescribeRegistryInput, arg2 ...request.Option) (*ecr.DescribeRegistryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeRegistryWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.DescribeRegistryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRegistryWithContext indicates an expected call of DescribeRegistryWithContext
+func (mr *MockECRAPIMockRecorder) DescribeRegistryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRegistryWithContext", reflect.TypeOf((*MockECRAPI)(nil).DescribeRegistryWithContext), varargs...)
+}
+
 // DescribeRepositories mocks base method
 func (m *MockECRAPI) DescribeRepositories(arg0 *ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error) {
 	m.ctrl.T.Helper()
@@ -633,69 +1116,152 @@ func (mr *MockECRAPIMockRecorder) DescribeRepositories(arg0 interface{}) *gomock
 // DescribeRepositoriesPages mocks base method
 func (m *MockECRAPI) DescribeRepositoriesPages(arg0 *ecr.DescribeRepositoriesInput, arg1 func(*ecr.DescribeRepositoriesOutput, bool) bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeRepositoriesPages", arg0, arg1)
+	ret := m.ctrl.Call(m, "DescribeRepositoriesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeRepositoriesPages indicates an expected call of DescribeRepositoriesPages
+func (mr *MockECRAPIMockRecorder) DescribeRepositoriesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRepositoriesPages", reflect.TypeOf((*MockECRAPI)(nil).DescribeRepositoriesPages), arg0, arg1)
+}
+
+// DescribeRepositoriesPagesWithContext mocks base method
+func (m *MockECRAPI) DescribeRepositoriesPagesWithContext(arg0 context.Context, arg1 *ecr.DescribeRepositoriesInput, arg2 func(*ecr.DescribeRepositoriesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeRepositoriesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeRepositoriesPagesWithContext indicates an expected call of DescribeRepositoriesPagesWithContext
+func (mr *MockECRAPIMockRecorder) DescribeRepositoriesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRepositoriesPagesWithContext", reflect.TypeOf((*MockECRAPI)(nil).DescribeRepositoriesPagesWithContext), varargs...)
+}
+
+// DescribeRepositoriesRequest mocks base method
+func (m *MockECRAPI) DescribeRepositoriesRequest(arg0 *ecr.DescribeRepositoriesInput) (*request.Request, *ecr.DescribeRepositoriesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRepositoriesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.DescribeRepositoriesOutput)
+	return ret0, ret1
+}
+
+// DescribeRepositoriesRequest indicates an expected call of DescribeRepositoriesRequest
+func (mr *MockECRAPIMockRecorder) DescribeRepositoriesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRepositoriesRequest", reflect.TypeOf((*MockECRAPI)(nil).DescribeRepositoriesRequest), arg0)
+}
+
+// DescribeRepositoriesWithContext mocks base method
+func (m *MockECRAPI) DescribeRepositoriesWithContext(arg0 context.Context, arg1 *ecr.DescribeRepositoriesInput, arg2 ...request.Option) (*ecr.DescribeRepositoriesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeRepositoriesWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.DescribeRepositoriesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRepositoriesWithContext indicates an expected call of DescribeRepositoriesWithContext
+func (mr *MockECRAPIMockRecorder) DescribeRepositoriesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRepositoriesWithContext", reflect.TypeOf((*MockECRAPI)(nil).DescribeRepositoriesWithContext), varargs...)
+}
+
+// DescribeRepositoryCreationTemplates mocks base method
+func (m *MockECRAPI) DescribeRepositoryCreationTemplates(arg0 *ecr.DescribeRepositoryCreationTemplatesInput) (*ecr.DescribeRepositoryCreationTemplatesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRepositoryCreationTemplates", arg0)
+	ret0, _ := ret[0].(*ecr.DescribeRepositoryCreationTemplatesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRepositoryCreationTemplates indicates an expected call of DescribeRepositoryCreationTemplates
+func (mr *MockECRAPIMockRecorder) DescribeRepositoryCreationTemplates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRepositoryCreationTemplates", reflect.TypeOf((*MockECRAPI)(nil).DescribeRepositoryCreationTemplates), arg0)
+}
+
+// DescribeRepositoryCreationTemplatesPages mocks base method
+func (m *MockECRAPI) DescribeRepositoryCreationTemplatesPages(arg0 *ecr.DescribeRepositoryCreationTemplatesInput, arg1 func(*ecr.DescribeRepositoryCreationTemplatesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRepositoryCreationTemplatesPages", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DescribeRepositoriesPages indicates an expected call of DescribeRepositoriesPages
-func (mr *MockECRAPIMockRecorder) DescribeRepositoriesPages(arg0, arg1 interface{}) *gomock.Call {
+// DescribeRepositoryCreationTemplatesPages indicates an expected call of DescribeRepositoryCreationTemplatesPages
+func (mr *MockECRAPIMockRecorder) DescribeRepositoryCreationTemplatesPages(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRepositoriesPages", reflect.TypeOf((*MockECRAPI)(nil).DescribeRepositoriesPages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRepositoryCreationTemplatesPages", reflect.TypeOf((*MockECRAPI)(nil).DescribeRepositoryCreationTemplatesPages), arg0, arg1)
 }
 
-// DescribeRepositoriesPagesWithContext mocks base method
-func (m *MockECRAPI) DescribeRepositoriesPagesWithContext(arg0 context.Context, arg1 *ecr.DescribeRepositoriesInput, arg2 func(*ecr.DescribeRepositoriesOutput, bool) bool, arg3 ...request.Option) error {
+// DescribeRepositoryCreationTemplatesPagesWithContext mocks base method
+func (m *MockECRAPI) DescribeRepositoryCreationTemplatesPagesWithContext(arg0 context.Context, arg1 *ecr.DescribeRepositoryCreationTemplatesInput, arg2 func(*ecr.DescribeRepositoryCreationTemplatesOutput, bool) bool, arg3 ...request.Option) error {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1, arg2}
 	for _, a := range arg3 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeRepositoriesPagesWithContext", varargs...)
+	ret := m.ctrl.Call(m, "DescribeRepositoryCreationTemplatesPagesWithContext", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DescribeRepositoriesPagesWithContext indicates an expected call of DescribeRepositoriesPagesWithContext
-func (mr *MockECRAPIMockRecorder) DescribeRepositoriesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+// DescribeRepositoryCreationTemplatesPagesWithContext indicates an expected call of DescribeRepositoryCreationTemplatesPagesWithContext
+func (mr *MockECRAPIMockRecorder) DescribeRepositoryCreationTemplatesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRepositoriesPagesWithContext", reflect.TypeOf((*MockECRAPI)(nil).DescribeRepositoriesPagesWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRepositoryCreationTemplatesPagesWithContext", reflect.TypeOf((*MockECRAPI)(nil).DescribeRepositoryCreationTemplatesPagesWithContext), varargs...)
 }
 
-// DescribeRepositoriesRequest mocks base method
-func (m *MockECRAPI) DescribeRepositoriesRequest(arg0 *ecr.DescribeRepositoriesInput) (*request.Request, *ecr.DescribeRepositoriesOutput) {
+// DescribeRepositoryCreationTemplatesRequest mocks base method
+func (m *MockECRAPI) DescribeRepositoryCreationTemplatesRequest(arg0 *ecr.DescribeRepositoryCreationTemplatesInput) (*request.Request, *ecr.DescribeRepositoryCreationTemplatesOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeRepositoriesRequest", arg0)
+	ret := m.ctrl.Call(m, "DescribeRepositoryCreationTemplatesRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*ecr.DescribeRepositoriesOutput)
+	ret1, _ := ret[1].(*ecr.DescribeRepositoryCreationTemplatesOutput)
 	return ret0, ret1
 }
 
-// DescribeRepositoriesRequest indicates an expected call of DescribeRepositoriesRequest
-func (mr *MockECRAPIMockRecorder) DescribeRepositoriesRequest(arg0 interface{}) *gomock.Call {
+// DescribeRepositoryCreationTemplatesRequest indicates an expected call of DescribeRepositoryCreationTemplatesRequest
+func (mr *MockECRAPIMockRecorder) DescribeRepositoryCreationTemplatesRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRepositoriesRequest", reflect.TypeOf((*MockECRAPI)(nil).DescribeRepositoriesRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRepositoryCreationTemplatesRequest", reflect.TypeOf((*MockECRAPI)(nil).DescribeRepositoryCreationTemplatesRequest), arg0)
 }
 
-// DescribeRepositoriesWithContext mocks base method
-func (m *MockECRAPI) DescribeRepositoriesWithContext(arg0 context.Context, arg1 *ecr.DescribeRepositoriesInput, arg2 ...request.Option) (*ecr.DescribeRepositoriesOutput, error) {
+// DescribeRepositoryCreationTemplatesWithContext mocks base method
+func (m *MockECRAPI) DescribeRepositoryCreationTemplatesWithContext(arg0 context.Context, arg1 *ecr.DescribeRepositoryCreationTemplatesInput, arg2 ...request.Option) (*ecr.DescribeRepositoryCreationTemplatesOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeRepositoriesWithContext", varargs...)
-	ret0, _ := ret[0].(*ecr.DescribeRepositoriesOutput)
+	ret := m.ctrl.Call(m, "DescribeRepositoryCreationTemplatesWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.DescribeRepositoryCreationTemplatesOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeRepositoriesWithContext indicates an expected call of DescribeRepositoriesWithContext
-func (mr *MockECRAPIMockRecorder) DescribeRepositoriesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// DescribeRepositoryCreationTemplatesWithContext indicates an expected call of DescribeRepositoryCreationTemplatesWithContext
+func (mr *MockECRAPIMockRecorder) DescribeRepositoryCreationTemplatesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRepositoriesWithContext", reflect.TypeOf((*MockECRAPI)(nil).DescribeRepositoriesWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRepositoryCreationTemplatesWithContext", reflect.TypeOf((*MockECRAPI)(nil).DescribeRepositoryCreationTemplatesWithContext), varargs...)
 }
 
 // GetAuthorizationToken mocks base method
@@ -931,6 +1497,106 @@ func (mr *MockECRAPIMockRecorder) GetLifecyclePolicyWithContext(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLifecyclePolicyWithContext", reflect.TypeOf((*MockECRAPI)(nil).GetLifecyclePolicyWithContext), varargs...)
 }
 
+// GetRegistryPolicy mocks base method
+func (m *MockECRAPI) GetRegistryPolicy(arg0 *ecr.GetRegistryPolicyInput) (*ecr.GetRegistryPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistryPolicy", arg0)
+	ret0, _ := ret[0].(*ecr.GetRegistryPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistryPolicy indicates an expected call of GetRegistryPolicy
+func (mr *MockECRAPIMockRecorder) GetRegistryPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryPolicy", reflect.TypeOf((*MockECRAPI)(nil).GetRegistryPolicy), arg0)
+}
+
+// GetRegistryPolicyRequest mocks base method
+func (m *MockECRAPI) GetRegistryPolicyRequest(arg0 *ecr.GetRegistryPolicyInput) (*request.Request, *ecr.GetRegistryPolicyOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistryPolicyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.GetRegistryPolicyOutput)
+	return ret0, ret1
+}
+
+// GetRegistryPolicyRequest indicates an expected call of GetRegistryPolicyRequest
+func (mr *MockECRAPIMockRecorder) GetRegistryPolicyRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryPolicyRequest", reflect.TypeOf((*MockECRAPI)(nil).GetRegistryPolicyRequest), arg0)
+}
+
+// GetRegistryPolicyWithContext mocks base method
+func (m *MockECRAPI) GetRegistryPolicyWithContext(arg0 context.Context, arg1 *ecr.GetRegistryPolicyInput, arg2 ...request.Option) (*ecr.GetRegistryPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRegistryPolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.GetRegistryPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistryPolicyWithContext indicates an expected call of GetRegistryPolicyWithContext
+func (mr *MockECRAPIMockRecorder) GetRegistryPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryPolicyWithContext", reflect.TypeOf((*MockECRAPI)(nil).GetRegistryPolicyWithContext), varargs...)
+}
+
+// GetRegistryScanningConfiguration mocks base method
+func (m *MockECRAPI) GetRegistryScanningConfiguration(arg0 *ecr.GetRegistryScanningConfigurationInput) (*ecr.GetRegistryScanningConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistryScanningConfiguration", arg0)
+	ret0, _ := ret[0].(*ecr.GetRegistryScanningConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistryScanningConfiguration indicates an expected call of GetRegistryScanningConfiguration
+func (mr *MockECRAPIMockRecorder) GetRegistryScanningConfiguration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryScanningConfiguration", reflect.TypeOf((*MockECRAPI)(nil).GetRegistryScanningConfiguration), arg0)
+}
+
+// GetRegistryScanningConfigurationRequest mocks base method
+func (m *MockECRAPI) GetRegistryScanningConfigurationRequest(arg0 *ecr.GetRegistryScanningConfigurationInput) (*request.Request, *ecr.GetRegistryScanningConfigurationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistryScanningConfigurationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.GetRegistryScanningConfigurationOutput)
+	return ret0, ret1
+}
+
+// GetRegistryScanningConfigurationRequest indicates an expected call of GetRegistryScanningConfigurationRequest
+func (mr *MockECRAPIMockRecorder) GetRegistryScanningConfigurationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryScanningConfigurationRequest", reflect.TypeOf((*MockECRAPI)(nil).GetRegistryScanningConfigurationRequest), arg0)
+}
+
+// GetRegistryScanningConfigurationWithContext mocks base method
+func (m *MockECRAPI) GetRegistryScanningConfigurationWithContext(arg0 context.Context, arg1 *ecr.GetRegistryScanningConfigurationInput, arg2 ...request.Option) (*ecr.GetRegistryScanningConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRegistryScanningConfigurationWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.GetRegistryScanningConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistryScanningConfigurationWithContext indicates an expected call of GetRegistryScanningConfigurationWithContext
+func (mr *MockECRAPIMockRecorder) GetRegistryScanningConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryScanningConfigurationWithContext", reflect.TypeOf((*MockECRAPI)(nil).GetRegistryScanningConfigurationWithContext), varargs...)
+}
+
 // GetRepositoryPolicy mocks base method
 func (m *MockECRAPI) GetRepositoryPolicy(arg0 *ecr.GetRepositoryPolicyInput) (*ecr.GetRepositoryPolicyOutput, error) {
 	m.ctrl.T.Helper()
@@ -1364,6 +2030,156 @@ func (mr *MockECRAPIMockRecorder) PutLifecyclePolicyWithContext(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutLifecyclePolicyWithContext", reflect.TypeOf((*MockECRAPI)(nil).PutLifecyclePolicyWithContext), varargs...)
 }
 
+// PutRegistryPolicy mocks base method
+func (m *MockECRAPI) PutRegistryPolicy(arg0 *ecr.PutRegistryPolicyInput) (*ecr.PutRegistryPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRegistryPolicy", arg0)
+	ret0, _ := ret[0].(*ecr.PutRegistryPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutRegistryPolicy indicates an expected call of PutRegistryPolicy
+func (mr *MockECRAPIMockRecorder) PutRegistryPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRegistryPolicy", reflect.TypeOf((*MockECRAPI)(nil).PutRegistryPolicy), arg0)
+}
+
+// PutRegistryPolicyRequest mocks base method
+func (m *MockECRAPI) PutRegistryPolicyRequest(arg0 *ecr.PutRegistryPolicyInput) (*request.Request, *ecr.PutRegistryPolicyOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRegistryPolicyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.PutRegistryPolicyOutput)
+	return ret0, ret1
+}
+
+// PutRegistryPolicyRequest indicates an expected call of PutRegistryPolicyRequest
+func (mr *MockECRAPIMockRecorder) PutRegistryPolicyRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRegistryPolicyRequest", reflect.TypeOf((*MockECRAPI)(nil).PutRegistryPolicyRequest), arg0)
+}
+
+// PutRegistryPolicyWithContext mocks base method
+func (m *MockECRAPI) PutRegistryPolicyWithContext(arg0 context.Context, arg1 *ecr.PutRegistryPolicyInput, arg2 ...request.Option) (*ecr.PutRegistryPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutRegistryPolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.PutRegistryPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutRegistryPolicyWithContext indicates an expected call of PutRegistryPolicyWithContext
+func (mr *MockECRAPIMockRecorder) PutRegistryPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRegistryPolicyWithContext", reflect.TypeOf((*MockECRAPI)(nil).PutRegistryPolicyWithContext), varargs...)
+}
+
+// PutRegistryScanningConfiguration mocks base method
+func (m *MockECRAPI) PutRegistryScanningConfiguration(arg0 *ecr.PutRegistryScanningConfigurationInput) (*ecr.PutRegistryScanningConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRegistryScanningConfiguration", arg0)
+	ret0, _ := ret[0].(*ecr.PutRegistryScanningConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutRegistryScanningConfiguration indicates an expected call of PutRegistryScanningConfiguration
+func (mr *MockECRAPIMockRecorder) PutRegistryScanningConfiguration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRegistryScanningConfiguration", reflect.TypeOf((*MockECRAPI)(nil).PutRegistryScanningConfiguration), arg0)
+}
+
+// PutRegistryScanningConfigurationRequest mocks base method
+func (m *MockECRAPI) PutRegistryScanningConfigurationRequest(arg0 *ecr.PutRegistryScanningConfigurationInput) (*request.Request, *ecr.PutRegistryScanningConfigurationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRegistryScanningConfigurationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.PutRegistryScanningConfigurationOutput)
+	return ret0, ret1
+}
+
+// PutRegistryScanningConfigurationRequest indicates an expected call of PutRegistryScanningConfigurationRequest
+func (mr *MockECRAPIMockRecorder) PutRegistryScanningConfigurationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRegistryScanningConfigurationRequest", reflect.TypeOf((*MockECRAPI)(nil).PutRegistryScanningConfigurationRequest), arg0)
+}
+
+// PutRegistryScanningConfigurationWithContext mocks base method
+func (m *MockECRAPI) PutRegistryScanningConfigurationWithContext(arg0 context.Context, arg1 *ecr.PutRegistryScanningConfigurationInput, arg2 ...request.Option) (*ecr.PutRegistryScanningConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutRegistryScanningConfigurationWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.PutRegistryScanningConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutRegistryScanningConfigurationWithContext indicates an expected call of PutRegistryScanningConfigurationWithContext
+func (mr *MockECRAPIMockRecorder) PutRegistryScanningConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRegistryScanningConfigurationWithContext", reflect.TypeOf((*MockECRAPI)(nil).PutRegistryScanningConfigurationWithContext), varargs...)
+}
+
+// PutReplicationConfiguration mocks base method
+func (m *MockECRAPI) PutReplicationConfiguration(arg0 *ecr.PutReplicationConfigurationInput) (*ecr.PutReplicationConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutReplicationConfiguration", arg0)
+	ret0, _ := ret[0].(*ecr.PutReplicationConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutReplicationConfiguration indicates an expected call of PutReplicationConfiguration
+func (mr *MockECRAPIMockRecorder) PutReplicationConfiguration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutReplicationConfiguration", reflect.TypeOf((*MockECRAPI)(nil).PutReplicationConfiguration), arg0)
+}
+
+// PutReplicationConfigurationRequest mocks base method
+func (m *MockECRAPI) PutReplicationConfigurationRequest(arg0 *ecr.PutReplicationConfigurationInput) (*request.Request, *ecr.PutReplicationConfigurationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutReplicationConfigurationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.PutReplicationConfigurationOutput)
+	return ret0, ret1
+}
+
+// PutReplicationConfigurationRequest indicates an expected call of PutReplicationConfigurationRequest
+func (mr *MockECRAPIMockRecorder) PutReplicationConfigurationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutReplicationConfigurationRequest", reflect.TypeOf((*MockECRAPI)(nil).PutReplicationConfigurationRequest), arg0)
+}
+
+// PutReplicationConfigurationWithContext mocks base method
+func (m *MockECRAPI) PutReplicationConfigurationWithContext(arg0 context.Context, arg1 *ecr.PutReplicationConfigurationInput, arg2 ...request.Option) (*ecr.PutReplicationConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutReplicationConfigurationWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.PutReplicationConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutReplicationConfigurationWithContext indicates an expected call of PutReplicationConfigurationWithContext
+func (mr *MockECRAPIMockRecorder) PutReplicationConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutReplicationConfigurationWithContext", reflect.TypeOf((*MockECRAPI)(nil).PutReplicationConfigurationWithContext), varargs...)
+}
+
 // SetRepositoryPolicy mocks base method
 func (m *MockECRAPI) SetRepositoryPolicy(arg0 *ecr.SetRepositoryPolicyInput) (*ecr.SetRepositoryPolicyOutput, error) {
 	m.ctrl.T.Helper()
@@ -1614,6 +2430,106 @@ func (mr *MockECRAPIMockRecorder) UntagResourceWithContext(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceWithContext", reflect.TypeOf((*MockECRAPI)(nil).UntagResourceWithContext), varargs...)
 }
 
+// UpdatePullThroughCacheRule mocks base method
+func (m *MockECRAPI) UpdatePullThroughCacheRule(arg0 *ecr.UpdatePullThroughCacheRuleInput) (*ecr.UpdatePullThroughCacheRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePullThroughCacheRule", arg0)
+	ret0, _ := ret[0].(*ecr.UpdatePullThroughCacheRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePullThroughCacheRule indicates an expected call of UpdatePullThroughCacheRule
+func (mr *MockECRAPIMockRecorder) UpdatePullThroughCacheRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullThroughCacheRule", reflect.TypeOf((*MockECRAPI)(nil).UpdatePullThroughCacheRule), arg0)
+}
+
+// UpdatePullThroughCacheRuleRequest mocks base method
+func (m *MockECRAPI) UpdatePullThroughCacheRuleRequest(arg0 *ecr.UpdatePullThroughCacheRuleInput) (*request.Request, *ecr.UpdatePullThroughCacheRuleOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePullThroughCacheRuleRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.UpdatePullThroughCacheRuleOutput)
+	return ret0, ret1
+}
+
+// UpdatePullThroughCacheRuleRequest indicates an expected call of UpdatePullThroughCacheRuleRequest
+func (mr *MockECRAPIMockRecorder) UpdatePullThroughCacheRuleRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullThroughCacheRuleRequest", reflect.TypeOf((*MockECRAPI)(nil).UpdatePullThroughCacheRuleRequest), arg0)
+}
+
+// UpdatePullThroughCacheRuleWithContext mocks base method
+func (m *MockECRAPI) UpdatePullThroughCacheRuleWithContext(arg0 context.Context, arg1 *ecr.UpdatePullThroughCacheRuleInput, arg2 ...request.Option) (*ecr.UpdatePullThroughCacheRuleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePullThroughCacheRuleWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.UpdatePullThroughCacheRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePullThroughCacheRuleWithContext indicates an expected call of UpdatePullThroughCacheRuleWithContext
+func (mr *MockECRAPIMockRecorder) UpdatePullThroughCacheRuleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullThroughCacheRuleWithContext", reflect.TypeOf((*MockECRAPI)(nil).UpdatePullThroughCacheRuleWithContext), varargs...)
+}
+
+// UpdateRepositoryCreationTemplate mocks base method
+func (m *MockECRAPI) UpdateRepositoryCreationTemplate(arg0 *ecr.UpdateRepositoryCreationTemplateInput) (*ecr.UpdateRepositoryCreationTemplateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRepositoryCreationTemplate", arg0)
+	ret0, _ := ret[0].(*ecr.UpdateRepositoryCreationTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRepositoryCreationTemplate indicates an expected call of UpdateRepositoryCreationTemplate
+func (mr *MockECRAPIMockRecorder) UpdateRepositoryCreationTemplate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRepositoryCreationTemplate", reflect.TypeOf((*MockECRAPI)(nil).UpdateRepositoryCreationTemplate), arg0)
+}
+
+// UpdateRepositoryCreationTemplateRequest mocks base method
+func (m *MockECRAPI) UpdateRepositoryCreationTemplateRequest(arg0 *ecr.UpdateRepositoryCreationTemplateInput) (*request.Request, *ecr.UpdateRepositoryCreationTemplateOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRepositoryCreationTemplateRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.UpdateRepositoryCreationTemplateOutput)
+	return ret0, ret1
+}
+
+// UpdateRepositoryCreationTemplateRequest indicates an expected call of UpdateRepositoryCreationTemplateRequest
+func (mr *MockECRAPIMockRecorder) UpdateRepositoryCreationTemplateRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRepositoryCreationTemplateRequest", reflect.TypeOf((*MockECRAPI)(nil).UpdateRepositoryCreationTemplateRequest), arg0)
+}
+
+// UpdateRepositoryCreationTemplateWithContext mocks base method
+func (m *MockECRAPI) UpdateRepositoryCreationTemplateWithContext(arg0 context.Context, arg1 *ecr.UpdateRepositoryCreationTemplateInput, arg2 ...request.Option) (*ecr.UpdateRepositoryCreationTemplateOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRepositoryCreationTemplateWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.UpdateRepositoryCreationTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRepositoryCreationTemplateWithContext indicates an expected call of UpdateRepositoryCreationTemplateWithContext
+func (mr *MockECRAPIMockRecorder) UpdateRepositoryCreationTemplateWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRepositoryCreationTemplateWithContext", reflect.TypeOf((*MockECRAPI)(nil).UpdateRepositoryCreationTemplateWithContext), varargs...)
+}
+
 // UploadLayerPart mocks base method
 func (m *MockECRAPI) UploadLayerPart(arg0 *ecr.UploadLayerPartInput) (*ecr.UploadLayerPartOutput, error) {
 	m.ctrl.T.Helper()
@@ -1664,6 +2580,56 @@ func (mr *MockECRAPIMockRecorder) UploadLayerPartWithContext(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadLayerPartWithContext", reflect.TypeOf((*MockECRAPI)(nil).UploadLayerPartWithContext), varargs...)
 }
 
+// ValidatePullThroughCacheRule mocks base method
+func (m *MockECRAPI) ValidatePullThroughCacheRule(arg0 *ecr.ValidatePullThroughCacheRuleInput) (*ecr.ValidatePullThroughCacheRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatePullThroughCacheRule", arg0)
+	ret0, _ := ret[0].(*ecr.ValidatePullThroughCacheRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatePullThroughCacheRule indicates an expected call of ValidatePullThroughCacheRule
+func (mr *MockECRAPIMockRecorder) ValidatePullThroughCacheRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePullThroughCacheRule", reflect.TypeOf((*MockECRAPI)(nil).ValidatePullThroughCacheRule), arg0)
+}
+
+// ValidatePullThroughCacheRuleRequest mocks base method
+func (m *MockECRAPI) ValidatePullThroughCacheRuleRequest(arg0 *ecr.ValidatePullThroughCacheRuleInput) (*request.Request, *ecr.ValidatePullThroughCacheRuleOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatePullThroughCacheRuleRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.ValidatePullThroughCacheRuleOutput)
+	return ret0, ret1
+}
+
+// ValidatePullThroughCacheRuleRequest indicates an expected call of ValidatePullThroughCacheRuleRequest
+func (mr *MockECRAPIMockRecorder) ValidatePullThroughCacheRuleRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePullThroughCacheRuleRequest", reflect.TypeOf((*MockECRAPI)(nil).ValidatePullThroughCacheRuleRequest), arg0)
+}
+
+// ValidatePullThroughCacheRuleWithContext mocks base method
+func (m *MockECRAPI) ValidatePullThroughCacheRuleWithContext(arg0 context.Context, arg1 *ecr.ValidatePullThroughCacheRuleInput, arg2 ...request.Option) (*ecr.ValidatePullThroughCacheRuleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatePullThroughCacheRuleWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.ValidatePullThroughCacheRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatePullThroughCacheRuleWithContext indicates an expected call of ValidatePullThroughCacheRuleWithContext
+func (mr *MockECRAPIMockRecorder) ValidatePullThroughCacheRuleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePullThroughCacheRuleWithContext", reflect.TypeOf((*MockECRAPI)(nil).ValidatePullThroughCacheRuleWithContext), varargs...)
+}
+
 // WaitUntilImageScanComplete mocks base method
 func (m *MockECRAPI) WaitUntilImageScanComplete(arg0 *ecr.DescribeImageScanFindingsInput) error {
 	m.ctrl.T.Helper()
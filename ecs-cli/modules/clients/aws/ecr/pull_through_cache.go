@@ -0,0 +1,94 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ecr
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// PullThroughCacheRule contains the settings of a new pull through cache rule
+type PullThroughCacheRule struct {
+	RepositoryPrefix    string
+	UpstreamRegistryURL string
+	CredentialARN       string // required by upstream registries which need authentication
+}
+
+// CreatePullThroughCacheRule creates a rule which caches the images of an
+// upstream registry in the repositories with the given prefix
+func (c *ecrClient) CreatePullThroughCacheRule(registryID string, rule PullThroughCacheRule) (*ecr.CreatePullThroughCacheRuleOutput, error) {
+	log.WithFields(log.Fields{
+		"prefix":   rule.RepositoryPrefix,
+		"upstream": rule.UpstreamRegistryURL,
+	}).Info("Creating pull through cache rule")
+
+	input := &ecr.CreatePullThroughCacheRuleInput{
+		EcrRepositoryPrefix: aws.String(rule.RepositoryPrefix),
+		UpstreamRegistryUrl: aws.String(rule.UpstreamRegistryURL),
+	}
+	if rule.CredentialARN != "" {
+		input.SetCredentialArn(rule.CredentialARN)
+	}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+
+	resp, err := c.client.CreatePullThroughCacheRule(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create pull through cache rule %s", rule.RepositoryPrefix)
+	}
+	log.Info("Pull through cache rule created")
+	return resp, nil
+}
+
+// ListPullThroughCacheRules returns the pull through cache rules of a registry
+func (c *ecrClient) ListPullThroughCacheRules(registryID string) ([]*ecr.PullThroughCacheRule, error) {
+	input := &ecr.DescribePullThroughCacheRulesInput{}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+
+	var rules []*ecr.PullThroughCacheRule
+	err := c.client.DescribePullThroughCacheRulesPages(input, func(resp *ecr.DescribePullThroughCacheRulesOutput, lastPage bool) bool {
+		rules = append(rules, resp.PullThroughCacheRules...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list pull through cache rules")
+	}
+	return rules, nil
+}
+
+// DeletePullThroughCacheRule deletes a pull through cache rule. The
+// repositories which it created, and the images cached in them, are kept.
+func (c *ecrClient) DeletePullThroughCacheRule(registryID, repositoryPrefix string) error {
+	log.WithFields(log.Fields{
+		"prefix": repositoryPrefix,
+	}).Info("Deleting pull through cache rule")
+
+	input := &ecr.DeletePullThroughCacheRuleInput{
+		EcrRepositoryPrefix: aws.String(repositoryPrefix),
+	}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+
+	if _, err := c.client.DeletePullThroughCacheRule(input); err != nil {
+		return errors.Wrapf(err, "unable to delete pull through cache rule %s", repositoryPrefix)
+	}
+	log.Info("Pull through cache rule deleted")
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ecr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const credentialARN = "arn:aws:secretsmanager:us-west-2:123456789012:secret:ecr-pullthroughcache/docker-hub-AbCdEf"
+
+func TestCreatePullThroughCacheRule(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().CreatePullThroughCacheRule(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecr.CreatePullThroughCacheRuleInput)
+		assert.Equal(t, "docker-hub", aws.StringValue(req.EcrRepositoryPrefix), "Expected prefix to match")
+		assert.Equal(t, "registry-1.docker.io", aws.StringValue(req.UpstreamRegistryUrl), "Expected upstream to match")
+		assert.Equal(t, credentialARN, aws.StringValue(req.CredentialArn), "Expected credential ARN to match")
+		assert.Nil(t, req.RegistryId, "Expected default registry")
+	}).Return(&ecr.CreatePullThroughCacheRuleOutput{RegistryId: aws.String(registryID)}, nil)
+
+	resp, err := client.CreatePullThroughCacheRule("", PullThroughCacheRule{
+		RepositoryPrefix:    "docker-hub",
+		UpstreamRegistryURL: "registry-1.docker.io",
+		CredentialARN:       credentialARN,
+	})
+	assert.NoError(t, err, "Unexpected error creating pull through cache rule")
+	assert.Equal(t, registryID, aws.StringValue(resp.RegistryId))
+}
+
+func TestListPullThroughCacheRules(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().DescribePullThroughCacheRulesPages(gomock.Any(), gomock.Any()).Do(func(input, fn interface{}) {
+		assert.Equal(t, registryID, aws.StringValue(input.(*ecr.DescribePullThroughCacheRulesInput).RegistryId), "Expected registryID to match")
+		pager := fn.(func(*ecr.DescribePullThroughCacheRulesOutput, bool) bool)
+		assert.True(t, pager(&ecr.DescribePullThroughCacheRulesOutput{
+			PullThroughCacheRules: []*ecr.PullThroughCacheRule{{EcrRepositoryPrefix: aws.String("docker-hub")}},
+		}, false))
+		pager(&ecr.DescribePullThroughCacheRulesOutput{
+			PullThroughCacheRules: []*ecr.PullThroughCacheRule{{EcrRepositoryPrefix: aws.String("quay")}},
+		}, true)
+	}).Return(nil)
+
+	rules, err := client.ListPullThroughCacheRules(registryID)
+	assert.NoError(t, err, "Unexpected error listing pull through cache rules")
+	assert.Len(t, rules, 2)
+	assert.Equal(t, "quay", aws.StringValue(rules[1].EcrRepositoryPrefix))
+}
+
+func TestDeletePullThroughCacheRule(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().DeletePullThroughCacheRule(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecr.DeletePullThroughCacheRuleInput)
+		assert.Equal(t, "quay", aws.StringValue(req.EcrRepositoryPrefix), "Expected prefix to match")
+		assert.Equal(t, registryID, aws.StringValue(req.RegistryId), "Expected registryID to match")
+	}).Return(nil, errors.New("rule not found"))
+
+	err := client.DeletePullThroughCacheRule(registryID, "quay")
+	assert.Error(t, err, "Expected error deleting a missing rule")
+}
@@ -0,0 +1,178 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ecr
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Severities lists the severities of scan findings from the most to the least severe
+var Severities = []string{
+	ecr.FindingSeverityCritical,
+	ecr.FindingSeverityHigh,
+	ecr.FindingSeverityMedium,
+	ecr.FindingSeverityLow,
+	ecr.FindingSeverityInformational,
+	ecr.FindingSeverityUndefined,
+}
+
+// SeverityRank returns how severe a finding severity is; higher is more
+// severe. Undefined severities rank with informational findings.
+func SeverityRank(severity string) int {
+	switch severity {
+	case ecr.FindingSeverityCritical:
+		return 4
+	case ecr.FindingSeverityHigh:
+		return 3
+	case ecr.FindingSeverityMedium:
+		return 2
+	case ecr.FindingSeverityLow:
+		return 1
+	}
+	return 0
+}
+
+// ParseSeverity returns the finding severity with the given name, regardless of case
+func ParseSeverity(severity string) (string, error) {
+	for _, s := range Severities {
+		if strings.EqualFold(severity, s) {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("invalid severity %s; must be one of %s", severity, strings.Join(Severities, ", "))
+}
+
+// ImageScanResult contains the status and findings of the latest scan of an image
+type ImageScanResult struct {
+	ImageDigest       string
+	Status            string
+	StatusDescription string
+	CompletedAt       *time.Time
+	SeverityCounts    map[string]int64
+	Findings          []*ecr.ImageScanFinding
+}
+
+// Complete returns whether the scan completed
+func (r *ImageScanResult) Complete() bool {
+	return r.Status == ecr.ScanStatusComplete
+}
+
+// CountAbove returns the number of findings which are more severe than the given severity
+func (r *ImageScanResult) CountAbove(severity string) int64 {
+	var count int64
+	for s, n := range r.SeverityCounts {
+		if SeverityRank(s) > SeverityRank(severity) {
+			count += n
+		}
+	}
+	return count
+}
+
+// ScanImage starts a vulnerability scan of an image and waits for it to
+// complete. Since an image can only be scanned once a day, an image which
+// was scanned recently is not scanned again.
+func (c *ecrClient) ScanImage(registryID, repositoryName string, imageID *ecr.ImageIdentifier) error {
+	log.WithFields(log.Fields{
+		"repository": repositoryName,
+		"image":      imageIDString(imageID),
+	}).Info("Starting image scan")
+
+	input := &ecr.StartImageScanInput{
+		RepositoryName: aws.String(repositoryName),
+		ImageId:        imageID,
+	}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+	if _, err := c.client.StartImageScan(input); err != nil {
+		aerr, ok := err.(awserr.Error)
+		if !ok || aerr.Code() != ecr.ErrCodeLimitExceededException {
+			return errors.Wrapf(err, "unable to start scan of image %s:%s", repositoryName, imageIDString(imageID))
+		}
+		log.Warn("Image was already scanned today; using the results of its latest scan")
+	}
+
+	waitInput := &ecr.DescribeImageScanFindingsInput{
+		RepositoryName: aws.String(repositoryName),
+		ImageId:        imageID,
+	}
+	if registryID != "" {
+		waitInput.SetRegistryId(registryID)
+	}
+	log.Info("Waiting for image scan to complete...")
+	if err := c.client.WaitUntilImageScanComplete(waitInput); err != nil {
+		return errors.Wrapf(err, "scan of image %s:%s did not complete", repositoryName, imageIDString(imageID))
+	}
+	return nil
+}
+
+// GetImageScanFindings returns the result of the latest scan of an image, or
+// nil if the image has not been scanned
+func (c *ecrClient) GetImageScanFindings(registryID, repositoryName string, imageID *ecr.ImageIdentifier) (*ImageScanResult, error) {
+	input := &ecr.DescribeImageScanFindingsInput{
+		RepositoryName: aws.String(repositoryName),
+		ImageId:        imageID,
+	}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+
+	var result *ImageScanResult
+	err := c.client.DescribeImageScanFindingsPages(input, func(resp *ecr.DescribeImageScanFindingsOutput, lastPage bool) bool {
+		if result == nil {
+			result = &ImageScanResult{
+				SeverityCounts: make(map[string]int64),
+			}
+			if resp.ImageId != nil {
+				result.ImageDigest = aws.StringValue(resp.ImageId.ImageDigest)
+			}
+			if resp.ImageScanStatus != nil {
+				result.Status = aws.StringValue(resp.ImageScanStatus.Status)
+				result.StatusDescription = aws.StringValue(resp.ImageScanStatus.Description)
+			}
+		}
+		if findings := resp.ImageScanFindings; findings != nil {
+			if findings.ImageScanCompletedAt != nil {
+				result.CompletedAt = findings.ImageScanCompletedAt
+			}
+			for severity, count := range findings.FindingSeverityCounts {
+				result.SeverityCounts[severity] = aws.Int64Value(count)
+			}
+			result.Findings = append(result.Findings, findings.Findings...)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ecr.ErrCodeScanNotFoundException {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "unable to get scan findings of image %s:%s", repositoryName, imageIDString(imageID))
+	}
+	return result, nil
+}
+
+func imageIDString(imageID *ecr.ImageIdentifier) string {
+	if digest := aws.StringValue(imageID.ImageDigest); digest != "" {
+		return digest
+	}
+	return aws.StringValue(imageID.ImageTag)
+}
@@ -0,0 +1,137 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ecr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSeverity(t *testing.T) {
+	severity, err := ParseSeverity("high")
+	assert.NoError(t, err, "Unexpected error parsing severity")
+	assert.Equal(t, ecr.FindingSeverityHigh, severity)
+
+	_, err = ParseSeverity("severe")
+	assert.Error(t, err, "Expected error for an invalid severity")
+}
+
+func TestImageScanResultCountAbove(t *testing.T) {
+	result := &ImageScanResult{
+		SeverityCounts: map[string]int64{
+			ecr.FindingSeverityCritical:      1,
+			ecr.FindingSeverityHigh:          2,
+			ecr.FindingSeverityMedium:        4,
+			ecr.FindingSeverityUndefined:     8,
+			ecr.FindingSeverityInformational: 16,
+		},
+	}
+	assert.Equal(t, int64(0), result.CountAbove(ecr.FindingSeverityCritical))
+	assert.Equal(t, int64(1), result.CountAbove(ecr.FindingSeverityHigh))
+	assert.Equal(t, int64(7), result.CountAbove(ecr.FindingSeverityLow))
+	assert.Equal(t, int64(7), result.CountAbove(ecr.FindingSeverityInformational))
+}
+
+func TestScanImage(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	imageID := &ecr.ImageIdentifier{ImageTag: aws.String("latest")}
+	gomock.InOrder(
+		mockEcr.EXPECT().StartImageScan(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecr.StartImageScanInput)
+			assert.Equal(t, repositoryName, aws.StringValue(req.RepositoryName), "Expected repositoryName to match")
+			assert.Equal(t, registryID, aws.StringValue(req.RegistryId), "Expected registryID to match")
+			assert.Equal(t, imageID, req.ImageId, "Expected image ID to match")
+		}).Return(&ecr.StartImageScanOutput{}, nil),
+		mockEcr.EXPECT().WaitUntilImageScanComplete(gomock.Any()).Return(nil),
+	)
+
+	err := client.ScanImage(registryID, repositoryName, imageID)
+	assert.NoError(t, err, "Scan Image should not fail")
+}
+
+func TestScanImageScannedToday(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockEcr.EXPECT().StartImageScan(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeLimitExceededException, "limit", nil)),
+		mockEcr.EXPECT().WaitUntilImageScanComplete(gomock.Any()).Return(nil),
+	)
+
+	err := client.ScanImage("", repositoryName, &ecr.ImageIdentifier{ImageTag: aws.String("latest")})
+	assert.NoError(t, err, "Expected the latest scan to be used")
+}
+
+func TestScanImageErrorCase(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().StartImageScan(gomock.Any()).Return(nil, errors.New("something failed"))
+
+	err := client.ScanImage("", repositoryName, &ecr.ImageIdentifier{ImageTag: aws.String("latest")})
+	assert.Error(t, err, "Expected error while StartImageScan is called")
+}
+
+func TestGetImageScanFindings(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	first := &ecr.ImageScanFinding{Name: aws.String("CVE-1"), Severity: aws.String(ecr.FindingSeverityHigh)}
+	second := &ecr.ImageScanFinding{Name: aws.String("CVE-2"), Severity: aws.String(ecr.FindingSeverityLow)}
+	mockEcr.EXPECT().DescribeImageScanFindingsPages(gomock.Any(), gomock.Any()).Do(func(_ interface{}, fn interface{}) {
+		pageFn := fn.(func(*ecr.DescribeImageScanFindingsOutput, bool) bool)
+		pageFn(&ecr.DescribeImageScanFindingsOutput{
+			ImageId:         &ecr.ImageIdentifier{ImageDigest: aws.String(imageDigest)},
+			ImageScanStatus: &ecr.ImageScanStatus{Status: aws.String(ecr.ScanStatusComplete)},
+			ImageScanFindings: &ecr.ImageScanFindings{
+				FindingSeverityCounts: map[string]*int64{
+					ecr.FindingSeverityHigh: aws.Int64(1),
+					ecr.FindingSeverityLow:  aws.Int64(1),
+				},
+				Findings: []*ecr.ImageScanFinding{first},
+			},
+		}, false)
+		pageFn(&ecr.DescribeImageScanFindingsOutput{
+			ImageScanFindings: &ecr.ImageScanFindings{
+				Findings: []*ecr.ImageScanFinding{second},
+			},
+		}, true)
+	}).Return(nil)
+
+	result, err := client.GetImageScanFindings("", repositoryName, &ecr.ImageIdentifier{ImageTag: aws.String("latest")})
+	assert.NoError(t, err, "Get Image Scan Findings should not fail")
+	assert.Equal(t, imageDigest, result.ImageDigest)
+	assert.True(t, result.Complete(), "Expected scan to be complete")
+	assert.Equal(t, map[string]int64{ecr.FindingSeverityHigh: 1, ecr.FindingSeverityLow: 1}, result.SeverityCounts)
+	assert.Equal(t, []*ecr.ImageScanFinding{first, second}, result.Findings)
+}
+
+func TestGetImageScanFindingsNotScanned(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().DescribeImageScanFindingsPages(gomock.Any(), gomock.Any()).Return(awserr.New(ecr.ErrCodeScanNotFoundException, "not found", nil))
+
+	result, err := client.GetImageScanFindings("", repositoryName, &ecr.ImageIdentifier{ImageTag: aws.String("latest")})
+	assert.NoError(t, err, "Expected no error for an image which was not scanned")
+	assert.Nil(t, result)
+}
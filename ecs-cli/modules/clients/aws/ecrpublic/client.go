@@ -0,0 +1,204 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package ecrpublic provides a client for Amazon ECR Public registries.
+package ecrpublic
+
+import (
+	"encoding/base64"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/aws/aws-sdk-go/service/ecrpublic/ecrpubliciface"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// RegistryDomain is the domain of all ECR Public registries; images are
+	// named RegistryDomain/<registry alias>/<repository>
+	RegistryDomain = "public.ecr.aws"
+
+	// Region is the only region with an ECR Public API endpoint
+	Region = "us-east-1"
+)
+
+// ParseRegistry returns the alias of an ECR Public registry such as
+// public.ecr.aws/alias, and false if it is not an ECR Public registry
+func ParseRegistry(registry string) (alias string, ok bool) {
+	if !strings.HasPrefix(registry, RegistryDomain+"/") {
+		return "", false
+	}
+	alias = strings.TrimPrefix(registry, RegistryDomain+"/")
+	return alias, alias != "" && !strings.Contains(alias, "/")
+}
+
+// Client ECR Public interface
+type Client interface {
+	GetAuthorizationToken() (*ecrclient.Auth, error)
+	RegistryAliases() ([]string, error)
+	RepositoryExists(repositoryName string) bool
+	CreateRepository(repositoryName string, tags map[string]*string) (*ecrpublic.Repository, error)
+	TagRepository(repositoryName string, tags map[string]*string) error
+}
+
+// ecrPublicClient implements Client
+type ecrPublicClient struct {
+	client ecrpubliciface.ECRPublicAPI
+	config *config.CommandConfig
+}
+
+// NewClient creates a new ECR Public client, which always uses the endpoint
+// in us-east-1 regardless of the configured region
+func NewClient(config *config.CommandConfig) Client {
+	client := ecrpublic.New(config.Session, aws.NewConfig().WithRegion(Region))
+	client.Handlers.Build.PushBackNamed(clients.CustomUserAgentHandler())
+	return newClient(config, client)
+}
+
+func newClient(config *config.CommandConfig, client ecrpubliciface.ECRPublicAPI) Client {
+	return &ecrPublicClient{
+		config: config,
+		client: client,
+	}
+}
+
+// GetAuthorizationToken gets a token which authorizes Docker to push to the
+// ECR Public registries of the account, and to pull from all of them
+func (c *ecrPublicClient) GetAuthorizationToken() (*ecrclient.Auth, error) {
+	log.Debug("Getting ECR Public authorization token...")
+
+	resp, err := c.client.GetAuthorizationToken(&ecrpublic.GetAuthorizationTokenInput{})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get ECR Public authorization token")
+	}
+	if resp.AuthorizationData == nil {
+		return nil, errors.New("no authorization data returned")
+	}
+
+	data := resp.AuthorizationData
+	decoded, err := base64.StdEncoding.DecodeString(aws.StringValue(data.AuthorizationToken))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decode authorization token")
+	}
+	parts := strings.SplitN(string(decoded), ":", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("invalid authorization token")
+	}
+
+	return &ecrclient.Auth{
+		Username:      parts[0],
+		Password:      parts[1],
+		ProxyEndpoint: "https://" + RegistryDomain,
+		Registry:      RegistryDomain,
+		ExpiresAt:     aws.TimeValue(data.ExpiresAt),
+	}, nil
+}
+
+// RegistryAliases returns the aliases of the ECR Public registry of the account
+func (c *ecrPublicClient) RegistryAliases() ([]string, error) {
+	var aliases []string
+	err := c.client.DescribeRegistriesPages(&ecrpublic.DescribeRegistriesInput{}, func(resp *ecrpublic.DescribeRegistriesOutput, lastPage bool) bool {
+		for _, registry := range resp.Registries {
+			for _, alias := range registry.Aliases {
+				aliases = append(aliases, aws.StringValue(alias.Name))
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to describe ECR Public registries")
+	}
+	return aliases, nil
+}
+
+func (c *ecrPublicClient) RepositoryExists(repositoryName string) bool {
+	_, err := c.describeRepository(repositoryName)
+	log.WithFields(log.Fields{
+		"repository": repositoryName,
+	}).Debug("Check if public repository exists")
+	return err == nil
+}
+
+// CreateRepository creates a public repository with the given tags
+func (c *ecrPublicClient) CreateRepository(repositoryName string, tags map[string]*string) (*ecrpublic.Repository, error) {
+	log.WithFields(log.Fields{
+		"repository": repositoryName,
+	}).Info("Creating public repository")
+
+	resp, err := c.client.CreateRepository(&ecrpublic.CreateRepositoryInput{
+		RepositoryName: aws.String(repositoryName),
+		Tags:           sdkTags(tags),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create public repository")
+	}
+	if resp == nil || resp.Repository == nil {
+		return nil, errors.New("create repository response is empty")
+	}
+
+	log.Info("Repository created")
+	return resp.Repository, nil
+}
+
+// TagRepository adds tags to an existing public repository
+func (c *ecrPublicClient) TagRepository(repositoryName string, tags map[string]*string) error {
+	repository, err := c.describeRepository(repositoryName)
+	if err != nil {
+		return err
+	}
+	_, err = c.client.TagResource(&ecrpublic.TagResourceInput{
+		ResourceArn: repository.RepositoryArn,
+		Tags:        sdkTags(tags),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "unable to tag public repository %s", repositoryName)
+	}
+	return nil
+}
+
+func (c *ecrPublicClient) describeRepository(repositoryName string) (*ecrpublic.Repository, error) {
+	resp, err := c.client.DescribeRepositories(&ecrpublic.DescribeRepositoriesInput{
+		RepositoryNames: aws.StringSlice([]string{repositoryName}),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to describe public repository %s", repositoryName)
+	}
+	if len(resp.Repositories) == 0 {
+		return nil, errors.Errorf("public repository %s not found", repositoryName)
+	}
+	return resp.Repositories[0], nil
+}
+
+// sdkTags returns tags sorted by key, so that requests are deterministic
+func sdkTags(tags map[string]*string) []*ecrpublic.Tag {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var sdkTags []*ecrpublic.Tag
+	for _, key := range keys {
+		sdkTags = append(sdkTags, &ecrpublic.Tag{
+			Key:   aws.String(key),
+			Value: tags[key],
+		})
+	}
+	return sdkTags
+}
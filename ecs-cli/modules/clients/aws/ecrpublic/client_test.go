@@ -0,0 +1,159 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ecrpublic
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecrpublic/mock/sdk"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	repositoryName = "web"
+	repositoryARN  = "arn:aws:ecr-public::123456789012:repository/web"
+)
+
+func setupTestController(t *testing.T) (*mock_ecrpubliciface.MockECRPublicAPI, Client, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockECRPublic := mock_ecrpubliciface.NewMockECRPublicAPI(ctrl)
+	client := newClient(&config.CommandConfig{}, mockECRPublic)
+	return mockECRPublic, client, ctrl
+}
+
+func TestParseRegistry(t *testing.T) {
+	alias, ok := ParseRegistry("public.ecr.aws/my-alias")
+	assert.True(t, ok)
+	assert.Equal(t, "my-alias", alias)
+
+	for _, registry := range []string{"public.ecr.aws", "public.ecr.aws/", "public.ecr.aws/a/b", "123456789012.dkr.ecr.us-east-1.amazonaws.com"} {
+		_, ok = ParseRegistry(registry)
+		assert.False(t, ok, "Expected %s not to be an ECR Public registry", registry)
+	}
+}
+
+func TestGetAuthorizationToken(t *testing.T) {
+	mockECRPublic, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	expiresAt := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+	mockECRPublic.EXPECT().GetAuthorizationToken(gomock.Any()).Return(&ecrpublic.GetAuthorizationTokenOutput{
+		AuthorizationData: &ecrpublic.AuthorizationData{
+			AuthorizationToken: aws.String(base64.StdEncoding.EncodeToString([]byte("AWS:secret"))),
+			ExpiresAt:          aws.Time(expiresAt),
+		},
+	}, nil)
+
+	auth, err := client.GetAuthorizationToken()
+	require.NoError(t, err, "Unexpected error getting authorization token")
+	assert.Equal(t, "AWS", auth.Username)
+	assert.Equal(t, "secret", auth.Password)
+	assert.Equal(t, "public.ecr.aws", auth.Registry)
+	assert.Equal(t, "https://public.ecr.aws", auth.ProxyEndpoint)
+	assert.Equal(t, expiresAt, auth.ExpiresAt)
+}
+
+func TestGetAuthorizationToken_InvalidToken(t *testing.T) {
+	mockECRPublic, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockECRPublic.EXPECT().GetAuthorizationToken(gomock.Any()).Return(&ecrpublic.GetAuthorizationTokenOutput{
+		AuthorizationData: &ecrpublic.AuthorizationData{
+			AuthorizationToken: aws.String(base64.StdEncoding.EncodeToString([]byte("no-separator"))),
+		},
+	}, nil)
+
+	_, err := client.GetAuthorizationToken()
+	assert.Error(t, err, "Expected error for an invalid token")
+}
+
+func TestRegistryAliases(t *testing.T) {
+	mockECRPublic, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockECRPublic.EXPECT().DescribeRegistriesPages(gomock.Any(), gomock.Any()).Do(func(_, fn interface{}) {
+		fn.(func(*ecrpublic.DescribeRegistriesOutput, bool) bool)(&ecrpublic.DescribeRegistriesOutput{
+			Registries: []*ecrpublic.Registry{{
+				Aliases: []*ecrpublic.RegistryAlias{{Name: aws.String("default-alias")}, {Name: aws.String("my-alias")}},
+			}},
+		}, true)
+	}).Return(nil)
+
+	aliases, err := client.RegistryAliases()
+	require.NoError(t, err, "Unexpected error describing registries")
+	assert.Equal(t, []string{"default-alias", "my-alias"}, aliases)
+}
+
+func TestCreateRepository(t *testing.T) {
+	mockECRPublic, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockECRPublic.EXPECT().CreateRepository(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecrpublic.CreateRepositoryInput)
+		assert.Equal(t, repositoryName, aws.StringValue(req.RepositoryName), "Expected repositoryName to match")
+		assert.Equal(t, []*ecrpublic.Tag{
+			{Key: aws.String("env"), Value: aws.String("prod")},
+			{Key: aws.String("team"), Value: aws.String("green")},
+		}, req.Tags, "Expected tags sorted by key")
+	}).Return(&ecrpublic.CreateRepositoryOutput{Repository: &ecrpublic.Repository{RepositoryArn: aws.String(repositoryARN)}}, nil)
+
+	repository, err := client.CreateRepository(repositoryName, map[string]*string{
+		"team": aws.String("green"),
+		"env":  aws.String("prod"),
+	})
+	require.NoError(t, err, "Unexpected error creating repository")
+	assert.Equal(t, repositoryARN, aws.StringValue(repository.RepositoryArn))
+}
+
+func TestRepositoryExists(t *testing.T) {
+	mockECRPublic, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockECRPublic.EXPECT().DescribeRepositories(gomock.Any()).Return(&ecrpublic.DescribeRepositoriesOutput{
+			Repositories: []*ecrpublic.Repository{{RepositoryName: aws.String(repositoryName)}},
+		}, nil),
+		mockECRPublic.EXPECT().DescribeRepositories(gomock.Any()).Return(nil, errors.New("RepositoryNotFoundException")),
+	)
+
+	assert.True(t, client.RepositoryExists(repositoryName))
+	assert.False(t, client.RepositoryExists(repositoryName))
+}
+
+func TestTagRepository(t *testing.T) {
+	mockECRPublic, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockECRPublic.EXPECT().DescribeRepositories(gomock.Any()).Return(&ecrpublic.DescribeRepositoriesOutput{
+			Repositories: []*ecrpublic.Repository{{RepositoryArn: aws.String(repositoryARN)}},
+		}, nil),
+		mockECRPublic.EXPECT().TagResource(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecrpublic.TagResourceInput)
+			assert.Equal(t, repositoryARN, aws.StringValue(req.ResourceArn), "Expected repository ARN to match")
+			assert.Len(t, req.Tags, 1)
+		}).Return(&ecrpublic.TagResourceOutput{}, nil),
+	)
+
+	err := client.TagRepository(repositoryName, map[string]*string{"team": aws.String("green")})
+	assert.NoError(t, err, "Unexpected error tagging repository")
+}
@@ -40,12 +40,14 @@ type ECSClient interface {
 	CreateCluster(clusterName string, tags []*ecs.Tag) (string, error)
 	DeleteCluster(clusterName string) (string, error)
 	IsActiveCluster(clusterName string) (bool, error)
+	DescribeCluster(clusterName string) (*ecs.Cluster, error)
 
 	// Service related
 	CreateService(createServiceInput *ecs.CreateServiceInput) error
 	UpdateService(updateServiceInput *ecs.UpdateServiceInput) error
 	DescribeService(serviceName string) (*ecs.DescribeServicesOutput, error)
 	DeleteService(serviceName string) error
+	GetServices() ([]*ecs.Service, error)
 
 	// Task Definition related
 	RegisterTaskDefinitionIfNeeded(request *ecs.RegisterTaskDefinitionInput, tdCache cache.Cache) (*ecs.TaskDefinition, error)
@@ -59,6 +61,9 @@ type ECSClient interface {
 
 	// Container Instance related
 	GetEC2InstanceIDs(containerInstanceArns []*string) (map[string]string, error)
+	GetContainerInstances() ([]*ecs.ContainerInstance, error)
+	DescribeContainerInstances(containerInstanceArns []*string) ([]*ecs.ContainerInstance, error)
+	DrainContainerInstances(containerInstanceArns []*string) error
 	//Describe Container Instances - Attribute Checker related
 	GetAttributesFromDescribeContainerInstances(containerInstanceArns []*string) (map[string][]*string, error)
 	// Settings related
@@ -459,6 +464,128 @@ func (c *ecsClient) IsActiveCluster(clusterName string) (bool, error) {
 	return false, nil
 }
 
+// DescribeCluster returns the cluster with its statistics, settings and tags.
+func (c *ecsClient) DescribeCluster(clusterName string) (*ecs.Cluster, error) {
+	output, err := c.client.DescribeClusters(&ecs.DescribeClustersInput{
+		Clusters: []*string{aws.String(clusterName)},
+		Include:  aws.StringSlice([]string{ecs.ClusterFieldStatistics, ecs.ClusterFieldSettings, ecs.ClusterFieldTags}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.Failures) > 0 {
+		return nil, fmt.Errorf("Could not describe cluster '%s': %s", clusterName, aws.StringValue(output.Failures[0].Reason))
+	}
+	if len(output.Clusters) == 0 {
+		return nil, fmt.Errorf("Got an empty list of clusters while describing the cluster '%s'", clusterName)
+	}
+	return output.Clusters[0], nil
+}
+
+// GetContainerInstances returns all of the container instances registered to the cluster.
+func (c *ecsClient) GetContainerInstances() ([]*ecs.ContainerInstance, error) {
+	var containerInstanceArns []*string
+	err := c.client.ListContainerInstancesPages(&ecs.ListContainerInstancesInput{
+		Cluster: aws.String(c.config.Cluster),
+	}, func(page *ecs.ListContainerInstancesOutput, lastPage bool) bool {
+		containerInstanceArns = append(containerInstanceArns, page.ContainerInstanceArns...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.DescribeContainerInstances(containerInstanceArns)
+}
+
+// DescribeContainerInstances returns the given container instances of the cluster.
+func (c *ecsClient) DescribeContainerInstances(containerInstanceArns []*string) ([]*ecs.ContainerInstance, error) {
+	var containerInstances []*ecs.ContainerInstance
+	for i := 0; i < len(containerInstanceArns); i += ecsChunkSize {
+		end := i + ecsChunkSize
+		if end > len(containerInstanceArns) {
+			end = len(containerInstanceArns)
+		}
+		output, err := c.client.DescribeContainerInstances(&ecs.DescribeContainerInstancesInput{
+			Cluster:            aws.String(c.config.Cluster),
+			ContainerInstances: containerInstanceArns[i:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+		containerInstances = append(containerInstances, output.ContainerInstances...)
+	}
+	return containerInstances, nil
+}
+
+// updateContainerInstancesStateChunkSize is the maximum number of container
+// instances to pass into UpdateContainerInstancesState
+const updateContainerInstancesStateChunkSize = 10
+
+// DrainContainerInstances sets the status of the given container instances to
+// DRAINING, so that the tasks of services running on them are moved to other
+// container instances.
+func (c *ecsClient) DrainContainerInstances(containerInstanceArns []*string) error {
+	for i := 0; i < len(containerInstanceArns); i += updateContainerInstancesStateChunkSize {
+		end := i + updateContainerInstancesStateChunkSize
+		if end > len(containerInstanceArns) {
+			end = len(containerInstanceArns)
+		}
+		output, err := c.client.UpdateContainerInstancesState(&ecs.UpdateContainerInstancesStateInput{
+			Cluster:            aws.String(c.config.Cluster),
+			ContainerInstances: containerInstanceArns[i:end],
+			Status:             aws.String(ecs.ContainerInstanceStatusDraining),
+		})
+		if err != nil {
+			return err
+		}
+		if len(output.Failures) > 0 {
+			failure := output.Failures[0]
+			return fmt.Errorf("Could not drain container instance %s: %s", aws.StringValue(failure.Arn), aws.StringValue(failure.Reason))
+		}
+		for _, containerInstance := range output.ContainerInstances {
+			log.WithFields(log.Fields{
+				"containerInstance": aws.StringValue(containerInstance.ContainerInstanceArn),
+				"ec2InstanceId":     aws.StringValue(containerInstance.Ec2InstanceId),
+			}).Info("Draining container instance")
+		}
+	}
+	return nil
+}
+
+// describeServicesChunkSize is the maximum number of services to pass into DescribeServices
+const describeServicesChunkSize = 10
+
+// GetServices returns all of the services in the cluster.
+func (c *ecsClient) GetServices() ([]*ecs.Service, error) {
+	var serviceArns []*string
+	err := c.client.ListServicesPages(&ecs.ListServicesInput{
+		Cluster: aws.String(c.config.Cluster),
+	}, func(page *ecs.ListServicesOutput, lastPage bool) bool {
+		serviceArns = append(serviceArns, page.ServiceArns...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var services []*ecs.Service
+	for i := 0; i < len(serviceArns); i += describeServicesChunkSize {
+		end := i + describeServicesChunkSize
+		if end > len(serviceArns) {
+			end = len(serviceArns)
+		}
+		output, err := c.client.DescribeServices(&ecs.DescribeServicesInput{
+			Cluster:  aws.String(c.config.Cluster),
+			Services: serviceArns[i:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+		services = append(services, output.Services...)
+	}
+	return services, nil
+}
+
 // Checks if the given setting is enabled
 func (c *ecsClient) ListAccountSettings(input *ecs.ListAccountSettingsInput) (*ecs.ListAccountSettingsOutput, error) {
 	return c.client.ListAccountSettings(input)
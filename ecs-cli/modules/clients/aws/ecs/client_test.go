@@ -692,6 +692,165 @@ func TestIsActiveCluster(t *testing.T) {
 	assert.True(t, active, "Expected IsActiveCluster to return true when API returned active cluster")
 }
 
+func TestDescribeCluster(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	// API error
+	mockEcs.EXPECT().DescribeClusters(gomock.Any()).Return(nil, errors.New("describe-clusters error"))
+	_, err := client.DescribeCluster(clusterName)
+	assert.Error(t, err, "Expected error when calling DescribeCluster")
+
+	// Non 0 failures
+	mockEcs.EXPECT().DescribeClusters(gomock.Any()).Return(&ecs.DescribeClustersOutput{
+		Failures: []*ecs.Failure{&ecs.Failure{Reason: aws.String("MISSING")}},
+	}, nil)
+	_, err = client.DescribeCluster(clusterName)
+	assert.Error(t, err, "Expected error when API returned failures")
+
+	// Cluster with statistics
+	mockEcs.EXPECT().DescribeClusters(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecs.DescribeClustersInput)
+		assert.Equal(t, clusterName, aws.StringValue(req.Clusters[0]), "Expected clusterName to match")
+		assert.Contains(t, aws.StringValueSlice(req.Include), ecs.ClusterFieldStatistics, "Expected statistics to be included")
+	}).Return(&ecs.DescribeClustersOutput{
+		Clusters: []*ecs.Cluster{&ecs.Cluster{ClusterName: aws.String(clusterName), RunningTasksCount: aws.Int64(2)}},
+	}, nil)
+	cluster, err := client.DescribeCluster(clusterName)
+	assert.NoError(t, err, "Unexpected error when calling DescribeCluster")
+	assert.Equal(t, int64(2), aws.Int64Value(cluster.RunningTasksCount), "Expected RunningTasksCount to match")
+}
+
+func TestGetContainerInstances(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	var containerInstanceArns []*string
+	for i := 0; i < ecsChunkSize+1; i++ {
+		containerInstanceArns = append(containerInstanceArns, aws.String(fmt.Sprintf("containerInstanceArn%d", i)))
+	}
+
+	mockEcs.EXPECT().ListContainerInstancesPages(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
+		req := x.(*ecs.ListContainerInstancesInput)
+		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
+		funct := y.(func(*ecs.ListContainerInstancesOutput, bool) bool)
+		funct(&ecs.ListContainerInstancesOutput{ContainerInstanceArns: containerInstanceArns[:ecsChunkSize]}, false)
+		funct(&ecs.ListContainerInstancesOutput{ContainerInstanceArns: containerInstanceArns[ecsChunkSize:]}, true)
+	}).Return(nil)
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeContainerInstances(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.DescribeContainerInstancesInput)
+			assert.Len(t, req.ContainerInstances, ecsChunkSize, "Expected a full chunk of container instances")
+		}).Return(&ecs.DescribeContainerInstancesOutput{
+			ContainerInstances: []*ecs.ContainerInstance{&ecs.ContainerInstance{}},
+		}, nil),
+		mockEcs.EXPECT().DescribeContainerInstances(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.DescribeContainerInstancesInput)
+			assert.Len(t, req.ContainerInstances, 1, "Expected the remaining container instance")
+		}).Return(&ecs.DescribeContainerInstancesOutput{
+			ContainerInstances: []*ecs.ContainerInstance{&ecs.ContainerInstance{}},
+		}, nil),
+	)
+
+	containerInstances, err := client.GetContainerInstances()
+	assert.NoError(t, err, "Unexpected error when calling GetContainerInstances")
+	assert.Len(t, containerInstances, 2, "Expected container instances of both chunks")
+}
+
+func TestGetContainerInstancesWithoutInstances(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().ListContainerInstancesPages(gomock.Any(), gomock.Any()).Return(nil)
+
+	containerInstances, err := client.GetContainerInstances()
+	assert.NoError(t, err, "Unexpected error when calling GetContainerInstances")
+	assert.Empty(t, containerInstances, "Expected no container instances")
+}
+
+func TestGetServices(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	var serviceArns []*string
+	for i := 0; i < 12; i++ {
+		serviceArns = append(serviceArns, aws.String(fmt.Sprintf("serviceArn%d", i)))
+	}
+
+	mockEcs.EXPECT().ListServicesPages(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
+		funct := y.(func(*ecs.ListServicesOutput, bool) bool)
+		funct(&ecs.ListServicesOutput{ServiceArns: serviceArns}, true)
+	}).Return(nil)
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeServices(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.DescribeServicesInput)
+			assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
+			assert.Len(t, req.Services, 10, "Expected a full chunk of services")
+		}).Return(&ecs.DescribeServicesOutput{Services: []*ecs.Service{&ecs.Service{}}}, nil),
+		mockEcs.EXPECT().DescribeServices(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.DescribeServicesInput)
+			assert.Len(t, req.Services, 2, "Expected the remaining services")
+		}).Return(&ecs.DescribeServicesOutput{Services: []*ecs.Service{&ecs.Service{}}}, nil),
+	)
+
+	services, err := client.GetServices()
+	assert.NoError(t, err, "Unexpected error when calling GetServices")
+	assert.Len(t, services, 2, "Expected services of both chunks")
+}
+
+func TestGetServicesErrorCase(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().ListServicesPages(gomock.Any(), gomock.Any()).Return(errors.New("list-services error"))
+
+	_, err := client.GetServices()
+	assert.Error(t, err, "Expected error when calling GetServices")
+}
+
+func TestDrainContainerInstances(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	var containerInstanceArns []*string
+	for i := 0; i < 11; i++ {
+		containerInstanceArns = append(containerInstanceArns, aws.String(fmt.Sprintf("containerInstanceArn%d", i)))
+	}
+
+	gomock.InOrder(
+		mockEcs.EXPECT().UpdateContainerInstancesState(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.UpdateContainerInstancesStateInput)
+			assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
+			assert.Equal(t, ecs.ContainerInstanceStatusDraining, aws.StringValue(req.Status), "Expected status to be DRAINING")
+			assert.Len(t, req.ContainerInstances, 10, "Expected a full chunk of container instances")
+		}).Return(&ecs.UpdateContainerInstancesStateOutput{}, nil),
+		mockEcs.EXPECT().UpdateContainerInstancesState(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.UpdateContainerInstancesStateInput)
+			assert.Len(t, req.ContainerInstances, 1, "Expected the remaining container instance")
+		}).Return(&ecs.UpdateContainerInstancesStateOutput{}, nil),
+	)
+
+	err := client.DrainContainerInstances(containerInstanceArns)
+	assert.NoError(t, err, "Unexpected error when calling DrainContainerInstances")
+}
+
+func TestDrainContainerInstancesWithFailures(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().UpdateContainerInstancesState(gomock.Any()).Return(&ecs.UpdateContainerInstancesStateOutput{
+		Failures: []*ecs.Failure{
+			&ecs.Failure{
+				Arn:    aws.String("containerInstanceArn"),
+				Reason: aws.String("MISSING"),
+			},
+		},
+	}, nil)
+
+	err := client.DrainContainerInstances([]*string{aws.String("containerInstanceArn")})
+	assert.Error(t, err, "Expected error when a container instance can not be drained")
+}
+
 func TestGetEC2InstanceIDs(t *testing.T) {
 	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
 	defer ctrl.Finish()
@@ -107,6 +107,36 @@ func (mr *MockECSClientMockRecorder) DeleteService(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*MockECSClient)(nil).DeleteService), arg0)
 }
 
+// DescribeCluster mocks base method
+func (m *MockECSClient) DescribeCluster(arg0 string) (*ecs0.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCluster", arg0)
+	ret0, _ := ret[0].(*ecs0.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCluster indicates an expected call of DescribeCluster
+func (mr *MockECSClientMockRecorder) DescribeCluster(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCluster", reflect.TypeOf((*MockECSClient)(nil).DescribeCluster), arg0)
+}
+
+// DescribeContainerInstances mocks base method
+func (m *MockECSClient) DescribeContainerInstances(arg0 []*string) ([]*ecs0.ContainerInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeContainerInstances", arg0)
+	ret0, _ := ret[0].([]*ecs0.ContainerInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeContainerInstances indicates an expected call of DescribeContainerInstances
+func (mr *MockECSClientMockRecorder) DescribeContainerInstances(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeContainerInstances", reflect.TypeOf((*MockECSClient)(nil).DescribeContainerInstances), arg0)
+}
+
 // DescribeService mocks base method
 func (m *MockECSClient) DescribeService(arg0 string) (*ecs0.DescribeServicesOutput, error) {
 	m.ctrl.T.Helper()
@@ -152,6 +182,20 @@ func (mr *MockECSClientMockRecorder) DescribeTasks(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTasks", reflect.TypeOf((*MockECSClient)(nil).DescribeTasks), arg0)
 }
 
+// DrainContainerInstances mocks base method
+func (m *MockECSClient) DrainContainerInstances(arg0 []*string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DrainContainerInstances", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DrainContainerInstances indicates an expected call of DrainContainerInstances
+func (mr *MockECSClientMockRecorder) DrainContainerInstances(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainContainerInstances", reflect.TypeOf((*MockECSClient)(nil).DrainContainerInstances), arg0)
+}
+
 // GetAttributesFromDescribeContainerInstances mocks base method
 func (m *MockECSClient) GetAttributesFromDescribeContainerInstances(arg0 []*string) (map[string][]*string, error) {
 	m.ctrl.T.Helper()
@@ -167,6 +211,21 @@ func (mr *MockECSClientMockRecorder) GetAttributesFromDescribeContainerInstances
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributesFromDescribeContainerInstances", reflect.TypeOf((*MockECSClient)(nil).GetAttributesFromDescribeContainerInstances), arg0)
 }
 
+// GetContainerInstances mocks base method
+func (m *MockECSClient) GetContainerInstances() ([]*ecs0.ContainerInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContainerInstances")
+	ret0, _ := ret[0].([]*ecs0.ContainerInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContainerInstances indicates an expected call of GetContainerInstances
+func (mr *MockECSClientMockRecorder) GetContainerInstances() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContainerInstances", reflect.TypeOf((*MockECSClient)(nil).GetContainerInstances))
+}
+
 // GetEC2InstanceIDs mocks base method
 func (m *MockECSClient) GetEC2InstanceIDs(arg0 []*string) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -182,6 +241,21 @@ func (mr *MockECSClientMockRecorder) GetEC2InstanceIDs(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEC2InstanceIDs", reflect.TypeOf((*MockECSClient)(nil).GetEC2InstanceIDs), arg0)
 }
 
+// GetServices mocks base method
+func (m *MockECSClient) GetServices() ([]*ecs0.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServices")
+	ret0, _ := ret[0].([]*ecs0.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServices indicates an expected call of GetServices
+func (mr *MockECSClientMockRecorder) GetServices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServices", reflect.TypeOf((*MockECSClient)(nil).GetServices))
+}
+
 // GetTasksPages mocks base method
 func (m *MockECSClient) GetTasksPages(arg0 *ecs0.ListTasksInput, arg1 ecs.ProcessTasksAction) error {
 	m.ctrl.T.Helper()
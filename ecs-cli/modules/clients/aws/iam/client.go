@@ -28,6 +28,14 @@ type Client interface {
 	CreateRole(iam.CreateRoleInput) (*iam.CreateRoleOutput, error)
 	CreatePolicy(iam.CreatePolicyInput) (*iam.CreatePolicyOutput, error)
 	CreateOrFindRole(string, string, string, []*iam.Tag) (string, error)
+	DeleteRole(roleName string) (*iam.DeleteRoleOutput, error)
+	DeleteRolePolicy(roleName, policyName string) (*iam.DeleteRolePolicyOutput, error)
+	DetachRolePolicy(policyArn, roleName string) (*iam.DetachRolePolicyOutput, error)
+	GetRole(roleName string) (*iam.GetRoleOutput, error)
+	ListAttachedRolePolicies(roleName string) ([]*iam.AttachedPolicy, error)
+	ListRoleTags(roleName string) ([]*iam.Tag, error)
+	PutRolePolicy(iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error)
+	TagRole(roleName string, tags []*iam.Tag) (*iam.TagRoleOutput, error)
 }
 
 type iamClient struct {
@@ -80,6 +88,114 @@ func (c *iamClient) CreatePolicy(input iam.CreatePolicyInput) (*iam.CreatePolicy
 	return output, nil
 }
 
+func (c *iamClient) DeleteRole(roleName string) (*iam.DeleteRoleOutput, error) {
+	request := iam.DeleteRoleInput{
+		RoleName: aws.String(roleName),
+	}
+
+	output, err := c.client.DeleteRole(&request)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *iamClient) DeleteRolePolicy(roleName, policyName string) (*iam.DeleteRolePolicyOutput, error) {
+	request := iam.DeleteRolePolicyInput{
+		PolicyName: aws.String(policyName),
+		RoleName:   aws.String(roleName),
+	}
+
+	output, err := c.client.DeleteRolePolicy(&request)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *iamClient) DetachRolePolicy(policyArn, roleName string) (*iam.DetachRolePolicyOutput, error) {
+	request := iam.DetachRolePolicyInput{
+		PolicyArn: aws.String(policyArn),
+		RoleName:  aws.String(roleName),
+	}
+
+	output, err := c.client.DetachRolePolicy(&request)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *iamClient) GetRole(roleName string) (*iam.GetRoleOutput, error) {
+	request := iam.GetRoleInput{
+		RoleName: aws.String(roleName),
+	}
+
+	output, err := c.client.GetRole(&request)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// ListAttachedRolePolicies returns every managed policy attached to the role
+func (c *iamClient) ListAttachedRolePolicies(roleName string) ([]*iam.AttachedPolicy, error) {
+	request := iam.ListAttachedRolePoliciesInput{
+		RoleName: aws.String(roleName),
+	}
+
+	var policies []*iam.AttachedPolicy
+	err := c.client.ListAttachedRolePoliciesPages(&request, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+		policies = append(policies, page.AttachedPolicies...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+func (c *iamClient) ListRoleTags(roleName string) ([]*iam.Tag, error) {
+	request := iam.ListRoleTagsInput{
+		RoleName: aws.String(roleName),
+	}
+
+	output, err := c.client.ListRoleTags(&request)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Tags, nil
+}
+
+func (c *iamClient) PutRolePolicy(input iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error) {
+	output, err := c.client.PutRolePolicy(&input)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *iamClient) TagRole(roleName string, tags []*iam.Tag) (*iam.TagRoleOutput, error) {
+	request := iam.TagRoleInput{
+		RoleName: aws.String(roleName),
+		Tags:     tags,
+	}
+
+	output, err := c.client.TagRole(&request)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 // CreateOrFindRole returns a new role ARN or an empty string if role already exists
 func (c *iamClient) CreateOrFindRole(roleName, roleDescription, assumeRolePolicyDoc string, tags []*iam.Tag) (string, error) {
 	createRoleRequest := iam.CreateRoleInput{
@@ -117,6 +117,145 @@ func TestCreatePolicy_ErrorCase(t *testing.T) {
 	assert.Error(t, err, "Expected error when Creating Policy")
 }
 
+func TestDeleteRole(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+
+	expectedInput := iam.DeleteRoleInput{
+		RoleName: aws.String(testRoleName),
+	}
+	mockIAM.EXPECT().DeleteRole(&expectedInput).Return(&iam.DeleteRoleOutput{}, nil)
+
+	_, err := client.DeleteRole(testRoleName)
+	assert.NoError(t, err, "Unexpected error when Deleting Role")
+}
+
+func TestDeleteRolePolicy(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+
+	expectedInput := iam.DeleteRolePolicyInput{
+		PolicyName: aws.String("myFancyInlinePolicy"),
+		RoleName:   aws.String(testRoleName),
+	}
+	mockIAM.EXPECT().DeleteRolePolicy(&expectedInput).Return(&iam.DeleteRolePolicyOutput{}, nil)
+
+	_, err := client.DeleteRolePolicy(testRoleName, "myFancyInlinePolicy")
+	assert.NoError(t, err, "Unexpected error when Deleting Role Policy")
+}
+
+func TestDetachRolePolicy(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+
+	expectedInput := iam.DetachRolePolicyInput{
+		PolicyArn: aws.String(testPolicyArn),
+		RoleName:  aws.String(testRoleName),
+	}
+	mockIAM.EXPECT().DetachRolePolicy(&expectedInput).Return(&iam.DetachRolePolicyOutput{}, nil)
+
+	_, err := client.DetachRolePolicy(testPolicyArn, testRoleName)
+	assert.NoError(t, err, "Unexpected error when Detaching Role Policy")
+}
+
+func TestDetachRolePolicy_ErrorCase(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+	mockIAM.EXPECT().DetachRolePolicy(gomock.Any()).Return(nil, errors.New("something went wrong"))
+
+	_, err := client.DetachRolePolicy(testPolicyArn, testRoleName)
+	assert.Error(t, err, "Expected error when Detaching Role Policy")
+}
+
+func TestGetRole(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+
+	expectedInput := iam.GetRoleInput{
+		RoleName: aws.String(testRoleName),
+	}
+	expectedRole := iam.Role{
+		Arn:      aws.String("arn:" + testRoleName),
+		RoleName: aws.String(testRoleName),
+	}
+	mockIAM.EXPECT().GetRole(&expectedInput).Return(&iam.GetRoleOutput{Role: &expectedRole}, nil)
+
+	output, err := client.GetRole(testRoleName)
+	assert.NoError(t, err, "Unexpected error when Getting Role")
+	assert.Equal(t, expectedRole, *output.Role)
+}
+
+func TestListAttachedRolePolicies(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+
+	firstPage := []*iam.AttachedPolicy{{PolicyArn: aws.String(testPolicyArn)}}
+	secondPage := []*iam.AttachedPolicy{{PolicyArn: aws.String("arn:aws:iam:policy/OtherPolicy")}}
+	expectedInput := iam.ListAttachedRolePoliciesInput{
+		RoleName: aws.String(testRoleName),
+	}
+	mockIAM.EXPECT().ListAttachedRolePoliciesPages(&expectedInput, gomock.Any()).Do(func(input *iam.ListAttachedRolePoliciesInput, fn func(*iam.ListAttachedRolePoliciesOutput, bool) bool) {
+		fn(&iam.ListAttachedRolePoliciesOutput{AttachedPolicies: firstPage}, false)
+		fn(&iam.ListAttachedRolePoliciesOutput{AttachedPolicies: secondPage}, true)
+	}).Return(nil)
+
+	policies, err := client.ListAttachedRolePolicies(testRoleName)
+	assert.NoError(t, err, "Unexpected error when Listing Attached Role Policies")
+	assert.Equal(t, append(firstPage, secondPage...), policies)
+}
+
+func TestListAttachedRolePolicies_ErrorCase(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+	mockIAM.EXPECT().ListAttachedRolePoliciesPages(gomock.Any(), gomock.Any()).Return(errors.New("something went wrong"))
+
+	_, err := client.ListAttachedRolePolicies(testRoleName)
+	assert.Error(t, err, "Expected error when Listing Attached Role Policies")
+}
+
+func TestListRoleTags(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+
+	tags := []*iam.Tag{{Key: aws.String("project"), Value: aws.String("hello")}}
+	expectedInput := iam.ListRoleTagsInput{
+		RoleName: aws.String(testRoleName),
+	}
+	mockIAM.EXPECT().ListRoleTags(&expectedInput).Return(&iam.ListRoleTagsOutput{Tags: tags}, nil)
+
+	actualTags, err := client.ListRoleTags(testRoleName)
+	assert.NoError(t, err, "Unexpected error when Listing Role Tags")
+	assert.Equal(t, tags, actualTags)
+}
+
+func TestPutRolePolicy(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+
+	expectedInput := iam.PutRolePolicyInput{
+		PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"test:TestAction","Resource":"arn:MyStuff"}]}`),
+		PolicyName:     aws.String("myFancyInlinePolicy"),
+		RoleName:       aws.String(testRoleName),
+	}
+	mockIAM.EXPECT().PutRolePolicy(&expectedInput).Return(&iam.PutRolePolicyOutput{}, nil)
+
+	_, err := client.PutRolePolicy(expectedInput)
+	assert.NoError(t, err, "Unexpected error when Putting Role Policy")
+}
+
+func TestTagRole(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+
+	tags := []*iam.Tag{{Key: aws.String("project"), Value: aws.String("hello")}}
+	expectedInput := iam.TagRoleInput{
+		RoleName: aws.String(testRoleName),
+		Tags:     tags,
+	}
+	mockIAM.EXPECT().TagRole(&expectedInput).Return(&iam.TagRoleOutput{}, nil)
+
+	_, err := client.TagRole(testRoleName, tags)
+	assert.NoError(t, err, "Unexpected error when Tagging Role")
+}
+
+func TestTagRole_ErrorCase(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+	mockIAM.EXPECT().TagRole(gomock.Any()).Return(nil, errors.New("something went wrong"))
+
+	_, err := client.TagRole(testRoleName, nil)
+	assert.Error(t, err, "Expected error when Tagging Role")
+}
+
 func setupTestController(t *testing.T) (*mock_iamiface.MockIAMAPI, Client) {
 	ctrl := gomock.NewController(t)
 	mockIAM := mock_iamiface.NewMockIAMAPI(ctrl)
@@ -0,0 +1,70 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ssm
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+)
+
+// Client defines methods for interacting with the SSMAPI interface
+type Client interface {
+	DescribeParameter(name string) (*ssm.ParameterMetadata, error)
+}
+
+type ssmClient struct {
+	client ssmiface.SSMAPI
+}
+
+// NewSSMClient creates an instance of an ssmClient
+func NewSSMClient(config *config.CommandConfig) Client {
+	client := ssm.New(config.Session)
+	client.Handlers.Build.PushBackNamed(clients.CustomUserAgentHandler())
+
+	return newClient(client)
+}
+
+func newClient(client ssmiface.SSMAPI) Client {
+	return &ssmClient{
+		client: client,
+	}
+}
+
+// DescribeParameter returns the metadata, such as the type and KMS key, of a parameter
+func (c *ssmClient) DescribeParameter(name string) (*ssm.ParameterMetadata, error) {
+	request := ssm.DescribeParametersInput{
+		ParameterFilters: []*ssm.ParameterStringFilter{
+			{
+				Key:    aws.String("Name"),
+				Option: aws.String("Equals"),
+				Values: aws.StringSlice([]string{name}),
+			},
+		},
+	}
+
+	output, err := c.client.DescribeParameters(&request)
+	if err != nil {
+		return nil, err
+	}
+	if len(output.Parameters) == 0 {
+		return nil, fmt.Errorf("Parameter %s not found", name)
+	}
+
+	return output.Parameters[0], nil
+}
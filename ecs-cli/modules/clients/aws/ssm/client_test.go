@@ -0,0 +1,69 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ssm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ssm/mock/sdk"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const testParameterName = "/prod/db_password"
+
+func TestDescribeParameter(t *testing.T) {
+	mockSSM, client := setupTestController(t)
+
+	expectedParameter := &ssm.ParameterMetadata{
+		Name:  aws.String(testParameterName),
+		Type:  aws.String(ssm.ParameterTypeSecureString),
+		KeyId: aws.String("alias/my-key"),
+	}
+	mockSSM.EXPECT().DescribeParameters(gomock.Any()).Do(func(input *ssm.DescribeParametersInput) {
+		assert.Equal(t, "Name", aws.StringValue(input.ParameterFilters[0].Key))
+		assert.Equal(t, []string{testParameterName}, aws.StringValueSlice(input.ParameterFilters[0].Values))
+	}).Return(&ssm.DescribeParametersOutput{Parameters: []*ssm.ParameterMetadata{expectedParameter}}, nil)
+
+	output, err := client.DescribeParameter(testParameterName)
+	assert.NoError(t, err, "Unexpected error when Describing Parameter")
+	assert.Equal(t, expectedParameter, output)
+}
+
+func TestDescribeParameter_NotFound(t *testing.T) {
+	mockSSM, client := setupTestController(t)
+	mockSSM.EXPECT().DescribeParameters(gomock.Any()).Return(&ssm.DescribeParametersOutput{}, nil)
+
+	_, err := client.DescribeParameter(testParameterName)
+	assert.Error(t, err, "Expected error when Parameter does not exist")
+}
+
+func TestDescribeParameter_ErrorCase(t *testing.T) {
+	mockSSM, client := setupTestController(t)
+	mockSSM.EXPECT().DescribeParameters(gomock.Any()).Return(nil, errors.New("something went wrong"))
+
+	_, err := client.DescribeParameter(testParameterName)
+	assert.Error(t, err, "Expected error when Describing Parameter")
+}
+
+func setupTestController(t *testing.T) (*mock_ssmiface.MockSSMAPI, Client) {
+	ctrl := gomock.NewController(t)
+	mockSSM := mock_ssmiface.NewMockSSMAPI(ctrl)
+	client := newClient(mockSSM)
+
+	return mockSSM, client
+}
@@ -14,6 +14,9 @@
 package docker
 
 import (
+	"os"
+	"sort"
+
 	log "github.com/sirupsen/logrus"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker/dockeriface"
 	"github.com/fsouza/go-dockerclient"
@@ -27,6 +30,8 @@ const (
 // Client is an interface specifying the subset of
 // github.com/fsouza/go-dockerclient.DockerClient that the agent uses.
 type Client interface {
+	BuildImage(contextDir, dockerfile, name string, buildArgs map[string]string) error
+	InspectImage(name string) (*docker.Image, error)
 	PullImage(repository, tag string, auth docker.AuthConfiguration) error
 	PushImage(repository, tag, registry string, auth docker.AuthConfiguration) error
 	TagImage(image, repository, tag string) error
@@ -52,6 +57,46 @@ func newClient(client dockeriface.DockerAPI) Client {
 	}
 }
 
+// BuildImage builds the image named name[:tag] from the Dockerfile in the
+// build context directory, writing the build output to stdout
+func (c *dockerClient) BuildImage(contextDir, dockerfile, name string, buildArgs map[string]string) error {
+	log.WithFields(log.Fields{
+		"context": contextDir,
+		"image":   name,
+	}).Info("Building image")
+
+	opts := docker.BuildImageOptions{
+		Name:           name,
+		Dockerfile:     dockerfile,
+		ContextDir:     contextDir,
+		RmTmpContainer: true,
+		OutputStream:   os.Stdout,
+	}
+	argNames := make([]string, 0, len(buildArgs))
+	for argName := range buildArgs {
+		argNames = append(argNames, argName)
+	}
+	sort.Strings(argNames)
+	for _, argName := range argNames {
+		opts.BuildArgs = append(opts.BuildArgs, docker.BuildArg{Name: argName, Value: buildArgs[argName]})
+	}
+
+	if err := c.client.BuildImage(opts); err != nil {
+		return errors.Wrap(err, "unable to build image")
+	}
+	log.Info("Image built")
+	return nil
+}
+
+// InspectImage returns the details of a local image
+func (c *dockerClient) InspectImage(name string) (*docker.Image, error) {
+	image, err := c.client.InspectImage(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to inspect image %s", name)
+	}
+	return image, nil
+}
+
 func (c *dockerClient) PushImage(repository, tag, registry string, auth docker.AuthConfiguration) error {
 	log.WithFields(log.Fields{
 		"repository": repository,
@@ -23,6 +23,53 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestBuildImage(t *testing.T) {
+	mockDocker, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDocker.EXPECT().BuildImage(gomock.Any()).Do(func(opts interface{}) {
+		optsInput := opts.(docker.BuildImageOptions)
+		assert.Equal(t, "web:abc123", optsInput.Name, "Expected name to match")
+		assert.Equal(t, "./app", optsInput.ContextDir, "Expected context directory to match")
+		assert.Equal(t, "Dockerfile.prod", optsInput.Dockerfile, "Expected Dockerfile to match")
+		assert.Equal(t, []docker.BuildArg{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}}, optsInput.BuildArgs, "Expected build args sorted by name")
+	}).Return(nil)
+
+	err := client.BuildImage("./app", "Dockerfile.prod", "web:abc123", map[string]string{"B": "2", "A": "1"})
+	assert.NoError(t, err, "Build Image")
+}
+
+func TestBuildImageErrorCase(t *testing.T) {
+	mockDocker, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDocker.EXPECT().BuildImage(gomock.Any()).Return(errors.New("something failed"))
+
+	err := client.BuildImage("./app", "", "web:abc123", nil)
+	assert.Error(t, err, "Expected error while BuildImage is called")
+}
+
+func TestInspectImage(t *testing.T) {
+	mockDocker, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDocker.EXPECT().InspectImage("web:v1-arm64").Return(&docker.Image{Architecture: "arm64"}, nil)
+
+	image, err := client.InspectImage("web:v1-arm64")
+	assert.NoError(t, err, "Inspect Image")
+	assert.Equal(t, "arm64", image.Architecture, "Expected architecture to match")
+}
+
+func TestInspectImageErrorCase(t *testing.T) {
+	mockDocker, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDocker.EXPECT().InspectImage(gomock.Any()).Return(nil, errors.New("something failed"))
+
+	_, err := client.InspectImage("web:v1-arm64")
+	assert.Error(t, err, "Expected error while InspectImage is called")
+}
+
 func TestPushImage(t *testing.T) {
 	mockDocker, client, ctrl := setupTestController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,118 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package registry
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// BlobExists returns whether a repository of the registry has the layer or
+// image config with the given digest
+func (c *registryClient) BlobExists(registry, repository, digest string) (bool, error) {
+	blobURL := c.url(registry, repository, "blobs", digest)
+	resp, err := c.do(registryRequest{method: http.MethodHead, url: blobURL, registry: registry, repository: repository})
+	if err != nil {
+		if statusErr, ok := errors.Cause(err).(*statusError); ok && statusErr.code == http.StatusNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	resp.Body.Close()
+	return true, nil
+}
+
+// CopyBlob copies a layer or image config from one repository to another.
+// Within a registry, the blob is mounted into the destination repository
+// if the registry supports it; otherwise it is streamed from the source to
+// the destination.
+func (c *registryClient) CopyBlob(srcRegistry, srcRepository, dstRegistry, dstRepository string, blob Descriptor) error {
+	log.WithFields(log.Fields{
+		"digest":     blob.Digest,
+		"source":     srcRegistry + "/" + srcRepository,
+		"repository": dstRegistry + "/" + dstRepository,
+	}).Debug("Copying blob")
+
+	uploadURL, err := url.Parse(c.url(dstRegistry, dstRepository, "blobs", "uploads/"))
+	if err != nil {
+		return err
+	}
+	if srcRegistry == dstRegistry && srcRepository != dstRepository {
+		uploadURL.RawQuery = url.Values{"mount": {blob.Digest}, "from": {srcRepository}}.Encode()
+	}
+	resp, err := c.do(registryRequest{method: http.MethodPost, url: uploadURL.String(), registry: dstRegistry, repository: dstRepository})
+	if err != nil {
+		return errors.Wrapf(err, "unable to start upload of %s", blob.Digest)
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusCreated {
+		// mounted from the source repository
+		return nil
+	}
+	location, err := uploadLocation(resp)
+	if err != nil {
+		return err
+	}
+
+	blobResp, err := c.do(registryRequest{
+		method:     http.MethodGet,
+		url:        c.url(srcRegistry, srcRepository, "blobs", blob.Digest),
+		registry:   srcRegistry,
+		repository: srcRepository,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "unable to download %s", blob.Digest)
+	}
+	defer blobResp.Body.Close()
+
+	resp, err = c.do(registryRequest{
+		method:        http.MethodPatch,
+		url:           location.String(),
+		registry:      dstRegistry,
+		repository:    dstRepository,
+		contentType:   "application/octet-stream",
+		stream:        blobResp.Body,
+		contentLength: blob.Size,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "unable to upload %s", blob.Digest)
+	}
+	resp.Body.Close()
+	if location, err = uploadLocation(resp); err != nil {
+		return err
+	}
+
+	query := location.Query()
+	query.Set("digest", blob.Digest)
+	location.RawQuery = query.Encode()
+	resp, err = c.do(registryRequest{method: http.MethodPut, url: location.String(), registry: dstRegistry, repository: dstRepository})
+	if err != nil {
+		return errors.Wrapf(err, "unable to complete upload of %s", blob.Digest)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// uploadLocation returns the URL to continue an upload at, which may be
+// relative to the URL of the previous request
+func uploadLocation(resp *http.Response) (*url.URL, error) {
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return nil, errors.Errorf("%s %s returned no upload location", resp.Request.Method, resp.Request.URL)
+	}
+	return resp.Request.URL.Parse(location)
+}
@@ -0,0 +1,107 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package registry
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fsouza/go-dockerclient"
+	"github.com/stretchr/testify/assert"
+)
+
+var testLayer = []byte("layer")
+
+func TestBlobExists(t *testing.T) {
+	registry := newFakeRegistry()
+	server := httptest.NewServer(registry)
+	defer server.Close()
+	client := testClient(server, nil)
+	registry.blobs[digestOf(testLayer)] = testLayer
+
+	exists, err := client.BlobExists(serverHost(server), "web", digestOf(testLayer))
+	assert.NoError(t, err, "Unexpected error when checking blob")
+	assert.True(t, exists, "Expected blob to exist")
+
+	exists, err = client.BlobExists(serverHost(server), "web", digestOf([]byte("other")))
+	assert.NoError(t, err, "Unexpected error when checking missing blob")
+	assert.False(t, exists, "Expected blob not to exist")
+}
+
+func TestBlobExists_ErrorCase(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	_, err := testClient(server, nil).BlobExists(serverHost(server), "web", digestOf(testLayer))
+	assert.Error(t, err, "Expected error when registry fails")
+}
+
+func TestCopyBlob_BetweenRegistries(t *testing.T) {
+	src := newFakeRegistry()
+	srcServer := httptest.NewServer(src)
+	defer srcServer.Close()
+	src.blobs[digestOf(testLayer)] = testLayer
+
+	dst := newFakeRegistry()
+	dst.username = "AWS"
+	dstServer := httptest.NewServer(dst)
+	defer dstServer.Close()
+
+	client := newClient(http.DefaultClient, "http", map[string]docker.AuthConfiguration{
+		serverHost(dstServer): {Username: "AWS", Password: "secret"},
+	})
+	blob := Descriptor{Digest: digestOf(testLayer), Size: int64(len(testLayer))}
+	err := client.CopyBlob(serverHost(srcServer), "library/web", serverHost(dstServer), "web", blob)
+	assert.NoError(t, err, "Unexpected error when copying blob")
+	assert.Equal(t, testLayer, dst.blobs[blob.Digest], "Expected blob in the destination registry")
+	assert.Equal(t, []string{
+		"POST /v2/web/blobs/uploads/",
+		"PATCH /v2/web/blobs/uploads/1",
+		"PUT /v2/web/blobs/uploads/1",
+	}, dst.requests, "Expected the layer to be uploaded with the authorization of the first request")
+}
+
+func TestCopyBlob_Mount(t *testing.T) {
+	registry := newFakeRegistry()
+	server := httptest.NewServer(registry)
+	defer server.Close()
+	registry.blobs[digestOf(testLayer)] = testLayer
+
+	blob := Descriptor{Digest: digestOf(testLayer), Size: int64(len(testLayer))}
+	err := testClient(server, nil).CopyBlob(serverHost(server), "build/web", serverHost(server), "web", blob)
+	assert.NoError(t, err, "Unexpected error when copying blob")
+	assert.Equal(t, []string{"POST /v2/web/blobs/uploads/"}, registry.requests, "Expected the blob to be mounted")
+	assert.Empty(t, registry.uploads, "Expected no upload")
+}
+
+func TestCopyBlob_SourceMissing(t *testing.T) {
+	registry := newFakeRegistry()
+	server := httptest.NewServer(registry)
+	defer server.Close()
+
+	blob := Descriptor{Digest: digestOf(testLayer), Size: int64(len(testLayer))}
+	err := testClient(server, nil).CopyBlob(serverHost(server), "build/web", serverHost(server), "web", blob)
+	assert.Error(t, err, "Expected error when the source blob does not exist")
+}
+
+func TestIsLoopback(t *testing.T) {
+	assert.True(t, isLoopback("localhost:5000"))
+	assert.True(t, isLoopback("127.0.0.1:5000"))
+	assert.True(t, isLoopback("localhost"))
+	assert.False(t, isLoopback("123456789012.dkr.ecr.us-west-2.amazonaws.com"))
+	assert.False(t, isLoopback("registry.example.com:5000"))
+}
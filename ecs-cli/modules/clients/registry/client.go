@@ -0,0 +1,489 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package registry looks up and pushes image manifests in registries which
+// implement the Docker Registry HTTP API V2.
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/fsouza/go-dockerclient"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DockerHubRegistry is the domain of images on Docker Hub
+	DockerHubRegistry = "docker.io"
+
+	dockerHubHost       = "registry-1.docker.io"
+	dockerHubAuthKey    = "https://index.docker.io/v1/"
+	contentDigestHeader = "Docker-Content-Digest"
+	requestTimeout      = 30 * time.Second
+)
+
+// Client looks up and pushes image manifests in a registry
+type Client interface {
+	GetImageDigest(registry, repository, tag string) (string, error)
+	GetManifest(registry, repository, reference string) (*Manifest, error)
+	PutManifest(registry, repository, tag string, manifest *Manifest) (string, error)
+	GetPlatforms(registry, repository, reference string) ([]Platform, error)
+	BlobExists(registry, repository, digest string) (bool, error)
+	CopyBlob(srcRegistry, srcRepository, dstRegistry, dstRepository string, blob Descriptor) error
+}
+
+// registryClient implements Client
+type registryClient struct {
+	httpClient     *http.Client
+	scheme         string
+	auths          map[string]docker.AuthConfiguration
+	authorizations map[string]string // Authorization headers by repository
+}
+
+// NewClient creates a registry client which authenticates with the
+// credentials stored in the docker config file
+func NewClient() Client {
+	auths := map[string]docker.AuthConfiguration{}
+	dockerAuths, err := docker.NewAuthConfigurationsFromDockerCfg()
+	if err != nil {
+		log.WithError(err).Debug("Unable to read docker credentials; registries will be accessed anonymously")
+	} else {
+		auths = dockerAuths.Configs
+	}
+	return newClient(&http.Client{Timeout: requestTimeout}, "https", auths)
+}
+
+// NewClientWithCredentials creates a registry client which authenticates
+// with the given credentials, such as those from an ECR authorization token
+func NewClientWithCredentials(registry string, auth docker.AuthConfiguration) Client {
+	return newClient(&http.Client{Timeout: requestTimeout}, "https", map[string]docker.AuthConfiguration{
+		registry: auth,
+	})
+}
+
+// NewClientWithAuths creates a registry client which authenticates with the
+// given credentials, and with the credentials stored in the docker config
+// file for other registries
+func NewClientWithAuths(auths map[string]docker.AuthConfiguration) Client {
+	client := NewClient().(*registryClient)
+	if client.auths == nil {
+		client.auths = make(map[string]docker.AuthConfiguration)
+	}
+	for registry, auth := range auths {
+		client.auths[registry] = auth
+	}
+	return client
+}
+
+func newClient(httpClient *http.Client, scheme string, auths map[string]docker.AuthConfiguration) Client {
+	return &registryClient{
+		httpClient:     httpClient,
+		scheme:         scheme,
+		auths:          auths,
+		authorizations: make(map[string]string),
+	}
+}
+
+// GetImageDigest returns the digest of the manifest with the given tag in a
+// repository of the registry
+func (c *registryClient) GetImageDigest(registry, repository, tag string) (string, error) {
+	log.WithFields(log.Fields{
+		"registry":   registry,
+		"repository": repository,
+		"tag":        tag,
+	}).Debug("Getting image digest")
+
+	manifestURL := c.url(registry, repository, "manifests", tag)
+	resp, err := c.do(registryRequest{method: http.MethodHead, url: manifestURL, registry: registry, repository: repository, accept: manifestMediaTypes})
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	if digest := resp.Header.Get(contentDigestHeader); digest != "" {
+		return digest, nil
+	}
+
+	// Not all registries return the digest header, in which case it is
+	// computed from the manifest itself
+	manifest, err := c.GetManifest(registry, repository, tag)
+	if err != nil {
+		return "", err
+	}
+	return manifest.Digest, nil
+}
+
+// GetManifest returns the manifest with the given tag or digest in a
+// repository of the registry
+func (c *registryClient) GetManifest(registry, repository, reference string) (*Manifest, error) {
+	manifestURL := c.url(registry, repository, "manifests", reference)
+	resp, err := c.do(registryRequest{method: http.MethodGet, url: manifestURL, registry: registry, repository: repository, accept: manifestMediaTypes})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read manifest %s", manifestURL)
+	}
+
+	manifest := &Manifest{
+		MediaType: mediaType(resp.Header.Get("Content-Type"), body),
+		Digest:    resp.Header.Get(contentDigestHeader),
+		Body:      body,
+	}
+	if manifest.Digest == "" {
+		manifest.Digest = digestOf(body)
+	}
+	return manifest, nil
+}
+
+// PutManifest pushes a manifest to a repository of the registry under the
+// given tag, and returns its digest
+func (c *registryClient) PutManifest(registry, repository, tag string, manifest *Manifest) (string, error) {
+	log.WithFields(log.Fields{
+		"registry":   registry,
+		"repository": repository,
+		"tag":        tag,
+		"mediaType":  manifest.MediaType,
+	}).Debug("Pushing manifest")
+
+	manifestURL := c.url(registry, repository, "manifests", tag)
+	resp, err := c.do(registryRequest{
+		method:      http.MethodPut,
+		url:         manifestURL,
+		registry:    registry,
+		repository:  repository,
+		contentType: manifest.MediaType,
+		body:        manifest.Body,
+	})
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	if digest := resp.Header.Get(contentDigestHeader); digest != "" {
+		return digest, nil
+	}
+	return digestOf(manifest.Body), nil
+}
+
+// GetPlatforms returns the platforms of the image with the given tag or
+// digest. For a manifest list or image index, these are the platforms of the
+// images it refers to; otherwise it is the platform in the image config.
+func (c *registryClient) GetPlatforms(registry, repository, reference string) ([]Platform, error) {
+	manifest, err := c.GetManifest(registry, repository, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest.IsList() {
+		descriptors, err := manifest.Manifests()
+		if err != nil {
+			return nil, err
+		}
+		var platforms []Platform
+		for _, descriptor := range descriptors {
+			if descriptor.Platform != nil {
+				platforms = append(platforms, *descriptor.Platform)
+			}
+		}
+		return platforms, nil
+	}
+
+	image := imageManifest{}
+	if err = json.Unmarshal(manifest.Body, &image); err != nil {
+		return nil, errors.Wrap(err, "unable to parse manifest")
+	}
+	if image.Config.Digest == "" {
+		return nil, errors.Errorf("manifest %s has no image config", manifest.Digest)
+	}
+	configURL := c.url(registry, repository, "blobs", image.Config.Digest)
+	resp, err := c.do(registryRequest{method: http.MethodGet, url: configURL, registry: registry, repository: repository})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	platform := Platform{}
+	if err = json.NewDecoder(resp.Body).Decode(&platform); err != nil {
+		return nil, errors.Wrap(err, "unable to parse image config")
+	}
+	return []Platform{platform}, nil
+}
+
+func (c *registryClient) url(registry, repository, kind, reference string) string {
+	host := registry
+	if registry == DockerHubRegistry {
+		host = dockerHubHost
+	}
+	scheme := c.scheme
+	if isLoopback(host) {
+		// Like docker, registries on this host, such as a registry
+		// container, are accessed without TLS
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://%s/v2/%s/%s/%s", scheme, host, repository, kind, reference)
+}
+
+// isLoopback returns whether the host of a registry is this machine
+func isLoopback(host string) bool {
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// registryRequest contains the values of a request to the registry API
+type registryRequest struct {
+	method        string
+	url           string
+	registry      string
+	repository    string
+	accept        []string
+	contentType   string
+	body          []byte
+	stream        io.Reader // body which is too large to keep in memory, such as a layer
+	contentLength int64     // length of the stream
+}
+
+// statusError is returned when the registry responds with an unsuccessful status
+type statusError struct {
+	method string
+	url    string
+	status string
+	code   int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("%s %s failed: %s", e.method, e.url, e.status)
+}
+
+// do sends a request, authenticating if the registry requires it, and
+// returns the response if it was successful. The Authorization header of a
+// repository is reused, since a stream can not be sent again after the
+// registry asks for authentication.
+func (c *registryClient) do(request registryRequest) (*http.Response, error) {
+	authKey := request.registry + "/" + request.repository
+	resp, err := c.send(request, c.authorizations[authKey])
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		resp.Body.Close()
+		if request.stream != nil {
+			return nil, errors.Errorf("%s %s failed: %s", request.method, request.url, resp.Status)
+		}
+		authorization, err := c.authorization(resp.Header.Get("WWW-Authenticate"), request.registry)
+		if err != nil {
+			return nil, err
+		}
+		if resp, err = c.send(request, authorization); err != nil {
+			return nil, err
+		}
+		c.authorizations[authKey] = authorization
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resp.Body.Close()
+		return nil, &statusError{method: request.method, url: request.url, status: resp.Status, code: resp.StatusCode}
+	}
+	return resp, nil
+}
+
+func (c *registryClient) send(request registryRequest, authorization string) (*http.Response, error) {
+	var body io.Reader
+	if request.body != nil {
+		body = bytes.NewReader(request.body)
+	} else if request.stream != nil {
+		body = request.stream
+	}
+	req, err := http.NewRequest(request.method, request.url, body)
+	if err != nil {
+		return nil, err
+	}
+	if request.stream != nil {
+		req.ContentLength = request.contentLength
+	}
+	if len(request.accept) > 0 {
+		req.Header.Set("Accept", strings.Join(request.accept, ", "))
+	}
+	if request.contentType != "" {
+		req.Header.Set("Content-Type", request.contentType)
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s %s failed", request.method, request.url)
+	}
+	return resp, nil
+}
+
+// authorization returns the Authorization header for the challenge returned
+// by the registry, fetching a bearer token if the registry uses token auth
+func (c *registryClient) authorization(challenge, registry string) (string, error) {
+	scheme, params := parseChallenge(challenge)
+	auth, hasAuth := c.credentials(registry)
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if !hasAuth {
+			return "", errors.Errorf("registry %s requires credentials; run docker login %s", registry, registry)
+		}
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth(auth.Username, auth.Password)
+		return req.Header.Get("Authorization"), nil
+	case "bearer":
+		token, err := c.token(params, auth, hasAuth)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to authenticate with registry %s", registry)
+		}
+		return "Bearer " + token, nil
+	default:
+		return "", errors.Errorf("registry %s requested unsupported authentication %q", registry, challenge)
+	}
+}
+
+// token fetches a bearer token from the auth server named in the challenge
+func (c *registryClient) token(params map[string]string, auth docker.AuthConfiguration, hasAuth bool) (string, error) {
+	realm := params["realm"]
+	if realm == "" {
+		return "", errors.New("token challenge has no realm")
+	}
+	tokenURL, err := url.Parse(realm)
+	if err != nil {
+		return "", err
+	}
+	query := tokenURL.Query()
+	for _, param := range []string{"service", "scope"} {
+		if value := params[param]; value != "" {
+			query.Set(param, value)
+		}
+	}
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if hasAuth {
+		req.SetBasicAuth(auth.Username, auth.Password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("token request failed: %s", resp.Status)
+	}
+
+	var tokenResp struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", errors.Wrap(err, "unable to decode token response")
+	}
+	if tokenResp.Token != "" {
+		return tokenResp.Token, nil
+	}
+	if tokenResp.AccessToken != "" {
+		return tokenResp.AccessToken, nil
+	}
+	return "", errors.New("token response has no token")
+}
+
+// credentials returns the docker credentials for the registry. Entries in
+// the docker config file may be keyed by host or by URL.
+func (c *registryClient) credentials(registry string) (docker.AuthConfiguration, bool) {
+	if registry == DockerHubRegistry {
+		if auth, ok := c.auths[dockerHubAuthKey]; ok {
+			return auth, true
+		}
+	}
+	if auth, ok := c.auths[registry]; ok {
+		return auth, true
+	}
+	for key, auth := range c.auths {
+		if registryHost(key) == registry {
+			return auth, true
+		}
+	}
+	return docker.AuthConfiguration{}, false
+}
+
+// registryHost strips the scheme and path from a docker config auth key
+func registryHost(key string) string {
+	if i := strings.Index(key, "://"); i >= 0 {
+		key = key[i+3:]
+	}
+	if i := strings.Index(key, "/"); i >= 0 {
+		key = key[:i]
+	}
+	return key
+}
+
+// parseChallenge parses a WWW-Authenticate header such as
+// Bearer realm="https://auth.docker.io/token",service="registry.docker.io"
+// into its scheme and parameters
+func parseChallenge(challenge string) (string, map[string]string) {
+	params := make(map[string]string)
+	challenge = strings.TrimSpace(challenge)
+	i := strings.Index(challenge, " ")
+	if i < 0 {
+		return challenge, params
+	}
+	scheme, rest := challenge[:i], challenge[i+1:]
+
+	for rest != "" {
+		rest = strings.TrimLeft(rest, " ,")
+		eq := strings.Index(rest, "=")
+		if eq < 0 {
+			break
+		}
+		name := strings.ToLower(strings.TrimSpace(rest[:eq]))
+		rest = rest[eq+1:]
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				value, rest = rest[1:], ""
+			} else {
+				value, rest = rest[1:end+1], rest[end+2:]
+			}
+		} else {
+			end := strings.Index(rest, ",")
+			if end < 0 {
+				value, rest = rest, ""
+			} else {
+				value, rest = rest[:end], rest[end+1:]
+			}
+		}
+		params[name] = value
+	}
+	return scheme, params
+}
@@ -0,0 +1,300 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package registry
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/fsouza/go-dockerclient"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testRepository = "library/nginx"
+	testTag        = "1.19"
+	testDigest     = "sha256:0123456789abcdef"
+)
+
+func TestGetImageDigest_Anonymous(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodHead, r.Method)
+		assert.Equal(t, "/v2/library/nginx/manifests/1.19", r.URL.Path)
+		assert.Contains(t, r.Header.Get("Accept"), "application/vnd.docker.distribution.manifest.list.v2+json")
+		w.Header().Set(contentDigestHeader, testDigest)
+	}))
+	defer server.Close()
+
+	client := testClient(server, nil)
+	digest, err := client.GetImageDigest(serverHost(server), testRepository, testTag)
+	assert.NoError(t, err, "Unexpected error when getting digest")
+	assert.Equal(t, testDigest, digest)
+}
+
+func TestGetImageDigest_BearerToken(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			user, password, ok := r.BasicAuth()
+			assert.True(t, ok, "Expected credentials in token request")
+			assert.Equal(t, "user", user)
+			assert.Equal(t, "secret", password)
+			assert.Equal(t, "registry", r.URL.Query().Get("service"))
+			assert.Equal(t, "repository:library/nginx:pull", r.URL.Query().Get("scope"))
+			fmt.Fprint(w, `{"token":"abc"}`)
+		default:
+			if r.Header.Get("Authorization") != "Bearer abc" {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:library/nginx:pull"`, server.URL))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set(contentDigestHeader, testDigest)
+		}
+	}))
+	defer server.Close()
+
+	client := testClient(server, map[string]docker.AuthConfiguration{
+		"https://" + serverHost(server): {Username: "user", Password: "secret"},
+	})
+	digest, err := client.GetImageDigest(serverHost(server), testRepository, testTag)
+	assert.NoError(t, err, "Unexpected error when getting digest")
+	assert.Equal(t, testDigest, digest)
+}
+
+func TestGetImageDigest_BasicAuthWithoutCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := testClient(server, nil)
+	_, err := client.GetImageDigest(serverHost(server), testRepository, testTag)
+	assert.Error(t, err, "Expected error when registry requires credentials")
+}
+
+func TestGetImageDigest_ComputedFromManifest(t *testing.T) {
+	manifest := `{"schemaVersion":2}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, manifest)
+		}
+	}))
+	defer server.Close()
+
+	client := testClient(server, nil)
+	digest, err := client.GetImageDigest(serverHost(server), testRepository, testTag)
+	assert.NoError(t, err, "Unexpected error when getting digest")
+	assert.Equal(t, fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(manifest))), digest)
+}
+
+func TestGetImageDigest_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := testClient(server, nil)
+	_, err := client.GetImageDigest(serverHost(server), testRepository, testTag)
+	assert.Error(t, err, "Expected error when tag does not exist")
+}
+
+func TestPutManifestAndGetPlatforms(t *testing.T) {
+	registry := newFakeRegistry()
+	server := httptest.NewServer(registry)
+	defer server.Close()
+	client := testClient(server, nil)
+	host := serverHost(server)
+
+	config := []byte(`{"architecture":"arm64","os":"linux","variant":"v8"}`)
+	registry.blobs[digestOf(config)] = config
+	armManifest := &Manifest{
+		MediaType: MediaTypeManifest,
+		Body:      []byte(fmt.Sprintf(`{"schemaVersion":2,"mediaType":"%s","config":{"digest":"%s"}}`, MediaTypeManifest, digestOf(config))),
+	}
+	armDigest, err := client.PutManifest(host, "web", "v1-arm64", armManifest)
+	assert.NoError(t, err, "Unexpected error when pushing manifest")
+	assert.Equal(t, digestOf(armManifest.Body), armDigest)
+
+	platforms, err := client.GetPlatforms(host, "web", "v1-arm64")
+	assert.NoError(t, err, "Unexpected error when getting platforms of an image")
+	assert.Equal(t, []Platform{{OS: "linux", Architecture: "arm64", Variant: "v8"}}, platforms)
+
+	armManifest, err = client.GetManifest(host, "web", "v1-arm64")
+	assert.NoError(t, err, "Unexpected error when getting manifest")
+	assert.Equal(t, MediaTypeManifest, armManifest.MediaType)
+	assert.Equal(t, armDigest, armManifest.Digest)
+
+	amdManifest := &Manifest{MediaType: MediaTypeManifest, Digest: "sha256:amd", Body: []byte(`{"schemaVersion":2}`)}
+	list, err := NewManifestList([]*Manifest{amdManifest, armManifest}, []Platform{
+		{OS: "linux", Architecture: "amd64"},
+		{OS: "linux", Architecture: "arm64", Variant: "v8"},
+	})
+	assert.NoError(t, err, "Unexpected error when creating manifest list")
+	_, err = client.PutManifest(host, "web", "v1", list)
+	assert.NoError(t, err, "Unexpected error when pushing manifest list")
+	assert.Equal(t, MediaTypeManifestList, registry.mediaTypes["web:v1"], "Expected manifest list content type")
+
+	platforms, err = client.GetPlatforms(host, "web", "v1")
+	assert.NoError(t, err, "Unexpected error when getting platforms of a manifest list")
+	assert.Equal(t, []Platform{
+		{OS: "linux", Architecture: "amd64"},
+		{OS: "linux", Architecture: "arm64", Variant: "v8"},
+	}, platforms)
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/nginx:pull,push"`)
+	assert.Equal(t, "Bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.docker.io/token",
+		"service": "registry.docker.io",
+		"scope":   "repository:library/nginx:pull,push",
+	}, params)
+}
+
+func TestCredentials(t *testing.T) {
+	client := &registryClient{auths: map[string]docker.AuthConfiguration{
+		"https://index.docker.io/v1/": {Username: "hub"},
+		"quay.io":                     {Username: "quay"},
+		"https://ghcr.io":             {Username: "github"},
+	}}
+
+	for registry, username := range map[string]string{
+		DockerHubRegistry: "hub",
+		"quay.io":         "quay",
+		"ghcr.io":         "github",
+	} {
+		auth, ok := client.credentials(registry)
+		assert.True(t, ok, "Expected credentials for %s", registry)
+		assert.Equal(t, username, auth.Username)
+	}
+
+	_, ok := client.credentials("gcr.io")
+	assert.False(t, ok, "Expected no credentials for gcr.io")
+}
+
+var fakeRegistryPath = regexp.MustCompile(`^/v2/(.+)/(manifests|blobs)/(.+)$`)
+
+// fakeRegistry stores manifests and blobs in memory, in place of a registry
+type fakeRegistry struct {
+	manifests  map[string][]byte
+	mediaTypes map[string]string
+	blobs      map[string][]byte
+	uploads    map[string][]byte
+	requests   []string // method and path of each authorized request
+	username   string   // if set, requests must use basic auth with this username
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{
+		manifests:  map[string][]byte{},
+		mediaTypes: map[string]string{},
+		blobs:      map[string][]byte{},
+		uploads:    map[string][]byte{},
+	}
+}
+
+func (f *fakeRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if f.username != "" {
+		if username, _, ok := r.BasicAuth(); !ok || username != f.username {
+			w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	}
+	f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+
+	parts := fakeRegistryPath.FindStringSubmatch(r.URL.Path)
+	if parts == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	repository, kind, reference := parts[1], parts[2], parts[3]
+	key := repository + ":" + reference
+
+	switch {
+	case kind == "manifests" && r.Method == http.MethodPut:
+		body, _ := ioutil.ReadAll(r.Body)
+		f.manifests[key] = body
+		f.mediaTypes[key] = r.Header.Get("Content-Type")
+		w.Header().Set(contentDigestHeader, digestOf(body))
+		w.WriteHeader(http.StatusCreated)
+	case kind == "manifests":
+		body, ok := f.manifests[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", f.mediaTypes[key])
+		w.Header().Set(contentDigestHeader, digestOf(body))
+		w.Write(body)
+	case kind == "blobs" && strings.HasPrefix(reference, "uploads/"):
+		f.upload(w, r, repository, strings.TrimPrefix(reference, "uploads/"))
+	case kind == "blobs":
+		body, ok := f.blobs[reference]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(body)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// upload implements blob uploads, which are started with a POST, continued
+// with a PATCH of the content and completed with a PUT of the digest
+func (f *fakeRegistry) upload(w http.ResponseWriter, r *http.Request, repository, id string) {
+	switch r.Method {
+	case http.MethodPost:
+		if mount := r.URL.Query().Get("mount"); mount != "" {
+			if _, ok := f.blobs[mount]; ok {
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+		}
+		id = fmt.Sprint(len(f.uploads) + 1)
+		f.uploads[id] = nil
+	case http.MethodPatch:
+		body, _ := ioutil.ReadAll(r.Body)
+		f.uploads[id] = append(f.uploads[id], body...)
+	case http.MethodPut:
+		digest := r.URL.Query().Get("digest")
+		if digestOf(f.uploads[id]) != digest {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.blobs[digest] = f.uploads[id]
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	w.Header().Set("Location", fmt.Sprintf("/v2/%s/blobs/uploads/%s", repository, id))
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func testClient(server *httptest.Server, auths map[string]docker.AuthConfiguration) Client {
+	return newClient(server.Client(), "http", auths)
+}
+
+func serverHost(server *httptest.Server) string {
+	return strings.TrimPrefix(server.URL, "http://")
+}
@@ -0,0 +1,204 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package registry
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Media types of image manifests
+const (
+	MediaTypeManifestList     = "application/vnd.docker.distribution.manifest.list.v2+json"
+	MediaTypeImageIndex       = "application/vnd.oci.image.index.v1+json"
+	MediaTypeManifest         = "application/vnd.docker.distribution.manifest.v2+json"
+	MediaTypeOCIManifest      = "application/vnd.oci.image.manifest.v1+json"
+	manifestListSchemaVersion = 2
+)
+
+// manifestMediaTypes are the manifest formats accepted from the registry.
+// Manifest lists and indexes are preferred, so that the digest of a multi
+// platform image refers to all of its platforms.
+var manifestMediaTypes = []string{
+	MediaTypeManifestList,
+	MediaTypeImageIndex,
+	MediaTypeManifest,
+	MediaTypeOCIManifest,
+}
+
+// Manifest is an image manifest, manifest list or image index as stored in
+// the registry
+type Manifest struct {
+	MediaType string
+	Digest    string
+	Body      []byte
+}
+
+// IsList returns whether the manifest is a manifest list or image index,
+// which refers to an image for each of several platforms
+func (m *Manifest) IsList() bool {
+	return m.MediaType == MediaTypeManifestList || m.MediaType == MediaTypeImageIndex
+}
+
+// Platform is the operating system and CPU architecture that an image runs on
+type Platform struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+	Variant      string `json:"variant,omitempty"`
+}
+
+// String returns the platform in the os/arch[/variant] format used by docker
+func (p Platform) String() string {
+	platform := p.OS + "/" + p.Architecture
+	if p.Variant != "" {
+		platform += "/" + p.Variant
+	}
+	return platform
+}
+
+// ParsePlatform parses a platform in the os/arch[/variant] format, such as linux/arm64
+func ParsePlatform(platform string) (Platform, error) {
+	parts := strings.Split(strings.TrimSpace(platform), "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Platform{}, fmt.Errorf("invalid platform %q; specify it as os/arch[/variant], for example linux/arm64", platform)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return Platform{}, fmt.Errorf("invalid platform %q; specify it as os/arch[/variant], for example linux/arm64", platform)
+		}
+	}
+	p := Platform{OS: parts[0], Architecture: parts[1]}
+	if len(parts) == 3 {
+		p.Variant = parts[2]
+	}
+	return p, nil
+}
+
+// Descriptor refers to a manifest or blob by its digest
+type Descriptor struct {
+	MediaType string    `json:"mediaType"`
+	Digest    string    `json:"digest"`
+	Size      int64     `json:"size"`
+	Platform  *Platform `json:"platform,omitempty"`
+	URLs      []string  `json:"urls,omitempty"` // set for foreign layers, which are not stored in the registry
+}
+
+// ManifestList is a Docker manifest list or OCI image index
+type ManifestList struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	MediaType     string       `json:"mediaType"`
+	Manifests     []Descriptor `json:"manifests"`
+}
+
+// NewManifestList creates a manifest list from the manifests of an image for
+// each platform. An OCI image index is created if all the manifests are OCI
+// manifests, otherwise a Docker manifest list is created.
+func NewManifestList(manifests []*Manifest, platforms []Platform) (*Manifest, error) {
+	list := ManifestList{
+		SchemaVersion: manifestListSchemaVersion,
+		MediaType:     MediaTypeImageIndex,
+	}
+	for i, manifest := range manifests {
+		if manifest.IsList() {
+			return nil, fmt.Errorf("image for platform %s is already a manifest list", platforms[i])
+		}
+		if manifest.MediaType != MediaTypeOCIManifest {
+			list.MediaType = MediaTypeManifestList
+		}
+		platform := platforms[i]
+		list.Manifests = append(list.Manifests, Descriptor{
+			MediaType: manifest.MediaType,
+			Digest:    manifest.Digest,
+			Size:      int64(len(manifest.Body)),
+			Platform:  &platform,
+		})
+	}
+
+	body, err := json.Marshal(list)
+	if err != nil {
+		return nil, err
+	}
+	return &Manifest{
+		MediaType: list.MediaType,
+		Digest:    digestOf(body),
+		Body:      body,
+	}, nil
+}
+
+// Manifests returns the descriptors of the images which a manifest list or
+// image index refers to
+func (m *Manifest) Manifests() ([]Descriptor, error) {
+	if !m.IsList() {
+		return nil, fmt.Errorf("manifest %s is not a manifest list", m.Digest)
+	}
+	list := ManifestList{}
+	if err := json.Unmarshal(m.Body, &list); err != nil {
+		return nil, fmt.Errorf("unable to parse manifest list: %v", err)
+	}
+	return list.Manifests, nil
+}
+
+// Blobs returns the descriptors of the image config and layers which an
+// image manifest refers to. Foreign layers are left out, since they are
+// downloaded from their own URLs rather than the registry.
+func (m *Manifest) Blobs() ([]Descriptor, error) {
+	if m.IsList() {
+		return nil, fmt.Errorf("manifest %s is a manifest list", m.Digest)
+	}
+	image := imageManifest{}
+	if err := json.Unmarshal(m.Body, &image); err != nil {
+		return nil, fmt.Errorf("unable to parse manifest: %v", err)
+	}
+	if image.Config.Digest == "" {
+		return nil, fmt.Errorf("manifest %s has no image config", m.Digest)
+	}
+	blobs := []Descriptor{image.Config}
+	for _, layer := range image.Layers {
+		if len(layer.URLs) == 0 {
+			blobs = append(blobs, layer)
+		}
+	}
+	return blobs, nil
+}
+
+// imageManifest contains the fields of an image manifest which refer to blobs
+type imageManifest struct {
+	Config Descriptor   `json:"config"`
+	Layers []Descriptor `json:"layers"`
+}
+
+// mediaType returns the media type of a manifest from the Content-Type
+// header, or from the manifest itself if the header is missing
+func mediaType(contentType string, body []byte) string {
+	if i := strings.Index(contentType, ";"); i >= 0 {
+		contentType = contentType[:i]
+	}
+	contentType = strings.TrimSpace(contentType)
+	if contentType != "" && contentType != "application/json" && contentType != "application/octet-stream" {
+		return contentType
+	}
+	manifest := struct {
+		MediaType string `json:"mediaType"`
+	}{}
+	if err := json.Unmarshal(body, &manifest); err == nil && manifest.MediaType != "" {
+		return manifest.MediaType
+	}
+	return contentType
+}
+
+func digestOf(body []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(body))
+}
@@ -0,0 +1,116 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package registry
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePlatform(t *testing.T) {
+	platform, err := ParsePlatform("linux/arm/v7")
+	assert.NoError(t, err, "Unexpected error when parsing platform")
+	assert.Equal(t, Platform{OS: "linux", Architecture: "arm", Variant: "v7"}, platform)
+	assert.Equal(t, "linux/arm/v7", platform.String())
+
+	platform, err = ParsePlatform(" linux/amd64")
+	assert.NoError(t, err, "Unexpected error when parsing platform")
+	assert.Equal(t, "linux/amd64", platform.String())
+
+	for _, invalid := range []string{"", "amd64", "linux/", "linux/arm/v7/extra"} {
+		_, err = ParsePlatform(invalid)
+		assert.Error(t, err, "Expected error for platform %q", invalid)
+	}
+}
+
+func TestNewManifestList_OCI(t *testing.T) {
+	manifests := []*Manifest{
+		{MediaType: MediaTypeOCIManifest, Digest: "sha256:amd", Body: []byte("amd64")},
+		{MediaType: MediaTypeOCIManifest, Digest: "sha256:arm", Body: []byte("arm")},
+	}
+	platforms := []Platform{{OS: "linux", Architecture: "amd64"}, {OS: "linux", Architecture: "arm64"}}
+
+	list, err := NewManifestList(manifests, platforms)
+	assert.NoError(t, err, "Unexpected error when creating manifest list")
+	assert.Equal(t, MediaTypeImageIndex, list.MediaType, "Expected an OCI index for OCI manifests")
+	assert.Equal(t, digestOf(list.Body), list.Digest)
+
+	index := ManifestList{}
+	assert.NoError(t, json.Unmarshal(list.Body, &index))
+	assert.Equal(t, 2, index.SchemaVersion)
+	assert.Equal(t, []Descriptor{
+		{MediaType: MediaTypeOCIManifest, Digest: "sha256:amd", Size: 5, Platform: &platforms[0]},
+		{MediaType: MediaTypeOCIManifest, Digest: "sha256:arm", Size: 3, Platform: &platforms[1]},
+	}, index.Manifests)
+}
+
+func TestNewManifestList_Docker(t *testing.T) {
+	manifests := []*Manifest{
+		{MediaType: MediaTypeManifest, Digest: "sha256:amd"},
+		{MediaType: MediaTypeOCIManifest, Digest: "sha256:arm"},
+	}
+	list, err := NewManifestList(manifests, []Platform{{OS: "linux", Architecture: "amd64"}, {OS: "linux", Architecture: "arm64"}})
+	assert.NoError(t, err, "Unexpected error when creating manifest list")
+	assert.Equal(t, MediaTypeManifestList, list.MediaType, "Expected a Docker manifest list when any manifest is a Docker manifest")
+}
+
+func TestNewManifestList_NestedList(t *testing.T) {
+	manifests := []*Manifest{{MediaType: MediaTypeManifestList, Digest: "sha256:list"}}
+	_, err := NewManifestList(manifests, []Platform{{OS: "linux", Architecture: "amd64"}})
+	assert.Error(t, err, "Expected error when an image is already a manifest list")
+}
+
+func TestMediaType(t *testing.T) {
+	assert.Equal(t, MediaTypeManifest, mediaType(MediaTypeManifest+"; charset=utf-8", nil))
+	assert.Equal(t, MediaTypeImageIndex, mediaType("application/json", []byte(`{"mediaType":"`+MediaTypeImageIndex+`"}`)))
+	assert.Equal(t, "", mediaType("", []byte(`{}`)))
+}
+
+func TestManifestBlobs(t *testing.T) {
+	manifest := &Manifest{
+		MediaType: MediaTypeManifest,
+		Body: []byte(`{"schemaVersion":2,"config":{"digest":"sha256:config","size":10},"layers":[` +
+			`{"digest":"sha256:layer","size":20},` +
+			`{"digest":"sha256:foreign","size":30,"urls":["https://example.com/layer"]}]}`),
+	}
+	blobs, err := manifest.Blobs()
+	assert.NoError(t, err, "Unexpected error when getting blobs")
+	assert.Equal(t, []Descriptor{
+		{Digest: "sha256:config", Size: 10},
+		{Digest: "sha256:layer", Size: 20},
+	}, blobs, "Expected config and layers without foreign layers")
+
+	_, err = (&Manifest{MediaType: MediaTypeManifest, Body: []byte(`{"schemaVersion":2}`)}).Blobs()
+	assert.Error(t, err, "Expected error for manifest without config")
+
+	_, err = (&Manifest{MediaType: MediaTypeManifestList}).Blobs()
+	assert.Error(t, err, "Expected error for manifest list")
+}
+
+func TestManifestManifests(t *testing.T) {
+	list, err := NewManifestList([]*Manifest{
+		{MediaType: MediaTypeManifest, Digest: "sha256:amd", Body: []byte("amd64")},
+	}, []Platform{{OS: "linux", Architecture: "amd64"}})
+	assert.NoError(t, err, "Unexpected error when creating manifest list")
+
+	descriptors, err := list.Manifests()
+	assert.NoError(t, err, "Unexpected error when getting manifests")
+	assert.Len(t, descriptors, 1)
+	assert.Equal(t, "sha256:amd", descriptors[0].Digest)
+
+	_, err = (&Manifest{MediaType: MediaTypeManifest}).Manifests()
+	assert.Error(t, err, "Expected error for image manifest")
+}
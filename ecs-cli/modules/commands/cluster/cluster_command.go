@@ -28,7 +28,7 @@ func UpCommand() cli.Command {
 		Usage:        usage.ClusterUp,
 		Before:       ecscli.BeforeApp,
 		Action:       cluster.ClusterUp,
-		Flags:        flags.AppendFlags(clusterUpFlags(), flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.DebugFlag()),
+		Flags:        flags.AppendFlags(clusterUpFlags(), changeSetFlags(), flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.DebugFlag()),
 		OnUsageError: flags.UsageErrorFactory("up"),
 	}
 }
@@ -48,11 +48,63 @@ func ScaleCommand() cli.Command {
 		Name:         "scale",
 		Usage:        usage.ClusterScale,
 		Action:       cluster.ClusterScale,
-		Flags:        flags.AppendFlags(clusterScaleFlags(), flags.OptionalConfigFlags()),
+		Flags:        flags.AppendFlags(clusterScaleFlags(), changeSetFlags(), flags.OptionalConfigFlags()),
 		OnUsageError: flags.UsageErrorFactory("scale"),
 	}
 }
 
+func MigrateTemplateCommand() cli.Command {
+	return cli.Command{
+		Name:         "migrate-template",
+		Usage:        usage.ClusterMigrateTemplate,
+		Action:       cluster.ClusterMigrateTemplate,
+		Flags:        flags.AppendFlags(clusterMigrateTemplateFlags(), flags.OptionalConfigFlags()),
+		OnUsageError: flags.UsageErrorFactory("migrate-template"),
+	}
+}
+
+func DescribeCommand() cli.Command {
+	return cli.Command{
+		Name:         "describe",
+		Usage:        usage.ClusterDescribe,
+		Action:       cluster.ClusterDescribe,
+		Flags:        flags.AppendFlags(clusterDescribeFlags(), flags.OptionalConfigFlags()),
+		OnUsageError: flags.UsageErrorFactory("describe"),
+	}
+}
+
+func UpgradeCommand() cli.Command {
+	return cli.Command{
+		Name:         "upgrade",
+		Usage:        usage.ClusterUpgrade,
+		Action:       cluster.ClusterUpgrade,
+		Flags:        flags.AppendFlags(clusterUpgradeFlags(), flags.OptionalConfigFlags(), flags.DebugFlag()),
+		OnUsageError: flags.UsageErrorFactory("upgrade"),
+	}
+}
+
+// InstancesCommand provides the commands which manage the container instances of a cluster.
+func InstancesCommand() cli.Command {
+	return cli.Command{
+		Name:  "instances",
+		Usage: usage.ClusterInstances,
+		Subcommands: []cli.Command{
+			instancesDrainCommand(),
+		},
+	}
+}
+
+func instancesDrainCommand() cli.Command {
+	return cli.Command{
+		Name:         "drain",
+		Usage:        usage.ClusterInstancesDrain,
+		ArgsUsage:    cluster.InstanceIDsFormat,
+		Action:       cluster.ClusterInstancesDrain,
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.DebugFlag()),
+		OnUsageError: flags.UsageErrorFactory("drain"),
+	}
+}
+
 func PsCommand() cli.Command {
 	return cli.Command{
 		Name:         "ps",
@@ -64,7 +116,7 @@ func PsCommand() cli.Command {
 }
 
 func clusterUpFlags() []cli.Flag {
-	return []cli.Flag{
+	return append([]cli.Flag{
 		cli.BoolFlag{
 			Name:  flags.CapabilityIAMFlag,
 			Usage: "Acknowledges that this command may create IAM resources. Required if --instance-role is not specified. NOTE: Not applicable for launch type FARGATE or when creating an empty cluster.",
@@ -87,7 +139,27 @@ func clusterUpFlags() []cli.Flag {
 		},
 		cli.StringFlag{
 			Name:  flags.SpotPriceFlag,
-			Usage: "[Optional] If filled and greater than 0, EC2 Spot instances will be requested.",
+			Usage: "[Optional] If filled and greater than 0, EC2 Spot instances will be requested. With --instance-types, specifies the maximum price of the Spot instances.",
+		},
+		cli.StringFlag{
+			Name:  flags.InstanceTypesFlag,
+			Usage: "[Optional] Specifies a comma-separated list of EC2 instance types for the Auto Scaling group to launch with a mixed instances policy, instead of --instance-type. All of them must have the same architecture. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.StringFlag{
+			Name:  flags.OnDemandBaseFlag,
+			Usage: "[Optional] Specifies the number of On-Demand instances to launch before launching Spot instances. Defaults to 0. Requires --instance-types.",
+		},
+		cli.StringFlag{
+			Name:  flags.OnDemandPercentageFlag,
+			Usage: "[Optional] Specifies the percentage of the instances above --on-demand-base to launch On-Demand; the others are Spot instances. Defaults to 100. Requires --instance-types.",
+		},
+		cli.StringFlag{
+			Name:  flags.SpotAllocationStrategyFlag,
+			Usage: "[Optional] Specifies how to allocate Spot instances across the instance types: lowest-price, capacity-optimized, capacity-optimized-prioritized or price-capacity-optimized. Defaults to price-capacity-optimized. Requires --instance-types.",
+		},
+		cli.StringFlag{
+			Name:  flags.AMIFamilyFlag,
+			Usage: "[Optional] Specifies the family of the recommended ECS optimized AMI for your container instances: al2, al2023, bottlerocket, al2-kernel-5.10 or inferentia. The Arm or GPU variant of the family is used for Arm or GPU instance types. With bottlerocket, user data is written as Bottlerocket TOML settings, and --extra-user-data files must contain TOML settings. Defaults to al2. NOTE: Not applicable for launch type FARGATE.",
 		},
 		cli.StringFlag{
 			Name:  flags.ImageIdFlag,
@@ -125,6 +197,18 @@ func clusterUpFlags() []cli.Flag {
 			Name:  flags.VpcIdFlag,
 			Usage: "[Optional] Specifies the ID of an existing VPC in which to launch your container instances. If you specify a VPC ID, you must specify a list of existing subnets in that VPC with the --subnets option. If you do not specify a VPC ID, a new VPC is created with two subnets.",
 		},
+		cli.BoolFlag{
+			Name:  flags.PrivateSubnetsFlag,
+			Usage: "[Optional] Creates two private subnets in the new VPC, in addition to the two public ones, and launches your container instances in them without public IP addresses. Not applicable with --vpc.",
+		},
+		cli.StringFlag{
+			Name:  flags.NatGatewayFlag,
+			Usage: "[Optional] Specifies whether the private subnets reach the internet through a single NAT gateway (single), one NAT gateway per availability zone (per-az), or none (none, which requires --vpc-endpoints). Defaults to single. Requires --private-subnets.",
+		},
+		cli.BoolFlag{
+			Name:  flags.VpcEndpointsFlag,
+			Usage: "[Optional] Creates VPC endpoints for ECR, ECS, CloudWatch Logs, SSM and S3, so that the private subnets reach them without a NAT gateway. Requires --private-subnets.",
+		},
 		cli.StringSliceFlag{
 			Name:  flags.UserDataFlag,
 			Usage: "[Optional] Specifies additional User Data for your EC2 instances. Files can be shell scripts or cloud-init directives and are packaged into a MIME Multipart Archive along with ECS CLI provided User Data which directs instances to join your cluster.",
@@ -142,7 +226,31 @@ func clusterUpFlags() []cli.Flag {
 			Name:  flags.IMDSv2Flag,
 			Usage: "[Optional] Disable IMDSv1 on an EC2 instance launch.",
 		},
-	}
+		cli.BoolFlag{
+			Name:  flags.CapacityProviderFlag,
+			Usage: "[Optional] Creates an ECS capacity provider for the Auto Scaling group of your container instances, with managed scaling and managed termination protection, and makes it the default capacity provider of your cluster. The capacity provider launches instances as tasks need them, up to the number given by --size. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.StringFlag{
+			Name:  flags.TargetCapacityFlag,
+			Usage: "[Optional] Specifies the percentage of the capacity provider's instance capacity that tasks should use. Defaults to 100. Requires --capacity-provider.",
+		},
+		cli.StringFlag{
+			Name:  flags.MinScalingStepFlag,
+			Usage: "[Optional] Specifies the minimum number of instances the capacity provider launches or terminates at a time. Defaults to 1. Requires --capacity-provider.",
+		},
+		cli.StringFlag{
+			Name:  flags.MaxScalingStepFlag,
+			Usage: "[Optional] Specifies the maximum number of instances the capacity provider launches or terminates at a time. Defaults to 10000. Requires --capacity-provider.",
+		},
+		cli.StringFlag{
+			Name:  flags.FargateCapacityProvidersFlag,
+			Usage: "[Optional] Specifies a comma-separated list of the Fargate capacity providers (FARGATE and FARGATE_SPOT) to also attach to your cluster. Without --capacity-provider, the first one becomes the default capacity provider of your cluster.",
+		},
+		cli.StringFlag{
+			Name:  flags.TemplateExtensionFlag,
+			Usage: "[Optional] Specifies a YAML or JSON file with extra Parameters, Resources and Outputs to add to the CloudFormation template of your cluster, such as an EFS file system or an SNS topic. Its logical IDs must not be used by the cluster template, and its parameters must have default values. ecs-cli scale keeps the extension.",
+		},
+	}, launchTemplateFlags()...)
 }
 
 func clusterDownFlags() []cli.Flag {
@@ -166,3 +274,109 @@ func clusterScaleFlags() []cli.Flag {
 		},
 	}
 }
+
+func clusterMigrateTemplateFlags() []cli.Flag {
+	return append([]cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.CapabilityIAMFlag,
+			Usage: "Acknowledges that this command may create IAM resources.",
+		},
+		cli.BoolFlag{
+			Name:  flags.IMDSv2Flag,
+			Usage: "[Optional] Disable IMDSv1 on container instances launched from now on.",
+		},
+	}, launchTemplateFlags()...)
+}
+
+// changeSetFlags are the flags which preview the changes to the CloudFormation
+// stack of the cluster as a change set
+func changeSetFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.PreviewFlag,
+			Usage: "[Optional] Creates a CloudFormation change set instead of changing the stack directly, prints a summary of the resources it adds, modifies, replaces and removes, and applies it after confirmation. With ecs-cli up, an existing stack is updated in place instead of being recreated.",
+		},
+		cli.BoolFlag{
+			Name:  flags.DryRunFlag,
+			Usage: "[Optional] Prints the summary of the change set like --preview, without applying it.",
+		},
+		cli.BoolFlag{
+			Name:  flags.YesFlag,
+			Usage: "[Optional] Applies the change set of --preview without asking for confirmation.",
+		},
+		cli.StringFlag{
+			Name:  flags.RenderTemplateFlag,
+			Usage: "[Optional] Writes the CloudFormation template of the stack and the values of its parameters to the given file for offline review, without changing the stack.",
+		},
+	}
+}
+
+// launchTemplateFlags are the flags which customize the launch template of
+// the container instances
+func launchTemplateFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.RootVolumeSizeFlag,
+			Usage: "[Optional] Specifies the size in GiB of the root EBS volume of your container instances. Defaults to the size of the AMI's root volume. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.StringFlag{
+			Name:  flags.RootVolumeTypeFlag,
+			Usage: "[Optional] Specifies the type of the root EBS volume of your container instances, such as gp3. Defaults to the type of the AMI's root volume. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.BoolFlag{
+			Name:  flags.EncryptRootVolumeFlag,
+			Usage: "[Optional] Encrypts the root EBS volume of your container instances with the default EBS encryption key. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.BoolFlag{
+			Name:  flags.DetailedMonitoringFlag,
+			Usage: "[Optional] Enables detailed (1-minute) CloudWatch monitoring of your container instances. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.StringFlag{
+			Name:  flags.MetadataHopLimitFlag,
+			Usage: "[Optional] Specifies how many network hops instance metadata requests can travel. Tasks using the bridge network mode need a limit of at least 2 with --imdsv2. Defaults to 1. NOTE: Not applicable for launch type FARGATE.",
+		},
+	}
+}
+
+func clusterUpgradeFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.CapabilityIAMFlag,
+			Usage: "Acknowledges that this command may create IAM resources.",
+		},
+		cli.StringFlag{
+			Name:  flags.ImageIdFlag,
+			Usage: "[Optional] Specifies the AMI ID to upgrade your container instances to. Defaults to the recommended ECS optimized AMI of the AMI family of your cluster.",
+		},
+		cli.StringFlag{
+			Name:  flags.AMIFamilyFlag,
+			Usage: "[Optional] Specifies the family of the recommended ECS optimized AMI to upgrade your container instances to: al2, al2023, al2-kernel-5.10 or inferentia, or bottlerocket for clusters created with bottlerocket. Defaults to the AMI family the cluster was created with. Changing the AMI family requires --change-ami-family.",
+		},
+		cli.BoolFlag{
+			Name:  flags.ChangeAMIFamilyFlag,
+			Usage: "[Optional] Allows --ami-family to change the AMI family of the cluster. Clusters created with bottlerocket can not be changed to another AMI family, nor the other way around.",
+		},
+		cli.StringFlag{
+			Name:  flags.InstanceTypeFlag,
+			Usage: "[Optional] Specifies the EC2 instance type to change your container instances to. Not applicable to clusters created with --instance-types.",
+		},
+		cli.IntFlag{
+			Name:  flags.BatchSizeFlag,
+			Value: 1,
+			Usage: "[Optional] Specifies the number of container instances to drain and terminate at a time.",
+		},
+		cli.IntFlag{
+			Name:  flags.MaxUnavailableFlag,
+			Usage: "[Optional] Specifies the maximum number of container instances which may be draining or waiting for their replacement at the same time. Must be at least --batch-size, which it defaults to.",
+		},
+	}
+}
+
+func clusterDescribeFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.JSON,
+			Usage: "[Optional] Prints the description of the cluster as JSON.",
+		},
+	}
+}
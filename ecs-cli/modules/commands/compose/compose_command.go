@@ -44,6 +44,10 @@ import (
 //
 // Stop and delete the project
 //   ecs-cli compose stop        : calls ECS.StopTask and ECS deletes them (rm)
+//
+// Check the project files
+//   ecs-cli compose validate    : parses the compose, ecs-params and registry creds files offline and reports all problems
+//   ecs-cli compose params      : prints the ecs-params after variable interpolation and environment overlays are applied
 //* --------------------------------------------------- */
 
 const (
@@ -68,6 +72,8 @@ func ComposeCommand(factory composeFactory.ProjectFactory) cli.Command {
 			startCommand(factory),
 			stopCommand(factory),
 			upCommand(factory),
+			validateCommand(),
+			paramsCommand(),
 			// ----- Unsupported/Unimplemented COMMANDS -----
 			// build, pull, logs, port, restart, rm, kill
 
@@ -94,12 +100,20 @@ func composeFlags() []cli.Flag {
 		},
 		cli.StringFlag{
 			Name:  flags.TaskRoleArnFlag,
-			Usage: "[Optional] Specifies the short name or full Amazon Resource Name (ARN) of the IAM role that containers in this task can assume. All containers in this task are granted the permissions that are specified in this role.",
+			Usage: "[Optional] Specifies the short name or full Amazon Resource Name (ARN) of the IAM role that containers in this task can assume. All containers in this task are granted the permissions that are specified in this role. Ignored if task_role_policies is specified in the ECS params file.",
 		},
 		cli.StringFlag{
 			Name:  flags.ECSParamsFileNameFlag,
 			Usage: "[Optional] Specifies ecs-params file to use. Defaults to " + ecsParamsFileNameDefaultValue + " file, if one exists.",
 		},
+		cli.StringFlag{
+			Name:  flags.ECSParamsEnvFlag,
+			Usage: "[Optional] Specifies the environment, such as staging, whose overlay file (for example, ecs-params.staging.yml) is merged over the ecs-params file.",
+		},
+		cli.BoolFlag{
+			Name:  flags.PinDigestsFlag,
+			Usage: "[Optional] Resolves each container image to its digest and registers the task definition with repository@sha256:digest, so that tasks always run the same image. The original image is kept in the com.amazonaws.ecs-cli.original-image docker label.",
+		},
 		cli.StringFlag{
 			Name:  flags.RegistryCredsFileNameFlag,
 			Usage: "[Optional] Specifies the ecs-registry-creds file to use. Defaults to latest 'ecs-registry-creds' output file, if one exists.",
@@ -133,7 +147,7 @@ func upCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Name:         "up",
 		Usage:        usage.ComposeUp,
 		Action:       compose.WithProject(factory, compose.ProjectUp, false),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), flags.OptionalForceUpdateFlag(), resourceTagsFlag(true), disableECSManagedTagsFlag()),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), flags.OptionalCreateExecutionRoleFlag(), flags.OptionalBuildFlag(), flags.OptionalMaxSeverityFlag(), flags.OptionalForceUpdateFlag(), resourceTagsFlag(true), disableECSManagedTagsFlag()),
 		OnUsageError: flags.UsageErrorFactory("up"),
 	}
 }
@@ -180,6 +194,34 @@ func scaleCommand(factory composeFactory.ProjectFactory) cli.Command {
 	}
 }
 
+func validateCommand() cli.Command {
+	return cli.Command{
+		Name:         "validate",
+		Usage:        usage.ComposeValidate,
+		Action:       compose.ProjectValidate,
+		Flags:        flags.AppendFlags(flags.OptionalLaunchTypeFlag(), platformVersionFlag()),
+		OnUsageError: flags.UsageErrorFactory("validate"),
+	}
+}
+
+func paramsCommand() cli.Command {
+	return cli.Command{
+		Name:         "params",
+		Usage:        usage.ComposeParams,
+		Action:       compose.ProjectParams,
+		OnUsageError: flags.UsageErrorFactory("params"),
+	}
+}
+
+func platformVersionFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.PlatformVersionFlag,
+			Usage: "[Optional] Specifies the Fargate platform version the project will run on. Defaults to the platform version chosen by the ECS CLI.",
+		},
+	}
+}
+
 func resourceTagsFlag(runTasks bool) []cli.Flag {
 	usage := "[Optional] Specify resource tags for your Task Definition. Specify tags in the format 'key1=value1,key2=value2,key3=value3'."
 	if runTasks {
@@ -88,7 +88,7 @@ func upServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Name:         "up",
 		Usage:        usage.ServiceUp,
 		Action:       compose.WithProject(factory, compose.ProjectUp, true),
-		Flags:        flags.AppendFlags(deploymentConfigFlags(true), loadBalancerFlags(), flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), ForceNewDeploymentFlag(), serviceDiscoveryFlags(), updateServiceDiscoveryFlags(), flags.OptionalSchedulingStrategyFlag(), taggingFlags()),
+		Flags:        flags.AppendFlags(deploymentConfigFlags(true), loadBalancerFlags(), flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), flags.OptionalCreateExecutionRoleFlag(), flags.OptionalBuildFlag(), flags.OptionalMaxSeverityFlag(), ForceNewDeploymentFlag(), serviceDiscoveryFlags(), updateServiceDiscoveryFlags(), flags.OptionalSchedulingStrategyFlag(), taggingFlags()),
 		OnUsageError: flags.UsageErrorFactory("up"),
 	}
 }
@@ -130,7 +130,7 @@ func rmServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Aliases:      []string{"delete", "down"},
 		Usage:        usage.ServiceRm,
 		Action:       compose.WithProject(factory, compose.ProjectDown, true),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), deleteServiceDiscoveryFlags()),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), deleteServiceDiscoveryFlags(), deleteRolesFlag()),
 		OnUsageError: flags.UsageErrorFactory("rm"),
 	}
 }
@@ -190,6 +190,22 @@ func updateServiceDiscoveryFlags() []cli.Flag {
 			Name:  flags.UpdateServiceDiscoveryFlag,
 			Usage: "[Optional] [Service Discovery] Allows update of Service Discovery Service settings DNS TTL and Failure Threshold.",
 		},
+		cli.BoolFlag{
+			Name:  flags.PreviewServiceDiscoveryFlag,
+			Usage: "[Optional] [Service Discovery] With --update-service-discovery, prints the changes to the CloudFormation stack of the Service Discovery Service as a change set before the ECS service is updated. The service and the stack are only updated after confirmation.",
+		},
+		cli.BoolFlag{
+			Name:  flags.YesFlag,
+			Usage: "[Optional] [Service Discovery] Applies the changes of --preview-service-discovery without asking for confirmation.",
+		},
+		cli.BoolFlag{
+			Name:  flags.DryRunFlag,
+			Usage: "[Optional] [Service Discovery] With --update-service-discovery, prints the changes to the CloudFormation stack of the Service Discovery Service as a change set, without updating the stack or the ECS service.",
+		},
+		cli.StringFlag{
+			Name:  flags.RenderTemplateFlag,
+			Usage: "[Optional] [Service Discovery] With --update-service-discovery, writes the template and parameters of the CloudFormation stack of the Service Discovery Service to the given file, without updating the stack or the ECS service.",
+		},
 	}
 }
 
@@ -202,6 +218,15 @@ func deleteServiceDiscoveryFlags() []cli.Flag {
 	}
 }
 
+func deleteRolesFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.DeleteRolesFlag,
+			Usage: "[Optional] Deletes the task role and task execution role created by the ECS CLI for the project",
+		},
+	}
+}
+
 func deploymentConfigFlags(specifyDefaults bool) []cli.Flag {
 	maxPercentUsageString := "[Optional] Specifies the upper limit (as a percentage of the service's desiredCount) of the number of running tasks that can be running in a service during a deployment."
 	minHealthyPercentUsageString := "[Optional] Specifies the lower limit (as a percentage of the service's desiredCount) of the number of running tasks that must remain running and healthy in a service during a deployment."
@@ -0,0 +1,47 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package credhelperCommand defines the hidden command which implements the Docker credential helper protocol
+package credhelperCommand
+
+import (
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/credhelper"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/urfave/cli"
+)
+
+// CredentialHelperCommand runs when the ECS CLI is invoked as docker-credential-ecs-cli
+func CredentialHelperCommand() cli.Command {
+	return cli.Command{
+		Name:   credhelper.CommandName,
+		Usage:  usage.CredentialHelper,
+		Hidden: true,
+		Subcommands: []cli.Command{
+			operationCommand("get", usage.CredentialHelperGet, credhelper.Get),
+			operationCommand("store", usage.CredentialHelperStore, credhelper.Store),
+			operationCommand("erase", usage.CredentialHelperErase, credhelper.Erase),
+			operationCommand("list", usage.CredentialHelperList, credhelper.List),
+		},
+	}
+}
+
+func operationCommand(name, usage string, action func(*cli.Context)) cli.Command {
+	return cli.Command{
+		Name:         name,
+		Usage:        usage,
+		Action:       action,
+		Flags:        flags.OptionalRegionAndProfileFlags(),
+		OnUsageError: flags.UsageErrorFactory(name),
+	}
+}
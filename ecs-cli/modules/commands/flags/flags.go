@@ -59,6 +59,10 @@ const (
 	TimeStampsFlag     = "timestamps"
 	CreateLogsFlag     = "create-log-groups"
 
+	// IAM
+	CreateExecutionRoleFlag = "create-execution-role"
+	DeleteRolesFlag         = "delete-roles"
+
 	// Service Discovery
 	PrivateDNSNamespaceNameFlag                 = "private-dns-namespace"
 	PrivateDNSNamespaceIDFlag                   = "private-dns-namespace-id"
@@ -72,6 +76,7 @@ const (
 	HealthcheckCustomConfigFailureThresholdFlag = "healthcheck-custom-config-failure-threshold"
 	DeletePrivateNamespaceFlag                  = "delete-namespace"
 	UpdateServiceDiscoveryFlag                  = "update-service-discovery"
+	PreviewServiceDiscoveryFlag                 = "preview-service-discovery"
 
 	ComposeProjectNamePrefixFlag         = "compose-project-name-prefix"
 	ComposeProjectNamePrefixDefaultValue = "ecscompose-"
@@ -106,20 +111,73 @@ const (
 	ForceFlag                       = "force"
 	EmptyFlag                       = "empty"
 	UserDataFlag                    = "extra-user-data"
+	RootVolumeSizeFlag              = "root-volume-size"
+	RootVolumeTypeFlag              = "root-volume-type"
+	EncryptRootVolumeFlag           = "encrypt-root-volume"
+	DetailedMonitoringFlag          = "detailed-monitoring"
+	MetadataHopLimitFlag            = "metadata-hop-limit"
+	CapacityProviderFlag            = "capacity-provider"
+	TargetCapacityFlag              = "target-capacity"
+	MinScalingStepFlag              = "min-scaling-step"
+	MaxScalingStepFlag              = "max-scaling-step"
+	FargateCapacityProvidersFlag    = "fargate-capacity-providers"
+	InstanceTypesFlag               = "instance-types"
+	OnDemandBaseFlag                = "on-demand-base"
+	OnDemandPercentageFlag          = "on-demand-percentage"
+	SpotAllocationStrategyFlag      = "spot-allocation-strategy"
+	PrivateSubnetsFlag              = "private-subnets"
+	NatGatewayFlag                  = "nat-gateway"
+	VpcEndpointsFlag                = "vpc-endpoints"
+	AMIFamilyFlag                   = "ami-family"
+	ChangeAMIFamilyFlag             = "change-ami-family"
+	BatchSizeFlag                   = "batch-size"
+	MaxUnavailableFlag              = "max-unavailable"
+	PreviewFlag                     = "preview"
+	YesFlag                         = "yes"
+	RenderTemplateFlag              = "render-template"
+	TemplateExtensionFlag           = "template-extension"
 
 	// Image
 	RegistryIdFlag = "registry-id"
 	TaggedFlag     = "tagged"
 	UntaggedFlag   = "untagged"
 	UseFIPSFlag    = "use-fips" // TODO: repurpose to use more generally with other services/workflows
+	PlatformFlag   = "platform"
+	PlatformsFlag  = "platforms"
+	OlderThanFlag  = "older-than"
+	KeepLastFlag   = "keep-last"
+	TagPrefixFlag  = "tag-prefix"
+	DryRunFlag     = "dry-run"
+	FormatFlag     = "format"
+	SortFlag       = "sort"
+	MinSizeFlag    = "min-size"
+
+	SourceECSProfileFlag = "source-ecs-profile"
+	SourceAWSProfileFlag = "source-aws-profile"
+
+	MaxSeverityFlag      = "max-severity"
+	CredentialHelperFlag = "credential-helper"
+
+	// Repo
+	RepoConfigFlag         = "repo-config"
+	ImageTagMutabilityFlag = "image-tag-mutability"
+	ScanOnPushFlag         = "scan-on-push"
+	KMSKeyFlag             = "kms-key"
+	PolicyFileFlag         = "policy-file"
+	UpstreamRegistryFlag   = "upstream-registry"
+	CredentialARNFlag      = "credential-arn"
 
 	// Compose
 	ProjectNameFlag           = "project-name"
 	ComposeFileNameFlag       = "file"
 	TaskRoleArnFlag           = "task-role-arn"
 	ECSParamsFileNameFlag     = "ecs-params"
+	ECSParamsEnvFlag          = "env"
 	ForceUpdateFlag           = "force-update"
 	RegistryCredsFileNameFlag = "registry-creds"
+	PlatformVersionFlag       = "platform-version"
+	BuildFlag                 = "build"
+	PinDigestsFlag            = "pin-digests"
 
 	// Compose Service
 	CreateServiceCommandName                = "create"
@@ -266,6 +324,36 @@ func OptionalCreateLogsFlag() []cli.Flag {
 	}
 }
 
+// OptionalCreateExecutionRoleFlag allows users to have a task execution role created for their task definition
+func OptionalCreateExecutionRoleFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  CreateExecutionRoleFlag,
+			Usage: "[Optional] Create or update a task execution role with only the permissions your task definition needs to pull its ECR images, write to its CloudWatch log groups and read its secrets, and use it in the task definition.",
+		},
+	}
+}
+
+// OptionalBuildFlag allows users to build the images of their compose services and push them to ECR on compose up
+func OptionalBuildFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  BuildFlag,
+			Usage: "[Optional] Builds the image of each service with a build section, pushes it to an ECR repository, creating the repository if needed, and uses it in the task definition.",
+		},
+	}
+}
+
+// OptionalMaxSeverityFlag allows users to refuse to deploy images with severe vulnerabilities on compose up
+func OptionalMaxSeverityFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  MaxSeverityFlag,
+			Usage: "[Optional] Refuses to deploy unless the image of each container is in ECR, has a completed scan, and has no findings more severe than the specified severity. Valid values are CRITICAL, HIGH, MEDIUM, LOW, INFORMATIONAL and UNDEFINED.",
+		},
+	}
+}
+
 // OptionalForceUpdateFlag allows users to force an update of running tasks on compose up.
 func OptionalForceUpdateFlag() []cli.Flag {
 	return []cli.Flag{
@@ -328,6 +416,20 @@ func CFNResourceFlags() []string {
 		ImageIdFlag,
 		KeypairNameFlag,
 		SpotPriceFlag,
+		RootVolumeSizeFlag,
+		RootVolumeTypeFlag,
+		MetadataHopLimitFlag,
+		TargetCapacityFlag,
+		MinScalingStepFlag,
+		MaxScalingStepFlag,
+		FargateCapacityProvidersFlag,
+		InstanceTypesFlag,
+		OnDemandBaseFlag,
+		OnDemandPercentageFlag,
+		SpotAllocationStrategyFlag,
+		NatGatewayFlag,
+		AMIFamilyFlag,
+		TemplateExtensionFlag,
 	}
 }
 
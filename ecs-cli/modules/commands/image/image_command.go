@@ -48,8 +48,26 @@ func PullCommand() cli.Command {
 	}
 }
 
-// ImagesCommand list images in ECR
+// ImagesCommand list images in ECR, and manages them with its subcommands
 func ImagesCommand() cli.Command {
+	list := listCommand()
+	return cli.Command{
+		Name:         list.Name,
+		Usage:        list.Usage,
+		ArgsUsage:    list.ArgsUsage,
+		Action:       listImages,
+		Flags:        list.Flags,
+		OnUsageError: list.OnUsageError,
+		Subcommands: []cli.Command{
+			pruneCommand(),
+			scanCommand(),
+			loginCommand(),
+			copyCommand(),
+		},
+	}
+}
+
+func listCommand() cli.Command {
 	return cli.Command{
 		Name:         "images",
 		Usage:        usage.Images,
@@ -61,6 +79,60 @@ func ImagesCommand() cli.Command {
 	}
 }
 
+// listImages runs the images command without its subcommands. A command with
+// subcommands stops parsing flags at its first argument, so the arguments are
+// parsed again from the parent context to allow flags after repository names.
+func listImages(c *cli.Context) error {
+	return listCommand().Run(c.Parent())
+}
+
+func pruneCommand() cli.Command {
+	return cli.Command{
+		Name:         "prune",
+		Usage:        usage.ImagesPrune,
+		ArgsUsage:    image.PruneImageFormat,
+		Before:       app.BeforeApp,
+		Action:       image.ImagePrune,
+		Flags:        flags.AppendFlags(imagePruneFlags(), flags.OptionalRegionAndProfileFlags(), flags.DebugFlag(), fipsEndpointFlag()),
+		OnUsageError: flags.UsageErrorFactory("prune"),
+	}
+}
+
+func scanCommand() cli.Command {
+	return cli.Command{
+		Name:         "scan",
+		Usage:        usage.ImagesScan,
+		ArgsUsage:    image.ScanImageFormat,
+		Before:       app.BeforeApp,
+		Action:       image.ImageScan,
+		Flags:        flags.AppendFlags(imageScanFlags(), flags.OptionalRegionAndProfileFlags(), flags.DebugFlag(), fipsEndpointFlag()),
+		OnUsageError: flags.UsageErrorFactory("scan"),
+	}
+}
+
+func loginCommand() cli.Command {
+	return cli.Command{
+		Name:         "login",
+		Usage:        usage.ImagesLogin,
+		Before:       app.BeforeApp,
+		Action:       image.ImageLogin,
+		Flags:        flags.AppendFlags(imageLoginFlags(), flags.OptionalRegionAndProfileFlags(), flags.DebugFlag(), fipsEndpointFlag()),
+		OnUsageError: flags.UsageErrorFactory("login"),
+	}
+}
+
+func copyCommand() cli.Command {
+	return cli.Command{
+		Name:         "copy",
+		Usage:        usage.ImagesCopy,
+		ArgsUsage:    image.CopyImageFormat,
+		Before:       app.BeforeApp,
+		Action:       image.ImageCopy,
+		Flags:        flags.AppendFlags(imageCopyFlags(), flags.OptionalRegionAndProfileFlags(), flags.DebugFlag()),
+		OnUsageError: flags.UsageErrorFactory("copy"),
+	}
+}
+
 func imagePushFlags() []cli.Flag {
 	return []cli.Flag{
 		cli.StringFlag{
@@ -71,6 +143,14 @@ func imagePushFlags() []cli.Flag {
 			Name:  flags.ResourceTagsFlag,
 			Usage: "[Optional] Specify AWS Resource tags which will be to your ECR repository. Specify in the format 'key1=value1,key2=value2,key3=value3.",
 		},
+		cli.StringFlag{
+			Name:  flags.PlatformFlag,
+			Usage: "[Optional] Specifies a comma separated list of platforms, such as linux/amd64,linux/arm64, to push a multi-platform image for. The image for each platform is taken from the local image whose tag has the architecture (and variant) appended, such as repo:tag-amd64 and repo:tag-arm64. Each is pushed under its own tag, and a manifest list which refers to all of them is pushed under the given tag.",
+		},
+		cli.StringFlag{
+			Name:  flags.RepoConfigFlag,
+			Usage: "[Optional] Specifies a YAML file with the settings to create the repository with if it does not exist, such as image tag mutability, scan on push, KMS encryption, a repository policy and tags. Has no effect on existing repositories.",
+		},
 	}
 }
 
@@ -93,6 +173,115 @@ func imageListFlags() []cli.Flag {
 			Name:  flags.UntaggedFlag,
 			Usage: "[Optional] Filters the result to show only untagged images",
 		},
+		cli.IntFlag{
+			Name:  flags.SinceFlag,
+			Usage: "[Optional] Filters the result to show only images which were pushed in the specified number of days.",
+		},
+		cli.IntFlag{
+			Name:  flags.OlderThanFlag,
+			Usage: "[Optional] Filters the result to show only images which were pushed more than the specified number of days ago.",
+		},
+		cli.StringFlag{
+			Name:  flags.TagPrefixFlag,
+			Usage: "[Optional] Specifies a comma separated list of tag prefixes. Filters the result to show only images with a tag which starts with one of them.",
+		},
+		cli.StringFlag{
+			Name:  flags.MinSizeFlag,
+			Usage: "[Optional] Filters the result to show only images of at least the specified size, such as 100MB.",
+		},
+		cli.StringFlag{
+			Name:  flags.SortFlag,
+			Usage: "[Optional] Sorts the images by the time they were pushed, most recent first, or by size, largest first. Valid values are pushed and size. By default, images are in the order ECR returns them in.",
+		},
+		cli.BoolFlag{
+			Name:  flags.PlatformsFlag,
+			Usage: "[Optional] Shows the platforms of each image, which are read from its manifest with an extra request for each image. Requires permission to call ecr:GetAuthorizationToken, ecr:BatchGetImage and ecr:GetDownloadUrlForLayer. Implied by --format templates which use .Platforms.",
+		},
+		cli.StringFlag{
+			Name:  flags.FormatFlag,
+			Value: image.TableFormat,
+			Usage: "[Optional] Specifies the output format. Valid values are table, json, yaml, or a Go template which is applied to each image, such as '{{.Repository}}@{{.Digest}} {{join .Tags \",\"}}'. The json and yaml formats include the number and total size of the images of each repository.",
+		},
+	}
+}
+
+func imagePruneFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.RegistryIdFlag,
+			Usage: "[Optional] Specifies the Amazon ECR registry ID to delete images from. By default, images are deleted from the current AWS account.",
+		},
+		cli.BoolFlag{
+			Name:  flags.UntaggedFlag,
+			Usage: "[Optional] Deletes only untagged images.",
+		},
+		cli.IntFlag{
+			Name:  flags.OlderThanFlag,
+			Usage: "[Optional] Deletes only images which were pushed more than the specified number of days ago.",
+		},
+		cli.IntFlag{
+			Name:  flags.KeepLastFlag,
+			Usage: "[Optional] Keeps the specified number of most recently pushed images, even if they match the other filters. If tag prefixes are specified, that many images are kept for each tag prefix.",
+		},
+		cli.StringFlag{
+			Name:  flags.TagPrefixFlag,
+			Usage: "[Optional] Specifies a comma separated list of tag prefixes. Deletes only images with a tag which starts with one of them.",
+		},
+		cli.BoolFlag{
+			Name:  flags.DryRunFlag,
+			Usage: "[Optional] Lists the images which would be deleted without deleting them.",
+		},
+		cli.BoolFlag{
+			Name:  flags.ForceFlag + ", f",
+			Usage: "[Optional] Deletes the images without prompting for confirmation.",
+		},
+	}
+}
+
+func imageScanFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.RegistryIdFlag,
+			Usage: "[Optional] Specifies the Amazon ECR registry ID of the image. By default, the registry of the current AWS account is used.",
+		},
+		cli.BoolFlag{
+			Name:  flags.JSON,
+			Usage: "[Optional] Prints the scan findings in JSON format.",
+		},
+		cli.StringFlag{
+			Name:  flags.MaxSeverityFlag,
+			Usage: "[Optional] Fails if the image has findings more severe than the specified severity. Valid values are CRITICAL, HIGH, MEDIUM, LOW, INFORMATIONAL and UNDEFINED.",
+		},
+	}
+}
+
+func imageLoginFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.RegistryIdFlag,
+			Usage: "[Optional] Specifies the Amazon ECR registry ID to log in to. By default, the registry of the current AWS account is used.",
+		},
+		cli.BoolFlag{
+			Name:  flags.CredentialHelperFlag,
+			Usage: "[Optional] Configures Docker to get credentials for the registry from the ECS CLI credential helper, docker-credential-ecs-cli, instead of writing an authorization token which expires after 12 hours.",
+		},
+	}
+}
+
+func imageCopyFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.SourceECSProfileFlag,
+			Usage: "[Optional] Specifies the name of the ECS profile configuration to access the source ECR registry with. Defaults to the profile used for the destination.",
+		},
+		cli.StringFlag{
+			Name:  flags.SourceAWSProfileFlag,
+			Usage: "[Optional] Use the AWS credentials from an existing named profile in ~/.aws/credentials to access the source ECR registry. Defaults to the credentials used for the destination.",
+		},
+		cli.StringFlag{
+			Name:  flags.ResourceTagsFlag,
+			Usage: "[Optional] Specify AWS Resource tags which will be added to the destination ECR repository. Specify in the format 'key1=value1,key2=value2,key3=value3'.",
+		},
 	}
 }
 
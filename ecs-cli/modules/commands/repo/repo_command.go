@@ -0,0 +1,246 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package repoCommand defines the commands for ECR repository workflows
+package repoCommand
+
+import (
+	ecscli "github.com/aws/amazon-ecs-cli/ecs-cli/modules"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/repo"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/urfave/cli"
+)
+
+// RepoCommand provides a list of commands that manage ECR repositories.
+func RepoCommand() cli.Command {
+	return cli.Command{
+		Name:   "repo",
+		Usage:  usage.Repo,
+		Before: ecscli.BeforeApp,
+		Flags:  flags.OptionalRegionAndProfileFlags(),
+		Subcommands: []cli.Command{
+			createCommand(),
+			describeCommand(),
+			deleteCommand(),
+			listCommand(),
+			lifecycleCommand(),
+			pullThroughCacheCommand(),
+		},
+	}
+}
+
+func createCommand() cli.Command {
+	return cli.Command{
+		Name:         "create",
+		Usage:        usage.RepoCreate,
+		ArgsUsage:    repo.RepoNameFormat,
+		Action:       repo.Create,
+		Flags:        flags.AppendFlags(flags.OptionalRegionAndProfileFlags(), repoCreateFlags(), flags.DebugFlag()),
+		OnUsageError: flags.UsageErrorFactory("create"),
+	}
+}
+
+func describeCommand() cli.Command {
+	return cli.Command{
+		Name:         "describe",
+		Usage:        usage.RepoDescribe,
+		ArgsUsage:    repo.RepoNameFormat,
+		Action:       repo.Describe,
+		Flags:        flags.AppendFlags(flags.OptionalRegionAndProfileFlags(), registryIDFlag(), flags.DebugFlag()),
+		OnUsageError: flags.UsageErrorFactory("describe"),
+	}
+}
+
+func deleteCommand() cli.Command {
+	return cli.Command{
+		Name:         "delete",
+		Usage:        usage.RepoDelete,
+		ArgsUsage:    repo.RepoNameFormat,
+		Action:       repo.Delete,
+		Flags:        flags.AppendFlags(flags.OptionalRegionAndProfileFlags(), registryIDFlag(), repoDeleteFlags(), flags.DebugFlag()),
+		OnUsageError: flags.UsageErrorFactory("delete"),
+	}
+}
+
+func listCommand() cli.Command {
+	return cli.Command{
+		Name:         "ls",
+		Usage:        usage.RepoList,
+		Action:       repo.List,
+		Flags:        flags.AppendFlags(flags.OptionalRegionAndProfileFlags(), registryIDFlag(), flags.DebugFlag()),
+		OnUsageError: flags.UsageErrorFactory("ls"),
+	}
+}
+
+func lifecycleCommand() cli.Command {
+	return cli.Command{
+		Name:  "lifecycle",
+		Usage: usage.RepoLifecycle,
+		Subcommands: []cli.Command{
+			{
+				Name:         "get",
+				Usage:        usage.RepoLifecycleGet,
+				ArgsUsage:    repo.RepoNameFormat,
+				Action:       repo.LifecycleGet,
+				Flags:        flags.AppendFlags(flags.OptionalRegionAndProfileFlags(), registryIDFlag(), flags.DebugFlag()),
+				OnUsageError: flags.UsageErrorFactory("get"),
+			},
+			{
+				Name:         "set",
+				Usage:        usage.RepoLifecycleSet,
+				ArgsUsage:    repo.RepoNameFormat,
+				Action:       repo.LifecycleSet,
+				Flags:        flags.AppendFlags(flags.OptionalRegionAndProfileFlags(), registryIDFlag(), lifecyclePolicyFlags(), flags.DebugFlag()),
+				OnUsageError: flags.UsageErrorFactory("set"),
+			},
+			{
+				Name:         "preview",
+				Usage:        usage.RepoLifecyclePreview,
+				ArgsUsage:    repo.RepoNameFormat,
+				Action:       repo.LifecyclePreview,
+				Flags:        flags.AppendFlags(flags.OptionalRegionAndProfileFlags(), registryIDFlag(), lifecyclePolicyFlags(), flags.DebugFlag()),
+				OnUsageError: flags.UsageErrorFactory("preview"),
+			},
+			{
+				Name:         "generate",
+				Usage:        usage.RepoLifecycleGenerate,
+				Action:       repo.LifecycleGenerate,
+				Flags:        lifecycleRuleFlags(),
+				OnUsageError: flags.UsageErrorFactory("generate"),
+			},
+		},
+	}
+}
+
+func pullThroughCacheCommand() cli.Command {
+	return cli.Command{
+		Name:  "pull-through-cache",
+		Usage: usage.RepoPullThroughCache,
+		Subcommands: []cli.Command{
+			{
+				Name:         "create",
+				Usage:        usage.RepoPullThroughCacheCreate,
+				ArgsUsage:    repo.RepoPrefixFormat,
+				Action:       repo.PullThroughCacheCreate,
+				Flags:        flags.AppendFlags(flags.OptionalRegionAndProfileFlags(), registryIDFlag(), pullThroughCacheCreateFlags(), flags.DebugFlag()),
+				OnUsageError: flags.UsageErrorFactory("create"),
+			},
+			{
+				Name:         "ls",
+				Usage:        usage.RepoPullThroughCacheList,
+				Action:       repo.PullThroughCacheList,
+				Flags:        flags.AppendFlags(flags.OptionalRegionAndProfileFlags(), registryIDFlag(), flags.DebugFlag()),
+				OnUsageError: flags.UsageErrorFactory("ls"),
+			},
+			{
+				Name:         "delete",
+				Usage:        usage.RepoPullThroughCacheDelete,
+				ArgsUsage:    repo.RepoPrefixFormat,
+				Action:       repo.PullThroughCacheDelete,
+				Flags:        flags.AppendFlags(flags.OptionalRegionAndProfileFlags(), registryIDFlag(), flags.DebugFlag()),
+				OnUsageError: flags.UsageErrorFactory("delete"),
+			},
+		},
+	}
+}
+
+func repoCreateFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.RepoConfigFlag,
+			Usage: "[Optional] Specifies a YAML file with the settings to create the repository with. Settings given by flags override the ones in this file.",
+		},
+		cli.StringFlag{
+			Name:  flags.ImageTagMutabilityFlag,
+			Usage: "[Optional] Specifies whether image tags can be overwritten. Valid values are MUTABLE and IMMUTABLE. Defaults to MUTABLE.",
+		},
+		cli.BoolFlag{
+			Name:  flags.ScanOnPushFlag,
+			Usage: "[Optional] Specifies that images are scanned for vulnerabilities after they are pushed to the repository.",
+		},
+		cli.StringFlag{
+			Name:  flags.KMSKeyFlag,
+			Usage: "[Optional] Specifies the ID, ARN or alias of the AWS KMS key to encrypt the repository with. By default, images are encrypted with AES256.",
+		},
+		cli.StringFlag{
+			Name:  flags.PolicyFileFlag,
+			Usage: "[Optional] Specifies a JSON file with the repository policy to set on the repository.",
+		},
+		cli.StringFlag{
+			Name:  flags.ResourceTagsFlag,
+			Usage: "[Optional] Specify AWS Resource tags to add to the repository. Specify in the format 'key1=value1,key2=value2,key3=value3'.",
+		},
+	}
+}
+
+func pullThroughCacheCreateFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.UpstreamRegistryFlag,
+			Usage: "Specifies the URL of the registry to cache images from, or one of docker-hub, ecr-public, quay or github-container-registry.",
+		},
+		cli.StringFlag{
+			Name:  flags.CredentialARNFlag,
+			Usage: "[Optional] Specifies the ARN of the AWS Secrets Manager secret with the credentials of the upstream registry. Required for Docker Hub, GitHub Container Registry and Azure Container Registry. The name of the secret must start with ecr-pullthroughcache/.",
+		},
+	}
+}
+
+func repoDeleteFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.ForceFlag + ", f",
+			Usage: "[Optional] Deletes the repository without prompting for confirmation.",
+		},
+	}
+}
+
+func lifecyclePolicyFlags() []cli.Flag {
+	return append([]cli.Flag{
+		cli.StringFlag{
+			Name:  flags.PolicyFileFlag,
+			Usage: "[Optional] Specifies a JSON file with the lifecycle policy. Can not be used with the flags which generate a policy.",
+		},
+	}, lifecycleRuleFlags()...)
+}
+
+func lifecycleRuleFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.UntaggedFlag,
+			Usage: "[Optional] Generates a rule which expires untagged images older than the number of days given by --" + flags.OlderThanFlag + ", or a day by default.",
+		},
+		cli.IntFlag{
+			Name:  flags.OlderThanFlag,
+			Usage: "[Optional] Specifies the number of days after which images expire. Applies to untagged images if --" + flags.UntaggedFlag + " is specified, or else to all images.",
+		},
+		cli.IntFlag{
+			Name:  flags.KeepLastFlag,
+			Usage: "[Optional] Generates a rule which keeps the specified number of most recently pushed images, for each tag prefix if --" + flags.TagPrefixFlag + " is specified, and expires the rest.",
+		},
+		cli.StringFlag{
+			Name:  flags.TagPrefixFlag,
+			Usage: "[Optional] Specifies a comma separated list of tag prefixes which --" + flags.KeepLastFlag + " applies to.",
+		},
+	}
+}
+
+func registryIDFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.RegistryIdFlag,
+			Usage: "[Optional] Specifies the Amazon ECR registry ID of the repository. By default, the registry of the current AWS account is used.",
+		},
+	}
+}
@@ -33,20 +33,28 @@ const (
 const (
 	ClusterUp    = "Creates the ECS cluster (if it does not already exist) and the AWS resources required to set up the cluster."
 	ClusterDown  = "Deletes the CloudFormation stack that was created by ecs-cli up and the associated resources."
-	ClusterScale = "Modifies the number of container instances in your cluster. This command changes the desired and maximum instance count in the Auto Scaling group created by the ecs-cli up command. You can use this command to scale up (increase the number of instances) or scale down (decrease the number of instances) your cluster."
+	ClusterScale = "Modifies the number of container instances in your cluster. This command changes the desired and maximum instance count in the Auto Scaling group created by the ecs-cli up command. You can use this command to scale up (increase the number of instances) or scale down (decrease the number of instances) your cluster. For clusters created with --capacity-provider, the capacity provider sets the desired instance count, and this command only changes the maximum."
 	ClusterPs    = "Lists all of the running containers in your ECS cluster."
+
+	ClusterMigrateTemplate = "Updates the CloudFormation stack of a cluster created with an Auto Scaling launch configuration by an earlier version of the ecs-cli up command to launch its container instances with an EC2 launch template. Existing stack parameters are kept, and running container instances are not replaced."
+	ClusterDescribe        = "Describes your ECS cluster: its task counts and capacity providers, its container instances and their available resources, its services, and the CloudFormation stack created by the ecs-cli up command, if any."
+	ClusterUpgrade         = "Updates the AMI and instance type of the container instances launched by the CloudFormation stack created by the ecs-cli up command, then replaces the running container instances in batches: each instance is drained, terminated, and replaced by the Auto Scaling group, and the next batch starts once the services of the cluster are stable again."
+	ClusterInstances       = "Manages the container instances of your ECS cluster."
+	ClusterInstancesDrain  = "Sets container instances to DRAINING and waits until the tasks of services have moved to other container instances. Tasks that were not started by a service keep running."
 )
 
 // Compose
 const (
-	Compose       = "Executes docker-compose-style commands on an ECS cluster."
-	ComposeCreate = "Creates an ECS task definition from your compose file. Note that we do not recommend using plain text environment variables for sensitive information, such as credential data."
-	ComposePs     = "Lists all the containers in your cluster that were started by the compose project."
-	ComposeUp     = "Creates an ECS task definition from your compose file (if it does not already exist) and runs one instance of that task on your cluster (a combination of create and start)."
-	ComposeStart  = "Starts a single task from the task definition created from your compose file."
-	ComposeRun    = "Starts all containers overriding commands with the supplied one-off commands for the containers."
-	ComposeStop   = "Stops all the running tasks created by the compose project."
-	ComposeScale  = "Scales the number of running tasks to the specified count."
+	Compose         = "Executes docker-compose-style commands on an ECS cluster."
+	ComposeCreate   = "Creates an ECS task definition from your compose file. Note that we do not recommend using plain text environment variables for sensitive information, such as credential data."
+	ComposePs       = "Lists all the containers in your cluster that were started by the compose project."
+	ComposeUp       = "Creates an ECS task definition from your compose file (if it does not already exist) and runs one instance of that task on your cluster (a combination of create and start)."
+	ComposeStart    = "Starts a single task from the task definition created from your compose file."
+	ComposeRun      = "Starts all containers overriding commands with the supplied one-off commands for the containers."
+	ComposeStop     = "Stops all the running tasks created by the compose project."
+	ComposeScale    = "Scales the number of running tasks to the specified count."
+	ComposeValidate = "Checks your compose, ecs-params and registry credentials files for problems without making any calls to AWS."
+	ComposeParams   = "Prints your ecs-params file after variables have been interpolated and the overlay for the --env environment, if any, has been merged."
 )
 
 // Compose Service
@@ -70,11 +78,24 @@ const (
 	ConfigureProfileDefault = "Sets the default profile."
 )
 
+// Credential helper
+const (
+	CredentialHelper      = "Implements the Docker credential helper protocol for Amazon ECR registries. Runs when the ECS CLI is invoked as docker-credential-ecs-cli."
+	CredentialHelperGet   = "Prints the credentials of the registry whose server URL is read from stdin."
+	CredentialHelperStore = "Accepts credentials from stdin. ECR credentials are not stored, since they are fetched when they are needed."
+	CredentialHelperErase = "Removes the cached credentials of the registry whose server URL is read from stdin."
+	CredentialHelperList  = "Lists the registries with cached credentials."
+)
+
 // Image
 const (
-	Push   = "Pushes an image to an Amazon ECR repository."
-	Pull   = "Pulls an image from an Amazon ECR repository."
-	Images = "Lists images from an Amazon ECR repository. Lists all images in all repositories by default."
+	Push        = "Pushes an image to an Amazon ECR or Amazon ECR Public repository."
+	Pull        = "Pulls an image from an Amazon ECR or Amazon ECR Public repository."
+	Images      = "Lists images from an Amazon ECR repository. Lists all images in all repositories by default."
+	ImagesPrune = "Deletes the images of Amazon ECR repositories which match the specified filters."
+	ImagesScan  = "Scans an image in an Amazon ECR repository for vulnerabilities, waits for the scan to complete, and prints its findings."
+	ImagesCopy  = "Copies an image, or a manifest list and the images for all of its platforms, from one registry to another, keeping its digest. Layers which the destination repository already has are not copied."
+	ImagesLogin = "Logs Docker in to an Amazon ECR registry by writing an authorization token, or the ECS CLI credential helper, into the Docker config file."
 )
 
 // License
@@ -101,3 +122,23 @@ const (
 	RegistryCreds   = "Facilitates the creation and use of private registry credentials within ECS."
 	RegistryCredsUp = "Uses a YAML input file to generate AWS Secrets Manager secrets and an IAM Task Execution Role for use in an ECS Task Definition."
 )
+
+// Repo
+const (
+	Repo         = "Manages Amazon ECR repositories."
+	RepoCreate   = "Creates an Amazon ECR repository with the specified settings."
+	RepoDescribe = "Describes the settings, tags, and repository policy of an Amazon ECR repository."
+	RepoDelete   = "Deletes an Amazon ECR repository and all of the images in it."
+	RepoList     = "Lists the Amazon ECR repositories in a registry."
+
+	RepoLifecycle         = "Manages the lifecycle policy of an Amazon ECR repository."
+	RepoLifecycleGet      = "Prints the lifecycle policy of an Amazon ECR repository."
+	RepoLifecycleSet      = "Sets the lifecycle policy of an Amazon ECR repository from a JSON file, or generates one from the specified rules."
+	RepoLifecyclePreview  = "Lists the images which a lifecycle policy would expire. Previews the current lifecycle policy of the repository by default."
+	RepoLifecycleGenerate = "Prints a lifecycle policy generated from the specified rules."
+
+	RepoPullThroughCache       = "Manages the pull through cache rules of an Amazon ECR registry, which cache the images of an upstream registry in Amazon ECR repositories."
+	RepoPullThroughCacheCreate = "Creates a pull through cache rule, which caches the images of an upstream registry in the repositories with the specified prefix."
+	RepoPullThroughCacheList   = "Lists the pull through cache rules of an Amazon ECR registry."
+	RepoPullThroughCacheDelete = "Deletes a pull through cache rule. The repositories it created, and the images cached in them, are kept."
+)
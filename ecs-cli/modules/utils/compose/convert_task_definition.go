@@ -93,6 +93,13 @@ func ConvertToTaskDefinition(params ConvertTaskDefParams) (*ecs.TaskDefinition,
 		containerDefinitions = append(containerDefinitions, containerDef)
 	}
 
+	// Fail before registration if the linux parameters cannot be used with Fargate
+	if params.RequiredCompatibilites == ecs.LaunchTypeFargate {
+		if err := validateFargateLinuxParameters(containerDefinitions); err != nil {
+			return nil, err
+		}
+	}
+
 	ecsVolumes, err := convertToECSVolumes(params.Volumes, params.ECSParams)
 	if err != nil {
 		return nil, err
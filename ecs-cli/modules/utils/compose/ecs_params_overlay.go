@@ -0,0 +1,199 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/docker/cli/cli/compose/template"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+const defaultECSParamsFileName = "ecs-params.yml"
+
+// variablePattern matches the same variables as docker compose ($VAR, ${VAR},
+// ${VAR:-default}, etc.) and $$ escapes, but leaves any other use of $ as is
+var variablePattern = regexp.MustCompile(`\$(?i:(?P<escaped>\$)|(?P<named>[_a-z][_a-z0-9]*(?::?[-?][^}]*)?)|{(?P<braced>[_a-z][_a-z0-9]*(?::?[-?][^}]*)?)})`)
+
+// ReadECSParamsOptions configures how the ecs-params files of a project are read
+type ReadECSParamsOptions struct {
+	// Env is the name of an environment, such as staging, whose overlay file
+	// (ecs-params.<env>.yml) is merged over the ecs-params file
+	Env string
+	// Environment holds the values of the variables which are interpolated
+	// into the ecs-params files. Defaults to the OS environment.
+	Environment map[string]string
+}
+
+// ecsParamsFile holds the contents of a single ecs-params file after variable interpolation
+type ecsParamsFile struct {
+	name string
+	data []byte
+}
+
+// RenderECSParams returns the ecs-params yaml that results from interpolating
+// variables and merging the environment overlay, if any, over the ecs-params file
+func RenderECSParams(filename string, opts ReadECSParamsOptions) ([]byte, error) {
+	files, err := readECSParamsFiles(filename, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("No ECS params file found")
+	}
+	merged, err := mergeECSParamsFiles(files)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(merged)
+}
+
+// readECSParamsFiles reads the ecs-params file and the overlay for opts.Env,
+// in the order in which they are merged
+func readECSParamsFiles(filename string, opts ReadECSParamsOptions) ([]ecsParamsFile, error) {
+	environment := opts.Environment
+	if environment == nil {
+		environment = osEnvironment()
+	}
+
+	var filenames []string
+	if name := ecsParamsFileName(filename); name != "" {
+		filenames = append(filenames, name)
+	}
+	if opts.Env != "" {
+		overlayFileName := ecsParamsOverlayFileName(filename, opts.Env)
+		if _, err := os.Stat(overlayFileName); err != nil {
+			return nil, errors.Wrapf(err, "Error reading overlay for environment '%s'", opts.Env)
+		}
+		filenames = append(filenames, overlayFileName)
+	}
+
+	files := []ecsParamsFile{}
+	for _, name := range filenames {
+		// NOTE: Readfile reads all data into memory and closes file. Could
+		// eventually refactor this to read different sections separately.
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error reading file '%v'", name)
+		}
+		data, err = interpolateECSParams(data, environment)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error interpolating variables in ECS params file: %v", name)
+		}
+		files = append(files, ecsParamsFile{name: name, data: data})
+	}
+	return files, nil
+}
+
+// ecsParamsOverlayFileName returns the name of the overlay file for an
+// environment, which sits next to the ecs-params file. For example, the
+// overlay of my-params.yml for staging is my-params.staging.yml.
+func ecsParamsOverlayFileName(filename, env string) string {
+	if filename == "" {
+		filename = defaultECSParamsFileName
+	}
+	ext := filepath.Ext(filename)
+	return strings.TrimSuffix(filename, ext) + "." + env + ext
+}
+
+// interpolateECSParams substitutes variables in the ecs-params data in the
+// same way as docker compose. Interpolating the raw data rather than the
+// parsed values keeps the line numbers of any yaml errors correct.
+func interpolateECSParams(data []byte, environment map[string]string) ([]byte, error) {
+	mapping := func(name string) (string, bool) {
+		value, ok := environment[name]
+		return value, ok
+	}
+	// Variables without a default are substituted last, so that a warning is
+	// only logged for those which are not set
+	warnUnset := func(name string, mapping template.Mapping) (string, bool, error) {
+		value, ok := mapping(name)
+		if !ok {
+			log.WithFields(log.Fields{
+				"variable": name,
+			}).Warn("The variable is not set. Defaulting to a blank string.")
+		}
+		return value, true, nil
+	}
+	substituteFuncs := append([]template.SubstituteFunc{}, template.DefaultSubstituteFuncs...)
+	substituteFuncs = append(substituteFuncs, warnUnset)
+	interpolated, err := template.SubstituteWith(string(data), mapping, variablePattern, substituteFuncs...)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(interpolated), nil
+}
+
+func osEnvironment() map[string]string {
+	environment := make(map[string]string)
+	for _, s := range os.Environ() {
+		varParts := strings.SplitN(s, "=", 2)
+		environment[varParts[0]] = varParts[1]
+	}
+	return environment
+}
+
+// mergeECSParamsFiles deep merges each file over the ones before it
+func mergeECSParamsFiles(files []ecsParamsFile) (yaml.MapSlice, error) {
+	var merged yaml.MapSlice
+	for _, file := range files {
+		var doc yaml.MapSlice
+		if err := yaml.Unmarshal(file.data, &doc); err != nil {
+			return nil, errors.Wrapf(err, "Error unmarshalling yaml data from ECS params file: %v", file.name)
+		}
+		merged = mergeYAMLMaps(merged, doc)
+	}
+	return merged, nil
+}
+
+// mergeYAMLMaps merges overlay into base. Maps are merged key by key, so that
+// a service or field which is not in the overlay keeps its value from base.
+// Any other value, including a list such as subnets or secrets, is replaced
+// as a whole by the overlay value; an explicit null clears it.
+func mergeYAMLMaps(base, overlay yaml.MapSlice) yaml.MapSlice {
+	merged := make(yaml.MapSlice, len(base))
+	copy(merged, base)
+
+	for _, item := range overlay {
+		i := indexOfYAMLKey(merged, item.Key)
+		if i < 0 {
+			merged = append(merged, item)
+			continue
+		}
+		baseValue, baseIsMap := merged[i].Value.(yaml.MapSlice)
+		overlayValue, overlayIsMap := item.Value.(yaml.MapSlice)
+		if baseIsMap && overlayIsMap {
+			merged[i].Value = mergeYAMLMaps(baseValue, overlayValue)
+		} else {
+			merged[i].Value = item.Value
+		}
+	}
+	return merged
+}
+
+func indexOfYAMLKey(m yaml.MapSlice, key interface{}) int {
+	for i, item := range m {
+		if item.Key == key {
+			return i
+		}
+	}
+	return -1
+}
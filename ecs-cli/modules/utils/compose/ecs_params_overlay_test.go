@@ -0,0 +1,188 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const overlayBaseECSParams = `version: 1
+task_definition:
+  task_execution_role: ${EXECUTION_ROLE}
+  task_size:
+    cpu_limit: ${CPU:-256}
+    mem_limit: 512
+  services:
+    web:
+      essential: true
+      secrets:
+        - value_from: /dev/db_password
+          name: DB_PASSWORD
+    worker:
+      essential: false
+      cpu_shares: 100
+run_params:
+  network_configuration:
+    awsvpc_configuration:
+      subnets:
+        - subnet-dev1
+        - subnet-dev2
+      security_groups:
+        - sg-dev
+      assign_public_ip: ENABLED`
+
+const overlayStagingECSParams = `task_definition:
+  task_size:
+    mem_limit: 1GB
+  services:
+    web:
+      secrets:
+        - value_from: /staging/db_password
+          name: DB_PASSWORD
+    worker:
+      cpu_shares: 200
+run_params:
+  network_configuration:
+    awsvpc_configuration:
+      subnets:
+        - subnet-staging1
+      security_groups:
+      assign_public_ip: DISABLED`
+
+func TestReadECSParamsWithOptions_Overlay(t *testing.T) {
+	filename := writeECSParamsOverlayFilesForTest(t, overlayBaseECSParams, "staging", overlayStagingECSParams)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	ecsParams, err := ReadECSParamsWithOptions(filename, ReadECSParamsOptions{
+		Env:         "staging",
+		Environment: map[string]string{"EXECUTION_ROLE": "arn:aws:iam::123456789012:role/staging"},
+	})
+
+	if assert.NoError(t, err) {
+		taskDef := ecsParams.TaskDefinition
+		assert.Equal(t, "arn:aws:iam::123456789012:role/staging", taskDef.ExecutionRole, "Expected variable to be interpolated")
+		assert.Equal(t, "256", taskDef.TaskSize.Cpu, "Expected default value to be used for unset variable")
+		assert.Equal(t, "1GB", taskDef.TaskSize.Memory, "Expected overlay to override nested field")
+
+		web := taskDef.ContainerDefinitions["web"]
+		assert.True(t, web.Essential, "Expected field not in overlay to be kept")
+		assert.Equal(t, []Secret{{ValueFrom: "/staging/db_password", Name: "DB_PASSWORD"}}, web.Secrets, "Expected overlay to replace secrets")
+
+		worker := taskDef.ContainerDefinitions["worker"]
+		assert.False(t, worker.Essential, "Expected field not in overlay to be kept")
+		assert.Equal(t, int64(200), worker.Cpu, "Expected overlay to override service field")
+
+		awsvpcConfig := ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration
+		assert.Equal(t, []string{"subnet-staging1"}, awsvpcConfig.Subnets, "Expected overlay to replace subnets")
+		assert.Empty(t, awsvpcConfig.SecurityGroups, "Expected null in overlay to clear security groups")
+		assert.Equal(t, Disabled, awsvpcConfig.AssignPublicIp)
+	}
+}
+
+func TestReadECSParamsWithOptions_NoOverlay(t *testing.T) {
+	filename := writeECSParamsOverlayFilesForTest(t, overlayBaseECSParams, "", "")
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	ecsParams, err := ReadECSParamsWithOptions(filename, ReadECSParamsOptions{
+		Environment: map[string]string{"CPU": "512"},
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "512", ecsParams.TaskDefinition.TaskSize.Cpu, "Expected variable to override default value")
+		assert.Equal(t, "512", ecsParams.TaskDefinition.TaskSize.Memory)
+		assert.Equal(t, []string{"subnet-dev1", "subnet-dev2"}, ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.Subnets)
+	}
+}
+
+func TestReadECSParamsWithOptions_MissingOverlay(t *testing.T) {
+	filename := writeECSParamsOverlayFilesForTest(t, overlayBaseECSParams, "", "")
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	_, err := ReadECSParamsWithOptions(filename, ReadECSParamsOptions{Env: "prod"})
+	assert.Error(t, err, "Expected error when the overlay file does not exist")
+}
+
+func TestReadECSParamsStrict_OverlayErrors(t *testing.T) {
+	overlay := `task_definition:
+  task_size:
+    cpu_limt: 512`
+	filename := writeECSParamsOverlayFilesForTest(t, overlayBaseECSParams, "staging", overlay)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	_, err := ReadECSParamsStrict(filename, ReadECSParamsOptions{Env: "staging", Environment: map[string]string{}})
+	validationErrs, ok := err.(ValidationErrors)
+	if assert.True(t, ok, "Expected ValidationErrors, got %v", err) {
+		assert.Len(t, validationErrs, 1)
+		assert.Contains(t, validationErrs[0].Error(), `ecs-params.staging.yml: line 3: unknown field "cpu_limt"`, "Expected problem to be reported against the overlay file")
+	}
+}
+
+func TestRenderECSParams(t *testing.T) {
+	base := `version: 1
+task_definition:
+  ecs_network_mode: ${NETWORK_MODE:-bridge}
+  task_role_arn: role
+  services:
+    web:
+      cpu_shares: 100
+      mem_limit: $$5`
+	overlay := `task_definition:
+  task_role_arn: staging-role
+  services:
+    worker:
+      essential: false`
+	filename := writeECSParamsOverlayFilesForTest(t, base, "staging", overlay)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	rendered, err := RenderECSParams(filename, ReadECSParamsOptions{Env: "staging", Environment: map[string]string{}})
+	expected := `version: 1
+task_definition:
+  ecs_network_mode: bridge
+  task_role_arn: staging-role
+  services:
+    web:
+      cpu_shares: 100
+      mem_limit: $5
+    worker:
+      essential: false
+`
+	if assert.NoError(t, err) {
+		assert.Equal(t, expected, string(rendered), "Expected keys to keep the order of the ecs-params file")
+	}
+}
+
+func TestEcsParamsOverlayFileName(t *testing.T) {
+	assert.Equal(t, "ecs-params.staging.yml", ecsParamsOverlayFileName("", "staging"))
+	assert.Equal(t, "config/my-params.prod.yaml", ecsParamsOverlayFileName("config/my-params.yaml", "prod"))
+}
+
+// writeECSParamsOverlayFilesForTest writes an ecs-params.yml file, and an
+// overlay for env if one is given, to a new temporary directory
+func writeECSParamsOverlayFilesForTest(t *testing.T, base, env, overlay string) string {
+	dir, err := ioutil.TempDir("", "ecs-params")
+	assert.NoError(t, err, "Could not create temp dir")
+
+	filename := filepath.Join(dir, "ecs-params.yml")
+	assert.NoError(t, ioutil.WriteFile(filename, []byte(base), 0644), "Could not write ecs params file")
+	if env != "" {
+		overlayFileName := filepath.Join(dir, "ecs-params."+env+".yml")
+		assert.NoError(t, ioutil.WriteFile(overlayFileName, []byte(overlay), 0644), "Could not write overlay file")
+	}
+	return filename
+}
@@ -17,9 +17,9 @@ package utils
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
@@ -43,16 +43,17 @@ type ECSParams struct {
 
 // EcsTaskDef corresponds to fields in an ECS TaskDefinition
 type EcsTaskDef struct {
-	NetworkMode          string         `yaml:"ecs_network_mode"`
-	TaskRoleArn          string         `yaml:"task_role_arn"`
-	PIDMode              string         `yaml:"pid_mode"`
-	IPCMode              string         `yaml:"ipc_mode"`
-	ContainerDefinitions ContainerDefs  `yaml:"services"`
-	ExecutionRole        string         `yaml:"task_execution_role"`
-	TaskSize             TaskSize       `yaml:"task_size"` // Needed to run FARGATE tasks
-	DockerVolumes        []DockerVolume `yaml:"docker_volumes"`
-	EFSVolumes           []EFSVolume    `yaml:"efs_volumes"`
-	PlacementConstraints []Constraint   `yaml:"placement_constraints"`
+	NetworkMode          string            `yaml:"ecs_network_mode"`
+	TaskRoleArn          string            `yaml:"task_role_arn"`
+	PIDMode              string            `yaml:"pid_mode"`
+	IPCMode              string            `yaml:"ipc_mode"`
+	ContainerDefinitions ContainerDefs     `yaml:"services"`
+	ExecutionRole        string            `yaml:"task_execution_role"`
+	TaskSize             TaskSize          `yaml:"task_size"` // Needed to run FARGATE tasks
+	DockerVolumes        []DockerVolume    `yaml:"docker_volumes"`
+	EFSVolumes           []EFSVolume       `yaml:"efs_volumes"`
+	PlacementConstraints []Constraint      `yaml:"placement_constraints"`
+	TaskRolePolicies     *TaskRolePolicies `yaml:"task_role_policies"`
 }
 
 // ContainerDefs is a map of ContainerDefs within a task definition
@@ -62,6 +63,7 @@ type ContainerDefs map[string]ContainerDef
 type ContainerDef struct {
 	Essential             bool                  `yaml:"essential"`
 	InitProcessEnabled    bool                  `yaml:"init_process_enabled"`
+	LinuxParameters       *LinuxParameters      `yaml:"linux_parameters"`
 	RepositoryCredentials RepositoryCredentials `yaml:"repository_credentials"`
 	// resource field yaml names correspond to equivalent docker-compose field
 	Cpu                   int64                  `yaml:"cpu_shares"`
@@ -117,6 +119,31 @@ type HealthCheck struct {
 	StartPeriod string `yaml:"start_period,omitempty"`
 }
 
+// LinuxParameters holds Linux-specific options for a ContainerDef. These are
+// merged with the linux parameters converted from the compose file.
+// https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_LinuxParameters.html
+type LinuxParameters struct {
+	Capabilities       KernelCapabilities      `yaml:"capabilities"`
+	Devices            []Device                `yaml:"devices"`
+	InitProcessEnabled *bool                   `yaml:"init_process_enabled"`
+	MaxSwap            *libYaml.MemStringorInt `yaml:"max_swap"`           // Values specified without units default to bytes
+	SharedMemorySize   libYaml.MemStringorInt  `yaml:"shared_memory_size"` // Values specified without units default to bytes
+	Swappiness         *int64                  `yaml:"swappiness"`
+}
+
+// KernelCapabilities holds the Linux capabilities to add to or drop from a container
+type KernelCapabilities struct {
+	Add  []string `yaml:"add"`
+	Drop []string `yaml:"drop"`
+}
+
+// Device holds a host device to expose to a container
+type Device struct {
+	HostPath      string   `yaml:"host_path"` // Required
+	ContainerPath string   `yaml:"container_path"`
+	Permissions   []string `yaml:"permissions"` // Valid values: read, write, mknod
+}
+
 // RepositoryCredentials holds CredentialParameters for a ContainerDef
 type RepositoryCredentials struct {
 	CredentialsParameter string `yaml:"credentials_parameter"`
@@ -141,6 +168,22 @@ type Secret struct {
 	Name      string `yaml:"name"`
 }
 
+// TaskRolePolicies holds the permissions of the task role which the ecs-cli
+// creates and keeps up to date for the project
+type TaskRolePolicies struct {
+	ManagedPolicyArns []string          `yaml:"managed_policy_arns"`
+	Statements        []PolicyStatement `yaml:"statements"`
+}
+
+// PolicyStatement holds an IAM policy statement for the task role
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements.html
+type PolicyStatement struct {
+	Effect    string                                      `yaml:"effect"` // default: Allow. options: Allow or Deny
+	Actions   libYaml.Stringorslice                       `yaml:"actions"`
+	Resources libYaml.Stringorslice                       `yaml:"resources"`
+	Condition map[string]map[string]libYaml.Stringorslice `yaml:"condition"`
+}
+
 // TaskSize holds Cpu and Memory values needed for Fargate tasks
 // https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task-cpu-memory-error.html
 type TaskSize struct {
@@ -246,41 +289,148 @@ type Constraint struct {
 func (cd *ContainerDef) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	type rawContainerDef ContainerDef
 	raw := rawContainerDef{Essential: true} //  If essential is not specified, we want it to be true
-	if err := unmarshal(&raw); err != nil {
+	// The fields which could be decoded are kept even if some could not
+	err := unmarshal(&raw)
+	*cd = ContainerDef(raw)
+	return err
+}
+
+// containerDefEntry decodes a single service, recording any error instead of
+// returning it, as the decoder drops the map entries of values which fail
+type containerDefEntry struct {
+	def ContainerDef
+	err error
+}
+
+func (e *containerDefEntry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	e.err = unmarshal(&e.def)
+	return nil
+}
+
+// UnmarshalYAML decodes each service on its own, so that a service with a
+// type error is still returned with the fields which could be decoded, and
+// the type errors of every service are reported.
+func (cds *ContainerDefs) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	entries := make(map[string]containerDefEntry)
+	if err := unmarshal(&entries); err != nil {
 		return err
 	}
 
-	*cd = ContainerDef(raw)
+	defs := make(ContainerDefs, len(entries))
+	var typeErrs []string
+	for name, entry := range entries {
+		defs[name] = entry.def
+	}
+	for _, name := range sortedServiceNames(defs) {
+		switch err := entries[name].err.(type) {
+		case nil:
+		case *yaml.TypeError:
+			typeErrs = append(typeErrs, err.Errors...)
+		default:
+			return err
+		}
+	}
+
+	*cds = defs
+	if len(typeErrs) > 0 {
+		return &yaml.TypeError{Errors: typeErrs}
+	}
 	return nil
 }
 
 // ReadECSParams parses the ecs-params.yml file and puts it into an ECSParams struct.
 func ReadECSParams(filename string) (*ECSParams, error) {
+	return ReadECSParamsWithOptions(filename, ReadECSParamsOptions{})
+}
+
+// ReadECSParamsWithOptions parses the ecs-params.yml file, merged with the
+// overlay for opts.Env if one is set, and puts it into an ECSParams struct.
+func ReadECSParamsWithOptions(filename string, opts ReadECSParamsOptions) (*ECSParams, error) {
+	files, err := readECSParamsFiles(filename, opts)
+	if err != nil {
+		return nil, err
+	}
+	ecsParams, err := unmarshalECSParams(files)
+	if err != nil {
+		return nil, err
+	}
+	return ecsParams, nil
+}
+
+// ReadECSParamsStrict parses the ecs-params files like ReadECSParamsWithOptions,
+// but also fails on unknown keys. Every unknown key and type error found in
+// each file is returned as a ValidationErrors, along with the fields that
+// could be parsed, so that the remaining fields can still be validated.
+func ReadECSParamsStrict(filename string, opts ReadECSParamsOptions) (*ECSParams, error) {
+	files, err := readECSParamsFiles(filename, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each file is checked on its own so that problems are reported against
+	// the file and line they are in, rather than the merged result
+	var errs ValidationErrors
+	for _, file := range files {
+		err := yaml.UnmarshalStrict(file.data, &ECSParams{})
+		if typeErr, ok := err.(*yaml.TypeError); ok {
+			errs = append(errs, newYAMLValidationErrors(file.name, typeErr)...)
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "Error unmarshalling yaml data from ECS params file: %v", file.name)
+		}
+	}
+
+	ecsParams, err := unmarshalECSParams(files)
+	if len(errs) > 0 {
+		return ecsParams, errs
+	}
+	return ecsParams, err
+}
+
+// ecsParamsFileName returns the ecs-params file to read, which defaults to
+// ecs-params.yml if it exists. An empty string means there is no file to read.
+func ecsParamsFileName(filename string) string {
 	if filename == "" {
-		defaultFilename := "ecs-params.yml"
-		if _, err := os.Stat(defaultFilename); err == nil {
-			filename = defaultFilename
-		} else {
-			return nil, nil
+		if _, err := os.Stat(defaultECSParamsFileName); err == nil {
+			return defaultECSParamsFileName
 		}
 	}
+	return filename
+}
 
-	// NOTE: Readfile reads all data into memory and closes file. Could
-	// eventually refactor this to read different sections separately.
-	ecsParamsData, err := ioutil.ReadFile(filename)
-	if err != nil {
-		return nil, errors.Wrapf(err, "Error reading file '%v'", filename)
+// unmarshalECSParams merges the ecs-params files and unmarshals the result.
+// The fields which could be parsed are returned along with any error.
+func unmarshalECSParams(files []ecsParamsFile) (*ECSParams, error) {
+	if len(files) == 0 {
+		return nil, nil
 	}
-	ecsParamsData = []byte(os.ExpandEnv(string(ecsParamsData)))
-	ecsParams := &ECSParams{}
 
-	if err = yaml.Unmarshal([]byte(ecsParamsData), &ecsParams); err != nil {
-		return nil, errors.Wrapf(err, "Error unmarshalling yaml data from ECS params file: %v", filename)
+	ecsParamsData := files[0].data
+	if len(files) > 1 {
+		merged, err := mergeECSParamsFiles(files)
+		if err != nil {
+			return nil, err
+		}
+		if ecsParamsData, err = yaml.Marshal(merged); err != nil {
+			return nil, err
+		}
+	}
+
+	ecsParams := &ECSParams{}
+	if err := yaml.Unmarshal(ecsParamsData, &ecsParams); err != nil {
+		return ecsParams, errors.Wrapf(err, "Error unmarshalling yaml data from ECS params file: %v", ecsParamsFileNames(files))
 	}
 
 	return ecsParams, nil
 }
 
+func ecsParamsFileNames(files []ecsParamsFile) string {
+	names := make([]string, len(files))
+	for i, file := range files {
+		names[i] = file.name
+	}
+	return strings.Join(names, ", ")
+}
+
 /////////////////////
 //// Converters ////
 ////////////////////
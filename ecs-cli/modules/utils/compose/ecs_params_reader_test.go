@@ -432,6 +432,64 @@ task_definition:
 	}
 }
 
+func TestReadECSParams_WithTaskRolePolicies(t *testing.T) {
+	ecsParamsString := `version: 1
+task_definition:
+  task_role_policies:
+    managed_policy_arns:
+      - arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess
+    statements:
+      - actions: sqs:SendMessage
+        resources:
+          - arn:aws:sqs:us-east-1:123456789012:jobs
+      - effect: Deny
+        actions:
+          - s3:DeleteObject
+          - s3:PutObject
+        resources: "*"
+        condition:
+          StringNotEquals:
+            aws:SourceVpc: vpc-1234`
+
+	content := []byte(ecsParamsString)
+
+	tmpfile, err := ioutil.TempFile("", "ecs-params")
+	assert.NoError(t, err, "Could not create ecs-params tempfile")
+
+	ecsParamsFileName := tmpfile.Name()
+	defer os.Remove(ecsParamsFileName)
+
+	_, err = tmpfile.Write(content)
+	assert.NoError(t, err, "Could not write data to ecs-params tempfile")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Could not close tempfile")
+
+	ecsParams, err := ReadECSParams(ecsParamsFileName)
+
+	if assert.NoError(t, err) {
+		policies := ecsParams.TaskDefinition.TaskRolePolicies
+		if assert.NotNil(t, policies, "Expected task role policies to be set") {
+			assert.Equal(t, []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}, policies.ManagedPolicyArns)
+			expectedStatements := []PolicyStatement{
+				{
+					Actions:   yaml.Stringorslice{"sqs:SendMessage"},
+					Resources: yaml.Stringorslice{"arn:aws:sqs:us-east-1:123456789012:jobs"},
+				},
+				{
+					Effect:    "Deny",
+					Actions:   yaml.Stringorslice{"s3:DeleteObject", "s3:PutObject"},
+					Resources: yaml.Stringorslice{"*"},
+					Condition: map[string]map[string]yaml.Stringorslice{
+						"StringNotEquals": {"aws:SourceVpc": {"vpc-1234"}},
+					},
+				},
+			}
+			assert.Equal(t, expectedStatements, policies.Statements)
+		}
+	}
+}
+
 /** ConvertToECSNetworkConfiguration tests **/
 
 func TestConvertToECSNetworkConfiguration(t *testing.T) {
@@ -0,0 +1,439 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+// ECS Params Validator checks the ecs-params.yml file against the compose
+// project it is used with, without making any calls to AWS
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/regcredio"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	platformVersionLatest = "LATEST"
+	// minEFSFargatePlatformVersion is the first Fargate platform version to support EFS volumes
+	minEFSFargatePlatformVersion = "1.4.0"
+)
+
+// fargateTaskSizes maps each valid Fargate task CPU value to the valid memory values in MiB
+// https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task-cpu-memory-error.html
+var fargateTaskSizes = map[int64][]int64{
+	256:   {512, 1024, 2048},
+	512:   memoryRange(1024, 4096, 1024),
+	1024:  memoryRange(2048, 8192, 1024),
+	2048:  memoryRange(4096, 16384, 1024),
+	4096:  memoryRange(8192, 30720, 1024),
+	8192:  memoryRange(16384, 61440, 4096),
+	16384: memoryRange(32768, 122880, 8192),
+}
+
+var yamlUnknownFieldRegexp = regexp.MustCompile(`field (\S+) not found in type \S+`)
+
+// ValidationErrors holds every problem found while validating a project, so
+// that they can all be reported at once
+type ValidationErrors []error
+
+func (v ValidationErrors) Error() string {
+	messages := make([]string, len(v))
+	for i, err := range v {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("%d problem(s) found:\n  %s", len(v), strings.Join(messages, "\n  "))
+}
+
+// newYAMLValidationErrors splits a yaml TypeError into one error per problem,
+// each of which includes the line number at which it was found
+func newYAMLValidationErrors(filename string, typeErr *yaml.TypeError) ValidationErrors {
+	var errs ValidationErrors
+	for _, msg := range typeErr.Errors {
+		msg = yamlUnknownFieldRegexp.ReplaceAllString(msg, "unknown field \"$1\"")
+		errs = append(errs, fmt.Errorf("%s: %s", filename, msg))
+	}
+	return errs
+}
+
+// ValidateECSParamsInput contains the values from the compose project and
+// command line that the ecs-params are validated against
+type ValidateECSParamsInput struct {
+	LaunchType       string
+	PlatformVersion  string
+	ContainerConfigs []adapter.ContainerConfig
+}
+
+// ValidateECSParams checks the ecs-params for problems that would otherwise
+// only be found when registering the task definition or running the tasks.
+// Every problem found is returned.
+func ValidateECSParams(ecsParams *ECSParams, input ValidateECSParamsInput) ValidationErrors {
+	var errs ValidationErrors
+	if ecsParams == nil {
+		if input.LaunchType == ecs.LaunchTypeFargate {
+			errs = append(errs, fmt.Errorf("launch type %s requires an ecs-params file with network configuration and task size", ecs.LaunchTypeFargate))
+		}
+		return errs
+	}
+
+	taskDef := ecsParams.TaskDefinition
+	errs = append(errs, validateServiceNames(taskDef.ContainerDefinitions, input.ContainerConfigs)...)
+	errs = append(errs, validateNetworkMode(ecsParams, input.ContainerConfigs)...)
+	errs = append(errs, validateSecrets(taskDef.ContainerDefinitions)...)
+	errs = append(errs, validateTaskRolePolicies(taskDef)...)
+	if input.LaunchType == ecs.LaunchTypeFargate {
+		errs = append(errs, validateFargateECSParams(ecsParams, input.PlatformVersion)...)
+	}
+
+	return errs
+}
+
+// validateServiceNames checks that every service in ecs-params is defined in the compose file
+func validateServiceNames(containerDefs ContainerDefs, containerConfigs []adapter.ContainerConfig) ValidationErrors {
+	composeServices := make(map[string]bool)
+	for _, containerConfig := range containerConfigs {
+		composeServices[containerConfig.Name] = true
+	}
+
+	var errs ValidationErrors
+	for _, name := range sortedServiceNames(containerDefs) {
+		if !composeServices[name] {
+			errs = append(errs, fmt.Errorf("task_definition.services.%s: service is not defined in the compose file", name))
+		}
+	}
+	return errs
+}
+
+// validateNetworkMode checks the network mode and that the port mappings in
+// the compose file can be used with it
+func validateNetworkMode(ecsParams *ECSParams, containerConfigs []adapter.ContainerConfig) ValidationErrors {
+	var errs ValidationErrors
+	networkMode := ecsParams.TaskDefinition.NetworkMode
+	switch networkMode {
+	case "", ecs.NetworkModeBridge:
+		return nil
+	case ecs.NetworkModeAwsvpc:
+		if len(ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.Subnets) == 0 {
+			errs = append(errs, fmt.Errorf("run_params.network_configuration.awsvpc_configuration.subnets: at least one subnet is required with network mode %s", networkMode))
+		}
+	case ecs.NetworkModeHost, ecs.NetworkModeNone:
+	default:
+		return append(errs, fmt.Errorf("task_definition.ecs_network_mode: %s is not a valid network mode; valid values are %s", networkMode, strings.Join([]string{ecs.NetworkModeBridge, ecs.NetworkModeHost, ecs.NetworkModeAwsvpc, ecs.NetworkModeNone}, ", ")))
+	}
+
+	for _, containerConfig := range containerConfigs {
+		for _, portMapping := range containerConfig.PortMappings {
+			hostPort := aws.Int64Value(portMapping.HostPort)
+			containerPort := aws.Int64Value(portMapping.ContainerPort)
+			if networkMode == ecs.NetworkModeNone {
+				errs = append(errs, fmt.Errorf("%s: port mappings are not supported with network mode %s", containerConfig.Name, networkMode))
+				break
+			}
+			if hostPort != 0 && hostPort != containerPort {
+				errs = append(errs, fmt.Errorf("%s: host port %d must match container port %d with network mode %s", containerConfig.Name, hostPort, containerPort, networkMode))
+			}
+		}
+	}
+	return errs
+}
+
+// validateSecrets checks the format of the secrets and repository credentials of each service
+func validateSecrets(containerDefs ContainerDefs) ValidationErrors {
+	var errs ValidationErrors
+	for _, name := range sortedServiceNames(containerDefs) {
+		containerDef := containerDefs[name]
+		for i, secret := range containerDef.Secrets {
+			field := fmt.Sprintf("task_definition.services.%s.secrets[%d]", name, i)
+			errs = append(errs, validateSecret(field, secret)...)
+		}
+		for i, secret := range containerDef.Logging.SecretOptions {
+			field := fmt.Sprintf("task_definition.services.%s.logging.secret_options[%d]", name, i)
+			errs = append(errs, validateSecret(field, secret)...)
+		}
+		if credParam := containerDef.RepositoryCredentials.CredentialsParameter; credParam != "" {
+			field := fmt.Sprintf("task_definition.services.%s.repository_credentials.credentials_parameter", name)
+			if err := validateSecretsManagerARN(field, credParam); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
+func validateSecret(field string, secret Secret) ValidationErrors {
+	var errs ValidationErrors
+	if secret.Name == "" {
+		errs = append(errs, fmt.Errorf("%s.name: name is required", field))
+	}
+	if secret.ValueFrom == "" {
+		errs = append(errs, fmt.Errorf("%s.value_from: value_from is required", field))
+	} else if err := validateSecretARN(field+".value_from", secret.ValueFrom); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// validateSecretARN checks that a secret given as an ARN refers to an SSM
+// parameter or a Secrets Manager secret. Secrets given as a parameter name
+// are not checked, since they cannot be validated offline.
+func validateSecretARN(field, value string) error {
+	if !arn.IsARN(value) {
+		if strings.HasPrefix(value, "arn:") {
+			return fmt.Errorf("%s: %s is not a valid ARN", field, value)
+		}
+		return nil
+	}
+	secretARN, err := arn.Parse(value)
+	if err != nil {
+		return fmt.Errorf("%s: %s is not a valid ARN", field, value)
+	}
+	switch secretARN.Service {
+	case "ssm":
+		if !strings.HasPrefix(secretARN.Resource, "parameter/") {
+			return fmt.Errorf("%s: %s is not an SSM parameter ARN", field, value)
+		}
+	case "secretsmanager":
+		return validateSecretsManagerARN(field, value)
+	default:
+		return fmt.Errorf("%s: %s must be an SSM parameter or Secrets Manager secret ARN", field, value)
+	}
+	return nil
+}
+
+func validateSecretsManagerARN(field, value string) error {
+	secretARN, err := arn.Parse(value)
+	if err != nil || secretARN.Service != "secretsmanager" || !strings.HasPrefix(secretARN.Resource, "secret:") {
+		return fmt.Errorf("%s: %s is not a valid Secrets Manager secret ARN", field, value)
+	}
+	return nil
+}
+
+// validateTaskRolePolicies checks the statements and managed policies of the
+// task role created by the ecs-cli
+func validateTaskRolePolicies(taskDef EcsTaskDef) ValidationErrors {
+	policies := taskDef.TaskRolePolicies
+	if policies == nil {
+		return nil
+	}
+
+	var errs ValidationErrors
+	if taskDef.TaskRoleArn != "" {
+		errs = append(errs, fmt.Errorf("task_definition.task_role_policies: task_role_policies can not be used with task_role_arn"))
+	}
+	for i, policyARN := range policies.ManagedPolicyArns {
+		parsedARN, err := arn.Parse(policyARN)
+		if err != nil || parsedARN.Service != "iam" || !strings.HasPrefix(parsedARN.Resource, "policy/") {
+			errs = append(errs, fmt.Errorf("task_definition.task_role_policies.managed_policy_arns[%d]: %s is not a valid IAM policy ARN", i, policyARN))
+		}
+	}
+	for i, statement := range policies.Statements {
+		field := fmt.Sprintf("task_definition.task_role_policies.statements[%d]", i)
+		switch statement.Effect {
+		case "", "Allow", "Deny":
+		default:
+			errs = append(errs, fmt.Errorf("%s.effect: %s is not a valid effect; valid values are Allow, Deny", field, statement.Effect))
+		}
+		if len(statement.Actions) == 0 {
+			errs = append(errs, fmt.Errorf("%s.actions: at least one action is required", field))
+		}
+		if len(statement.Resources) == 0 {
+			errs = append(errs, fmt.Errorf("%s.resources: at least one resource is required", field))
+		}
+	}
+	return errs
+}
+
+// validateFargateECSParams checks the fields which are required or restricted with the Fargate launch type
+func validateFargateECSParams(ecsParams *ECSParams, platformVersion string) ValidationErrors {
+	var errs ValidationErrors
+	taskDef := ecsParams.TaskDefinition
+	if taskDef.NetworkMode != ecs.NetworkModeAwsvpc {
+		errs = append(errs, fmt.Errorf("task_definition.ecs_network_mode: launch type %s requires network mode %s", ecs.LaunchTypeFargate, ecs.NetworkModeAwsvpc))
+	}
+	if err := validateFargateTaskSize(taskDef.TaskSize); err != nil {
+		errs = append(errs, err)
+	}
+	if len(taskDef.EFSVolumes) > 0 && !supportsEFS(platformVersion) {
+		errs = append(errs, fmt.Errorf("task_definition.efs_volumes: EFS volumes require Fargate platform version %s or later, got %s", minEFSFargatePlatformVersion, platformVersion))
+	}
+	if len(taskDef.PlacementConstraints) > 0 {
+		errs = append(errs, fmt.Errorf("task_definition.placement_constraints: placement constraints are not supported with launch type %s", ecs.LaunchTypeFargate))
+	}
+	taskPlacement := ecsParams.RunParams.TaskPlacement
+	if len(taskPlacement.Strategies) > 0 || len(taskPlacement.Constraints) > 0 {
+		errs = append(errs, fmt.Errorf("run_params.task_placement: task placement is not supported with launch type %s", ecs.LaunchTypeFargate))
+	}
+	return errs
+}
+
+func validateFargateTaskSize(taskSize TaskSize) error {
+	if taskSize.Cpu == "" || taskSize.Memory == "" {
+		return fmt.Errorf("task_definition.task_size: cpu_limit and mem_limit are required with launch type %s", ecs.LaunchTypeFargate)
+	}
+	cpu, err := parseTaskCPU(taskSize.Cpu)
+	if err != nil {
+		return fmt.Errorf("task_definition.task_size.cpu_limit: %v", err)
+	}
+	memory, err := parseTaskMemory(taskSize.Memory)
+	if err != nil {
+		return fmt.Errorf("task_definition.task_size.mem_limit: %v", err)
+	}
+	validMemory, ok := fargateTaskSizes[cpu]
+	if !ok {
+		return fmt.Errorf("task_definition.task_size.cpu_limit: %s is not a valid CPU value for launch type %s", taskSize.Cpu, ecs.LaunchTypeFargate)
+	}
+	for _, m := range validMemory {
+		if m == memory {
+			return nil
+		}
+	}
+	return fmt.Errorf("task_definition.task_size: mem_limit %s is not valid with cpu_limit %s for launch type %s", taskSize.Memory, taskSize.Cpu, ecs.LaunchTypeFargate)
+}
+
+// parseTaskCPU parses a task CPU value, given either in CPU units or in vCPUs (e.g. "1 vCPU")
+func parseTaskCPU(value string) (int64, error) {
+	normalized := strings.ToLower(strings.Replace(value, " ", "", -1))
+	if strings.HasSuffix(normalized, "vcpu") {
+		vcpu, err := strconv.ParseFloat(strings.TrimSuffix(normalized, "vcpu"), 64)
+		if err != nil {
+			return 0, fmt.Errorf("could not parse %s as a CPU value", value)
+		}
+		return int64(vcpu * 1024), nil
+	}
+	cpu, err := strconv.ParseInt(normalized, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse %s as a CPU value", value)
+	}
+	return cpu, nil
+}
+
+// parseTaskMemory parses a task memory value into MiB. Values without units default to MiB.
+func parseTaskMemory(value string) (int64, error) {
+	normalized := strings.ToLower(strings.Replace(value, " ", "", -1))
+	multiplier := float64(1)
+	for _, suffix := range []string{"gib", "gb", "g"} {
+		if strings.HasSuffix(normalized, suffix) {
+			normalized = strings.TrimSuffix(normalized, suffix)
+			multiplier = 1024
+			break
+		}
+	}
+	for _, suffix := range []string{"mib", "mb", "m"} {
+		if strings.HasSuffix(normalized, suffix) {
+			normalized = strings.TrimSuffix(normalized, suffix)
+			break
+		}
+	}
+	memory, err := strconv.ParseFloat(normalized, 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse %s as a memory value", value)
+	}
+	return int64(memory * multiplier), nil
+}
+
+// supportsEFS returns whether the given Fargate platform version supports EFS
+// volumes. An empty platform version is supported, since ecs-cli uses
+// platform version 1.4.0 for Fargate tasks with EFS volumes by default.
+func supportsEFS(platformVersion string) bool {
+	if platformVersion == "" || platformVersion == platformVersionLatest {
+		return true
+	}
+	return compareVersions(platformVersion, minEFSFargatePlatformVersion) >= 0
+}
+
+// compareVersions compares two dotted version strings numerically
+func compareVersions(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aVal, bVal int
+		if i < len(aParts) {
+			aVal, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bVal, _ = strconv.Atoi(bParts[i])
+		}
+		if aVal != bVal {
+			if aVal < bVal {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// ValidateRegistryCreds checks the registry credentials output file used with the compose project
+func ValidateRegistryCreds(regCreds *regcredio.ECSRegistryCredsOutput, containerConfigs []adapter.ContainerConfig) ValidationErrors {
+	var errs ValidationErrors
+	if regCreds == nil {
+		return errs
+	}
+
+	composeServices := make(map[string]bool)
+	for _, containerConfig := range containerConfigs {
+		composeServices[containerConfig.Name] = true
+	}
+
+	resources := regCreds.CredentialResources
+	if role := resources.TaskExecutionRole; arn.IsARN(role) {
+		if roleARN, err := arn.Parse(role); err != nil || roleARN.Service != "iam" || !strings.HasPrefix(roleARN.Resource, "role/") {
+			errs = append(errs, fmt.Errorf("registry_credential_outputs.task_execution_role: %s is not a valid IAM role ARN", role))
+		}
+	}
+
+	registries := make([]string, 0, len(resources.ContainerCredentials))
+	for registry := range resources.ContainerCredentials {
+		registries = append(registries, registry)
+	}
+	sort.Strings(registries)
+
+	for _, registry := range registries {
+		entry := resources.ContainerCredentials[registry]
+		field := fmt.Sprintf("registry_credential_outputs.container_credentials.%s", registry)
+		if err := validateSecretsManagerARN(field+".credentials_parameter", entry.CredentialARN); err != nil {
+			errs = append(errs, err)
+		}
+		for _, containerName := range entry.ContainerNames {
+			if !composeServices[containerName] {
+				errs = append(errs, fmt.Errorf("%s.container_names: %s is not defined in the compose file", field, containerName))
+			}
+		}
+	}
+	return errs
+}
+
+func sortedServiceNames(containerDefs ContainerDefs) []string {
+	names := make([]string, 0, len(containerDefs))
+	for name := range containerDefs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func memoryRange(min, max, step int64) []int64 {
+	var values []int64
+	for m := min; m <= max; m += step {
+		values = append(values, m)
+	}
+	return values
+}
@@ -0,0 +1,294 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/regcredio"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadECSParamsStrict(t *testing.T) {
+	content := `version: 1
+task_defintion:
+  ecs_network_mode: host
+run_params:
+  network_configuration:
+    awsvpc_configuration:
+      subnets: subnet-feedface
+      assign_public_ip: ENABLED`
+
+	f, err := ioutil.TempFile("", "ecs-params")
+	assert.NoError(t, err, "Could not create ecs params tempfile")
+	defer os.Remove(f.Name())
+	_, err = f.Write([]byte(content))
+	assert.NoError(t, err, "Could not write data to ecs params tempfile")
+	assert.NoError(t, f.Close(), "Could not close tempfile")
+
+	ecsParams, err := ReadECSParamsStrict(f.Name(), ReadECSParamsOptions{})
+	validationErrs, ok := err.(ValidationErrors)
+	if assert.True(t, ok, "Expected ValidationErrors") {
+		assert.Len(t, validationErrs, 2, "Expected an error for each problem")
+		assert.Contains(t, validationErrs[0].Error(), `line 2: unknown field "task_defintion"`)
+		assert.Contains(t, validationErrs[1].Error(), "line 7")
+	}
+	if assert.NotNil(t, ecsParams, "Expected fields which could be parsed to be returned") {
+		assert.Equal(t, Enabled, ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.AssignPublicIp)
+	}
+
+	// The lenient reader still fails on type errors
+	_, err = ReadECSParams(f.Name())
+	assert.Error(t, err, "Expected type errors to fail the lenient reader")
+}
+
+func TestValidateECSParams_Valid(t *testing.T) {
+	ecsParams := &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			NetworkMode: ecs.NetworkModeAwsvpc,
+			TaskSize:    TaskSize{Cpu: "0.5 vCPU", Memory: "2GB"},
+			ContainerDefinitions: ContainerDefs{
+				"web": ContainerDef{
+					Secrets: []Secret{
+						{Name: "DB_PASSWORD", ValueFrom: "arn:aws:ssm:us-east-1:123456789012:parameter/db/password"},
+						{Name: "API_KEY", ValueFrom: "arn:aws:secretsmanager:us-east-1:123456789012:secret:api-key-AbCdEf"},
+						{Name: "TOKEN", ValueFrom: "token"},
+					},
+				},
+			},
+			EFSVolumes: []EFSVolume{{Name: "data", FileSystemID: aws.String("fs-1234")}},
+		},
+		RunParams: RunParams{
+			NetworkConfiguration: NetworkConfiguration{
+				AwsVpcConfiguration: AwsVpcConfiguration{Subnets: []string{"subnet-feedface"}},
+			},
+		},
+	}
+	input := ValidateECSParamsInput{
+		LaunchType: ecs.LaunchTypeFargate,
+		ContainerConfigs: []adapter.ContainerConfig{
+			{
+				Name:         "web",
+				PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(80), HostPort: aws.Int64(80)}},
+			},
+		},
+	}
+
+	assert.Empty(t, ValidateECSParams(ecsParams, input), "Expected no problems")
+}
+
+func TestValidateECSParams_ReportsAllProblems(t *testing.T) {
+	ecsParams := &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			NetworkMode: ecs.NetworkModeBridge,
+			TaskSize:    TaskSize{Cpu: "256", Memory: "4GB"},
+			ContainerDefinitions: ContainerDefs{
+				"web": ContainerDef{
+					Secrets: []Secret{{Name: "BUCKET", ValueFrom: "arn:aws:s3:::bucket/key"}},
+					RepositoryCredentials: RepositoryCredentials{
+						CredentialsParameter: "arn:aws:ssm:us-east-1:123456789012:parameter/creds",
+					},
+				},
+				"cache": ContainerDef{},
+			},
+			EFSVolumes:           []EFSVolume{{Name: "data", FileSystemID: aws.String("fs-1234")}},
+			PlacementConstraints: []Constraint{{Type: "memberOf", Expression: "attribute:foo == bar"}},
+		},
+	}
+	input := ValidateECSParamsInput{
+		LaunchType:       ecs.LaunchTypeFargate,
+		PlatformVersion:  "1.3.0",
+		ContainerConfigs: []adapter.ContainerConfig{{Name: "web"}},
+	}
+
+	errs := ValidateECSParams(ecsParams, input)
+	// unknown service, secret ARN, credentials parameter ARN, network mode,
+	// task size, EFS platform version and placement constraints
+	assert.Len(t, errs, 7, "Expected every problem to be reported: %v", errs)
+}
+
+func TestValidateECSParams_NetworkModePortMappings(t *testing.T) {
+	testCases := map[string]struct {
+		networkMode  string
+		portMappings []*ecs.PortMapping
+		expectError  bool
+	}{
+		"bridge with different ports": {
+			networkMode:  ecs.NetworkModeBridge,
+			portMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(80), HostPort: aws.Int64(8080)}},
+		},
+		"host with matching ports": {
+			networkMode:  ecs.NetworkModeHost,
+			portMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(80), HostPort: aws.Int64(80)}},
+		},
+		"host with different ports": {
+			networkMode:  ecs.NetworkModeHost,
+			portMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(80), HostPort: aws.Int64(8080)}},
+			expectError:  true,
+		},
+		"none with port mappings": {
+			networkMode:  ecs.NetworkModeNone,
+			portMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(80)}},
+			expectError:  true,
+		},
+		"invalid network mode": {
+			networkMode: "nat",
+			expectError: true,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ecsParams := &ECSParams{TaskDefinition: EcsTaskDef{NetworkMode: test.networkMode}}
+			input := ValidateECSParamsInput{
+				ContainerConfigs: []adapter.ContainerConfig{{Name: "web", PortMappings: test.portMappings}},
+			}
+			errs := ValidateECSParams(ecsParams, input)
+			if test.expectError {
+				assert.Len(t, errs, 1)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestValidateECSParams_TaskRolePolicies(t *testing.T) {
+	ecsParams := &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			TaskRoleArn: "arn:aws:iam::123456789012:role/app",
+			TaskRolePolicies: &TaskRolePolicies{
+				ManagedPolicyArns: []string{
+					"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess",
+					"AmazonS3ReadOnlyAccess",
+					"arn:aws:iam::123456789012:role/app",
+				},
+				Statements: []PolicyStatement{
+					{Actions: []string{"s3:GetObject"}, Resources: []string{"*"}},
+					{Effect: "allow", Actions: []string{"s3:GetObject"}, Resources: []string{"*"}},
+					{Effect: "Deny"},
+				},
+			},
+		},
+	}
+
+	errs := ValidateECSParams(ecsParams, ValidateECSParamsInput{})
+	if assert.Len(t, errs, 6, "Expected every problem to be reported: %v", errs) {
+		assert.Contains(t, errs[0].Error(), "can not be used with task_role_arn")
+		assert.Contains(t, errs[1].Error(), "managed_policy_arns[1]")
+		assert.Contains(t, errs[2].Error(), "managed_policy_arns[2]")
+		assert.Contains(t, errs[3].Error(), "statements[1].effect")
+		assert.Contains(t, errs[4].Error(), "statements[2].actions")
+		assert.Contains(t, errs[5].Error(), "statements[2].resources")
+	}
+}
+
+func TestValidateFargateTaskSize(t *testing.T) {
+	testCases := map[string]struct {
+		cpu         string
+		memory      string
+		expectError bool
+	}{
+		"smallest":                {cpu: "256", memory: "512"},
+		"vCPU and GB units":       {cpu: "1 vCPU", memory: "8 GB"},
+		"memory in MiB":           {cpu: "512", memory: "1024MiB"},
+		"largest for 4 vCPU":      {cpu: "4096", memory: "30GB"},
+		"8 vCPU in 4 GB steps":    {cpu: "8192", memory: "20GB"},
+		"8 vCPU not in 4 GB step": {cpu: "8192", memory: "17GB", expectError: true},
+		"memory too large":        {cpu: "256", memory: "4096", expectError: true},
+		"memory not multiple":     {cpu: "1024", memory: "2500", expectError: true},
+		"invalid cpu":             {cpu: "300", memory: "1024", expectError: true},
+		"unparseable cpu":         {cpu: "lots", memory: "1024", expectError: true},
+		"missing memory":          {cpu: "256", expectError: true},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateFargateTaskSize(TaskSize{Cpu: test.cpu, Memory: test.memory})
+			if test.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRegistryCreds(t *testing.T) {
+	regCreds := &regcredio.ECSRegistryCredsOutput{
+		CredentialResources: regcredio.CredResources{
+			TaskExecutionRole: "arn:aws:iam::123456789012:policy/not-a-role",
+			ContainerCredentials: map[string]regcredio.CredsOutputEntry{
+				"docker.io": {
+					CredentialARN:  "arn:aws:secretsmanager:us-east-1:123456789012:secret:dockerhub-AbCdEf",
+					ContainerNames: []string{"web", "worker"},
+				},
+				"quay.io": {
+					CredentialARN:  "quay-creds",
+					ContainerNames: []string{"web"},
+				},
+			},
+		},
+	}
+
+	errs := ValidateRegistryCreds(regCreds, []adapter.ContainerConfig{{Name: "web"}})
+	// task execution role, unknown container and credentials parameter
+	assert.Len(t, errs, 3, "Expected every problem to be reported: %v", errs)
+}
+
+func TestReadECSParamsStrict_KeepsServiceWithTypeError(t *testing.T) {
+	content := `version: 1
+task_definition:
+  services:
+    web:
+      cpu_shares: lots
+      secrets:
+        - value_from: arn:aws:s3:::bucket/key
+          name: BUCKET
+    cache:
+      essential: false`
+
+	f, err := ioutil.TempFile("", "ecs-params")
+	assert.NoError(t, err, "Could not create ecs params tempfile")
+	defer os.Remove(f.Name())
+	_, err = f.Write([]byte(content))
+	assert.NoError(t, err, "Could not write data to ecs params tempfile")
+	assert.NoError(t, f.Close(), "Could not close tempfile")
+
+	ecsParams, err := ReadECSParamsStrict(f.Name(), ReadECSParamsOptions{})
+	validationErrs, ok := err.(ValidationErrors)
+	if assert.True(t, ok, "Expected ValidationErrors") {
+		assert.Len(t, validationErrs, 1, "Expected an error for the type error")
+		assert.Contains(t, validationErrs[0].Error(), "line 5")
+	}
+	if !assert.NotNil(t, ecsParams, "Expected fields which could be parsed to be returned") {
+		return
+	}
+	web, ok := ecsParams.TaskDefinition.ContainerDefinitions["web"]
+	if assert.True(t, ok, "Expected service with a type error to be kept") {
+		assert.True(t, web.Essential, "Expected default essential of service")
+		assert.Len(t, web.Secrets, 1)
+	}
+
+	errs := ValidateECSParams(ecsParams, ValidateECSParamsInput{
+		ContainerConfigs: []adapter.ContainerConfig{{Name: "cache"}},
+	})
+	// unknown service and secret ARN of the service with the type error
+	assert.Len(t, errs, 2, "Expected the problems of the service to be reported: %v", errs)
+}
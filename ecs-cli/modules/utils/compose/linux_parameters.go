@@ -0,0 +1,210 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	minSwappiness = 0
+	maxSwappiness = 100
+
+	// fargateAllowedCapability is the only capability which can be added to containers running on Fargate
+	fargateAllowedCapability = "SYS_PTRACE"
+)
+
+// mergeLinuxParameters merges the linux_parameters specified in ecs-params
+// into the linux parameters converted from the compose file. Capabilities are
+// combined, while devices and shared memory size from ecs-params override
+// those from the compose file.
+func mergeLinuxParameters(serviceName string, linuxParams *ecs.LinuxParameters, ecsParamsLinuxParams *LinuxParameters) error {
+	if linuxParams.Capabilities == nil {
+		linuxParams.Capabilities = &ecs.KernelCapabilities{}
+	}
+	capabilities := linuxParams.Capabilities
+	capabilities.Add = mergeCapabilities(capabilities.Add, ecsParamsLinuxParams.Capabilities.Add)
+	capabilities.Drop = mergeCapabilities(capabilities.Drop, ecsParamsLinuxParams.Capabilities.Drop)
+	for _, capability := range capabilities.Add {
+		if containsCapability(capabilities.Drop, aws.StringValue(capability)) {
+			log.WithFields(log.Fields{
+				"service name": serviceName,
+				"capability":   aws.StringValue(capability),
+			}).Warn("Capability is listed in both cap_add and cap_drop")
+		}
+	}
+
+	if len(ecsParamsLinuxParams.Devices) > 0 {
+		devices, err := mergeDevices(serviceName, linuxParams.Devices, ecsParamsLinuxParams.Devices)
+		if err != nil {
+			return err
+		}
+		linuxParams.SetDevices(devices)
+	}
+
+	if initProcessEnabled := ecsParamsLinuxParams.InitProcessEnabled; initProcessEnabled != nil {
+		if linuxParams.InitProcessEnabled != nil && aws.BoolValue(linuxParams.InitProcessEnabled) != *initProcessEnabled {
+			log.WithFields(log.Fields{
+				"option name":  "init_process_enabled",
+				"service name": serviceName,
+			}).Warnf("Using linux_parameters value as override (was %v but is now %v)", aws.BoolValue(linuxParams.InitProcessEnabled), *initProcessEnabled)
+		}
+		linuxParams.SetInitProcessEnabled(*initProcessEnabled)
+	}
+
+	if ecsParamsLinuxParams.SharedMemorySize != 0 {
+		shmSize := adapter.ConvertToMemoryInMB(int64(ecsParamsLinuxParams.SharedMemorySize))
+		if shmSize < 1 {
+			return fmt.Errorf("%s: shared_memory_size must be at least 1 MiB", serviceName)
+		}
+		if linuxParams.SharedMemorySize != nil {
+			showResourceOverrideMsg(serviceName, aws.Int64Value(linuxParams.SharedMemorySize), shmSize, "SharedMemorySize")
+		}
+		linuxParams.SetSharedMemorySize(shmSize)
+	}
+
+	if ecsParamsLinuxParams.MaxSwap != nil {
+		maxSwap := int64(*ecsParamsLinuxParams.MaxSwap)
+		if maxSwap < 0 {
+			return fmt.Errorf("%s: max_swap must be greater than or equal to 0", serviceName)
+		}
+		linuxParams.SetMaxSwap(adapter.ConvertToMemoryInMB(maxSwap))
+	}
+
+	if swappiness := ecsParamsLinuxParams.Swappiness; swappiness != nil {
+		if *swappiness < minSwappiness || *swappiness > maxSwappiness {
+			return fmt.Errorf("%s: swappiness must be between %d and %d, got %d", serviceName, minSwappiness, maxSwappiness, *swappiness)
+		}
+		if ecsParamsLinuxParams.MaxSwap == nil {
+			log.WithFields(log.Fields{
+				"option name":  "swappiness",
+				"service name": serviceName,
+			}).Warn("swappiness is ignored by ECS unless max_swap is also specified")
+		}
+		linuxParams.SetSwappiness(*swappiness)
+	}
+
+	return nil
+}
+
+// mergeCapabilities appends the ecs-params capabilities which are not already
+// present in the compose capabilities
+func mergeCapabilities(composeCapabilities []*string, ecsParamsCapabilities []string) []*string {
+	merged := composeCapabilities
+	for _, capability := range ecsParamsCapabilities {
+		if !containsCapability(merged, capability) {
+			merged = append(merged, aws.String(capability))
+		}
+	}
+	return merged
+}
+
+func containsCapability(capabilities []*string, capability string) bool {
+	for _, c := range capabilities {
+		if normalizeCapability(aws.StringValue(c)) == normalizeCapability(capability) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeCapability allows capabilities to be compared regardless of case or a "CAP_" prefix
+func normalizeCapability(capability string) string {
+	return strings.TrimPrefix(strings.ToUpper(capability), "CAP_")
+}
+
+// mergeDevices merges the ecs-params devices with the compose devices. Devices
+// are identified by their container path (or host path, if no container path
+// is given), and ecs-params devices replace compose devices with the same path.
+func mergeDevices(serviceName string, composeDevices []*ecs.Device, ecsParamsDevices []Device) ([]*ecs.Device, error) {
+	merged := composeDevices
+	for _, device := range ecsParamsDevices {
+		if device.HostPath == "" {
+			return nil, fmt.Errorf("%s: host_path is required for linux_parameters devices", serviceName)
+		}
+		ecsDevice := &ecs.Device{
+			HostPath: aws.String(device.HostPath),
+		}
+		if device.ContainerPath != "" {
+			ecsDevice.ContainerPath = aws.String(device.ContainerPath)
+		}
+		if len(device.Permissions) > 0 {
+			ecsDevice.Permissions = aws.StringSlice(device.Permissions)
+		}
+
+		replaced := false
+		for i, composeDevice := range merged {
+			if devicePath(composeDevice) == devicePath(ecsDevice) {
+				log.WithFields(log.Fields{
+					"option name":  "devices",
+					"service name": serviceName,
+				}).Warnf("Using ecs-params value as override for device %s", devicePath(ecsDevice))
+				merged[i] = ecsDevice
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, ecsDevice)
+		}
+	}
+	return merged, nil
+}
+
+// devicePath returns the path at which a device is exposed inside the container
+func devicePath(device *ecs.Device) string {
+	if device.ContainerPath != nil {
+		return aws.StringValue(device.ContainerPath)
+	}
+	return aws.StringValue(device.HostPath)
+}
+
+// validateFargateLinuxParameters checks that the linux parameters on each
+// container definition are supported by the Fargate launch type, so that
+// invalid task definitions fail before they are registered.
+func validateFargateLinuxParameters(containerDefs []*ecs.ContainerDefinition) error {
+	for _, containerDef := range containerDefs {
+		linuxParams := containerDef.LinuxParameters
+		if linuxParams == nil {
+			continue
+		}
+		name := aws.StringValue(containerDef.Name)
+		if linuxParams.Capabilities != nil {
+			for _, capability := range linuxParams.Capabilities.Add {
+				if normalizeCapability(aws.StringValue(capability)) != fargateAllowedCapability {
+					return fmt.Errorf("%s: capability %s cannot be added with launch type %s; only %s is supported", name, aws.StringValue(capability), ecs.LaunchTypeFargate, fargateAllowedCapability)
+				}
+			}
+		}
+		if len(linuxParams.Devices) > 0 {
+			return fmt.Errorf("%s: devices are not supported with launch type %s", name, ecs.LaunchTypeFargate)
+		}
+		if linuxParams.MaxSwap != nil || linuxParams.Swappiness != nil {
+			return fmt.Errorf("%s: max_swap and swappiness are not supported with launch type %s", name, ecs.LaunchTypeFargate)
+		}
+		if linuxParams.SharedMemorySize != nil {
+			return fmt.Errorf("%s: shared memory size is not supported with launch type %s", name, ecs.LaunchTypeFargate)
+		}
+		if len(linuxParams.Tmpfs) > 0 {
+			return fmt.Errorf("%s: tmpfs is not supported with launch type %s", name, ecs.LaunchTypeFargate)
+		}
+	}
+	return nil
+}
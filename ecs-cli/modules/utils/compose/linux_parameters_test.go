@@ -0,0 +1,238 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertToTaskDefinitionWithECSParams_LinuxParameters(t *testing.T) {
+	content := `version: 1
+task_definition:
+  services:
+    web:
+      linux_parameters:
+        capabilities:
+          add:
+            - SYS_PTRACE
+            - NET_ADMIN
+          drop:
+            - MKNOD
+        devices:
+          - host_path: /dev/xvdc
+            container_path: /dev/sdc
+            permissions:
+              - read
+          - host_path: /dev/fuse
+        init_process_enabled: true
+        max_swap: 1g
+        swappiness: 60
+        shared_memory_size: 128m`
+	ecsParams, err := createTempECSParamsForTest(t, content)
+	assert.NoError(t, err, "Could not read ECS Params file")
+
+	containerConfig := adapter.ContainerConfig{
+		Name:    "web",
+		Image:   "wordpress",
+		CapAdd:  []string{"NET_ADMIN"},
+		ShmSize: 64,
+		Devices: []*ecs.Device{
+			{
+				HostPath:      aws.String("/dev/sda"),
+				ContainerPath: aws.String("/dev/sdc"),
+			},
+			{
+				HostPath: aws.String("/dev/sdb"),
+			},
+		},
+	}
+	taskDefinition, err := convertToTaskDefinitionForTest(t, []adapter.ContainerConfig{containerConfig}, "", "", ecsParams, nil)
+
+	if assert.NoError(t, err) {
+		linuxParams := findContainerByName("web", taskDefinition.ContainerDefinitions).LinuxParameters
+		assert.ElementsMatch(t, []string{"NET_ADMIN", "SYS_PTRACE"}, aws.StringValueSlice(linuxParams.Capabilities.Add), "Expected capabilities to add to match")
+		assert.ElementsMatch(t, []string{"MKNOD"}, aws.StringValueSlice(linuxParams.Capabilities.Drop), "Expected capabilities to drop to match")
+		expectedDevices := []*ecs.Device{
+			{
+				HostPath:      aws.String("/dev/xvdc"),
+				ContainerPath: aws.String("/dev/sdc"),
+				Permissions:   aws.StringSlice([]string{"read"}),
+			},
+			{
+				HostPath: aws.String("/dev/sdb"),
+			},
+			{
+				HostPath: aws.String("/dev/fuse"),
+			},
+		}
+		assert.ElementsMatch(t, expectedDevices, linuxParams.Devices, "Expected devices to match")
+		assert.True(t, aws.BoolValue(linuxParams.InitProcessEnabled), "Expected initProcessEnabled to be true")
+		assert.Equal(t, int64(1024), aws.Int64Value(linuxParams.MaxSwap), "Expected maxSwap to match")
+		assert.Equal(t, int64(60), aws.Int64Value(linuxParams.Swappiness), "Expected swappiness to match")
+		assert.Equal(t, int64(128), aws.Int64Value(linuxParams.SharedMemorySize), "Expected sharedMemorySize to match")
+	}
+}
+
+func TestConvertToTaskDefinitionWithECSParams_LinuxParametersOverrideInitProcess(t *testing.T) {
+	content := `version: 1
+task_definition:
+  services:
+    web:
+      init_process_enabled: true
+      linux_parameters:
+        init_process_enabled: false`
+	ecsParams, err := createTempECSParamsForTest(t, content)
+	assert.NoError(t, err, "Could not read ECS Params file")
+
+	containerConfig := adapter.ContainerConfig{
+		Name:  "web",
+		Image: "wordpress",
+	}
+	taskDefinition, err := convertToTaskDefinitionForTest(t, []adapter.ContainerConfig{containerConfig}, "", "", ecsParams, nil)
+
+	if assert.NoError(t, err) {
+		linuxParams := findContainerByName("web", taskDefinition.ContainerDefinitions).LinuxParameters
+		assert.False(t, aws.BoolValue(linuxParams.InitProcessEnabled), "Expected initProcessEnabled to be false")
+	}
+}
+
+func TestConvertToTaskDefinitionWithECSParams_LinuxParametersInvalidSwappiness(t *testing.T) {
+	content := `version: 1
+task_definition:
+  services:
+    web:
+      linux_parameters:
+        max_swap: 0
+        swappiness: 101`
+	ecsParams, err := createTempECSParamsForTest(t, content)
+	assert.NoError(t, err, "Could not read ECS Params file")
+
+	containerConfig := adapter.ContainerConfig{
+		Name:  "web",
+		Image: "wordpress",
+	}
+	_, err = convertToTaskDefinitionForTest(t, []adapter.ContainerConfig{containerConfig}, "", "", ecsParams, nil)
+	assert.Error(t, err, "Expected error when swappiness is out of range")
+}
+
+func TestConvertToTaskDefinitionWithECSParams_LinuxParametersDeviceWithoutHostPath(t *testing.T) {
+	content := `version: 1
+task_definition:
+  services:
+    web:
+      linux_parameters:
+        devices:
+          - container_path: /dev/sdc`
+	ecsParams, err := createTempECSParamsForTest(t, content)
+	assert.NoError(t, err, "Could not read ECS Params file")
+
+	containerConfig := adapter.ContainerConfig{
+		Name:  "web",
+		Image: "wordpress",
+	}
+	_, err = convertToTaskDefinitionForTest(t, []adapter.ContainerConfig{containerConfig}, "", "", ecsParams, nil)
+	assert.Error(t, err, "Expected error when device has no host path")
+}
+
+func TestConvertToTaskDefinitionWithECSParams_LinuxParametersFargate(t *testing.T) {
+	content := `version: 1
+task_definition:
+  ecs_network_mode: awsvpc
+  services:
+    web:
+      linux_parameters:
+        capabilities:
+          add:
+            - SYS_PTRACE
+          drop:
+            - ALL
+        init_process_enabled: true`
+	ecsParams, err := createTempECSParamsForTest(t, content)
+	assert.NoError(t, err, "Could not read ECS Params file")
+
+	containerConfig := adapter.ContainerConfig{
+		Name:  "web",
+		Image: "wordpress",
+	}
+	taskDefinition, err := convertToTaskDefinitionForTest(t, []adapter.ContainerConfig{containerConfig}, "", ecs.LaunchTypeFargate, ecsParams, nil)
+
+	if assert.NoError(t, err) {
+		linuxParams := findContainerByName("web", taskDefinition.ContainerDefinitions).LinuxParameters
+		assert.Equal(t, []string{"SYS_PTRACE"}, aws.StringValueSlice(linuxParams.Capabilities.Add), "Expected capabilities to add to match")
+		assert.True(t, aws.BoolValue(linuxParams.InitProcessEnabled), "Expected initProcessEnabled to be true")
+	}
+}
+
+func TestConvertToTaskDefinitionWithECSParams_LinuxParametersFargateUnsupported(t *testing.T) {
+	testCases := map[string]struct {
+		content         string
+		containerConfig adapter.ContainerConfig
+	}{
+		"capability other than SYS_PTRACE": {
+			content: `version: 1
+task_definition:
+  services:
+    web:
+      linux_parameters:
+        capabilities:
+          add:
+            - NET_ADMIN`,
+		},
+		"compose capability": {
+			containerConfig: adapter.ContainerConfig{CapAdd: []string{"SYS_ADMIN"}},
+		},
+		"devices": {
+			content: `version: 1
+task_definition:
+  services:
+    web:
+      linux_parameters:
+        devices:
+          - host_path: /dev/fuse`,
+		},
+		"max swap": {
+			content: `version: 1
+task_definition:
+  services:
+    web:
+      linux_parameters:
+        max_swap: 512m`,
+		},
+		"shared memory size": {
+			containerConfig: adapter.ContainerConfig{ShmSize: 128},
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var ecsParams *ECSParams
+			if test.content != "" {
+				var err error
+				ecsParams, err = createTempECSParamsForTest(t, test.content)
+				assert.NoError(t, err)
+			}
+			containerConfig := test.containerConfig
+			containerConfig.Name = "web"
+			containerConfig.Image = "wordpress"
+
+			_, err := convertToTaskDefinitionForTest(t, []adapter.ContainerConfig{containerConfig}, "", ecs.LaunchTypeFargate, ecsParams, nil)
+			assert.Error(t, err, "Expected error for linux parameters unsupported on Fargate")
+		})
+	}
+}
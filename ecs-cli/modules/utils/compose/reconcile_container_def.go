@@ -95,6 +95,13 @@ func reconcileContainerDef(inputCfg *adapter.ContainerConfig, ecsConDef *Contain
 		outputContDef.LinuxParameters.SetTmpfs(inputCfg.Tmpfs)
 	}
 
+	// Merge linux parameters from ecs-params with those set from the compose file
+	if ecsConDef.LinuxParameters != nil {
+		if err := mergeLinuxParameters(inputCfg.Name, outputContDef.LinuxParameters, ecsConDef.LinuxParameters); err != nil {
+			return nil, err
+		}
+	}
+
 	// initialize container resources from inputCfg
 	cpu := inputCfg.CPU
 	memLimit := inputCfg.Memory
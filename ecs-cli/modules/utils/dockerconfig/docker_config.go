@@ -0,0 +1,173 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package dockerconfig updates the registry credentials in the Docker config file.
+package dockerconfig
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	configDirEnvVar = "DOCKER_CONFIG"
+	configDir       = ".docker"
+	configFileName  = "config.json"
+
+	authsKey       = "auths"
+	credHelpersKey = "credHelpers"
+	credsStoreKey  = "credsStore"
+
+	configDirMode  = 0700
+	configFileMode = 0600
+)
+
+// ConfigFile is the Docker config file. Settings other than credentials are
+// kept as they are when the file is saved.
+type ConfigFile struct {
+	Path   string
+	fields map[string]json.RawMessage
+}
+
+// Load reads the Docker config file from $DOCKER_CONFIG, or ~/.docker by default
+func Load() (*ConfigFile, error) {
+	dir := os.Getenv(configDirEnvVar)
+	if dir == "" {
+		homeDir, err := utils.GetHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = filepath.Join(homeDir, configDir)
+	}
+	return LoadFile(filepath.Join(dir, configFileName))
+}
+
+// LoadFile reads a Docker config file; a file which does not exist is empty
+func LoadFile(path string) (*ConfigFile, error) {
+	config := &ConfigFile{
+		Path:   path,
+		fields: make(map[string]json.RawMessage),
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > 0 {
+		if err = json.Unmarshal(data, &config.fields); err != nil {
+			return nil, errors.Wrapf(err, "unable to parse Docker config file %s", path)
+		}
+	}
+	return config, nil
+}
+
+// CredentialsStore returns the credentials store which Docker keeps the
+// credentials of a registry in, instead of the config file, if any
+func (c *ConfigFile) CredentialsStore(registry string) string {
+	helpers := map[string]string{}
+	if err := c.get(credHelpersKey, &helpers); err == nil {
+		if helper, ok := helpers[registry]; ok {
+			return helper
+		}
+	}
+	store := ""
+	c.get(credsStoreKey, &store)
+	return store
+}
+
+// SetAuth stores the username and password of a registry
+func (c *ConfigFile) SetAuth(registry, username, password string) error {
+	auths := map[string]json.RawMessage{}
+	if err := c.get(authsKey, &auths); err != nil {
+		return err
+	}
+	auth, err := json.Marshal(map[string]string{
+		"auth": base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+	})
+	if err != nil {
+		return err
+	}
+	auths[registry] = auth
+	return c.set(authsKey, auths)
+}
+
+// SetCredentialHelper makes Docker get the credentials of a registry from
+// the credential helper docker-credential-<helper>
+func (c *ConfigFile) SetCredentialHelper(registry, helper string) error {
+	helpers := map[string]string{}
+	if err := c.get(credHelpersKey, &helpers); err != nil {
+		return err
+	}
+	helpers[registry] = helper
+	return c.set(credHelpersKey, helpers)
+}
+
+// Save writes the config file, which is only readable by its owner since it
+// contains credentials
+func (c *ConfigFile) Save() error {
+	data, err := json.MarshalIndent(c.fields, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(c.Path), configDirMode); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so that the config is not lost if writing fails
+	temp, err := ioutil.TempFile(filepath.Dir(c.Path), filepath.Base(c.Path))
+	if err != nil {
+		return err
+	}
+	if _, err = temp.Write(data); err != nil {
+		temp.Close()
+		os.Remove(temp.Name())
+		return err
+	}
+	if err = temp.Close(); err != nil {
+		os.Remove(temp.Name())
+		return err
+	}
+	if err = os.Chmod(temp.Name(), configFileMode); err != nil {
+		os.Remove(temp.Name())
+		return err
+	}
+	return os.Rename(temp.Name(), c.Path)
+}
+
+func (c *ConfigFile) get(key string, value interface{}) error {
+	raw, ok := c.fields[key]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal(raw, value); err != nil {
+		return errors.Wrapf(err, "unable to parse %s in Docker config file %s", key, c.Path)
+	}
+	return nil
+}
+
+func (c *ConfigFile) set(key string, value interface{}) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	c.fields[key] = raw
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package dockerconfig
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const registry = "123456789012.dkr.ecr.us-west-2.amazonaws.com"
+
+func TestSetAuthKeepsOtherSettings(t *testing.T) {
+	dir, err := ioutil.TempDir("", "docker-config")
+	assert.NoError(t, err, "Unexpected error creating temp dir")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.json")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(`{"auths":{"quay.io":{"auth":"cXVheQ=="}},"detachKeys":"ctrl-e,e"}`), 0600))
+
+	config, err := LoadFile(path)
+	assert.NoError(t, err, "Unexpected error loading config")
+	assert.NoError(t, config.SetAuth(registry, "AWS", "secret"))
+	assert.NoError(t, config.Save())
+
+	info, err := os.Stat(path)
+	assert.NoError(t, err, "Expected config file to exist")
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err, "Unexpected error reading config")
+	saved := struct {
+		Auths      map[string]map[string]string `json:"auths"`
+		DetachKeys string                       `json:"detachKeys"`
+	}{}
+	assert.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, "QVdTOnNlY3JldA==", saved.Auths[registry]["auth"])
+	assert.Equal(t, "cXVheQ==", saved.Auths["quay.io"]["auth"])
+	assert.Equal(t, "ctrl-e,e", saved.DetachKeys)
+}
+
+func TestSetCredentialHelperCreatesFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "docker-config")
+	assert.NoError(t, err, "Unexpected error creating temp dir")
+	defer os.RemoveAll(dir)
+	os.Setenv(configDirEnvVar, filepath.Join(dir, "docker"))
+	defer os.Unsetenv(configDirEnvVar)
+
+	config, err := Load()
+	assert.NoError(t, err, "Unexpected error loading missing config")
+	assert.NoError(t, config.SetCredentialHelper(registry, "ecs-cli"))
+	assert.NoError(t, config.Save())
+
+	config, err = Load()
+	assert.NoError(t, err, "Unexpected error loading config")
+	assert.Equal(t, "ecs-cli", config.CredentialsStore(registry))
+	assert.Equal(t, "", config.CredentialsStore("quay.io"))
+}
+
+func TestCredentialsStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "docker-config")
+	assert.NoError(t, err, "Unexpected error creating temp dir")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.json")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(`{"credsStore":"desktop","credHelpers":{"`+registry+`":"ecr-login"}}`), 0600))
+
+	config, err := LoadFile(path)
+	assert.NoError(t, err, "Unexpected error loading config")
+	assert.Equal(t, "ecr-login", config.CredentialsStore(registry))
+	assert.Equal(t, "desktop", config.CredentialsStore("quay.io"))
+}
+
+func TestLoadFileInvalid(t *testing.T) {
+	file, err := ioutil.TempFile("", "config.json")
+	assert.NoError(t, err, "Unexpected error creating temp file")
+	defer os.Remove(file.Name())
+	file.WriteString("{")
+	file.Close()
+
+	_, err = LoadFile(file.Name())
+	assert.Error(t, err, "Expected error for an invalid config file")
+}
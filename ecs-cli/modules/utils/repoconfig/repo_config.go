@@ -0,0 +1,100 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package repoconfig reads the file which holds the settings that new ECR
+// repositories are created with.
+package repoconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Values of image_tag_mutability
+const (
+	TagMutabilityMutable   = "MUTABLE"
+	TagMutabilityImmutable = "IMMUTABLE"
+)
+
+// RepoConfig contains the settings of new repositories
+type RepoConfig struct {
+	Version            string            `yaml:"version"`
+	ImageTagMutability string            `yaml:"image_tag_mutability"`
+	ScanOnPush         bool              `yaml:"scan_on_push"`
+	KMSKey             string            `yaml:"kms_key"`
+	PolicyFile         string            `yaml:"policy_file"`
+	Tags               map[string]string `yaml:"tags"`
+
+	// Policy is the contents of the policy file
+	Policy string `yaml:"-"`
+}
+
+// ReadRepoConfig parses a repository config file, and reads the repository
+// policy file it refers to, which may be relative to the config file
+func ReadRepoConfig(filename string) (*RepoConfig, error) {
+	rawConfig, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error reading file '%v'", filename)
+	}
+
+	config := &RepoConfig{}
+	if err = yaml.UnmarshalStrict(rawConfig, config); err != nil {
+		return nil, errors.Wrapf(err, "Error unmarshalling yaml data from repository config file: %s", filename)
+	}
+
+	if config.ImageTagMutability, err = ValidateTagMutability(config.ImageTagMutability); err != nil {
+		return nil, errors.Wrapf(err, "Invalid repository config file %s", filename)
+	}
+
+	if config.PolicyFile != "" {
+		policyFile := config.PolicyFile
+		if !filepath.IsAbs(policyFile) {
+			policyFile = filepath.Join(filepath.Dir(filename), policyFile)
+		}
+		if config.Policy, err = ReadPolicy(policyFile); err != nil {
+			return nil, err
+		}
+	}
+
+	return config, nil
+}
+
+// ReadPolicy reads a repository policy from a JSON file
+func ReadPolicy(filename string) (string, error) {
+	policy, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", errors.Wrapf(err, "Error reading repository policy file '%v'", filename)
+	}
+	if !json.Valid(policy) {
+		return "", fmt.Errorf("Repository policy file %s is not valid JSON", filename)
+	}
+	return string(policy), nil
+}
+
+// ValidateTagMutability returns the image tag mutability in upper case, or
+// an error if it is not MUTABLE or IMMUTABLE. An empty value is allowed.
+func ValidateTagMutability(mutability string) (string, error) {
+	mutability = strings.ToUpper(mutability)
+	switch mutability {
+	case "", TagMutabilityMutable, TagMutabilityImmutable:
+		return mutability, nil
+	default:
+		return "", fmt.Errorf("image tag mutability must be %s or %s, not %s", TagMutabilityMutable, TagMutabilityImmutable, mutability)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2015-2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package repoconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadRepoConfig(t *testing.T) {
+	configString := `version: 1
+image_tag_mutability: immutable
+scan_on_push: true
+kms_key: alias/ecr
+policy_file: policy.json
+tags:
+  team: green`
+	policy := `{"Version":"2012-10-17","Statement":[]}`
+
+	dir, err := ioutil.TempDir("", "repoconfig")
+	assert.NoError(t, err, "Unexpected error creating temp dir")
+	defer os.RemoveAll(dir)
+	configFile := filepath.Join(dir, "repo-config.yml")
+	assert.NoError(t, ioutil.WriteFile(configFile, []byte(configString), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "policy.json"), []byte(policy), 0644))
+
+	config, err := ReadRepoConfig(configFile)
+	if assert.NoError(t, err, "Unexpected error reading file") {
+		assert.Equal(t, TagMutabilityImmutable, config.ImageTagMutability)
+		assert.True(t, config.ScanOnPush)
+		assert.Equal(t, "alias/ecr", config.KMSKey)
+		assert.Equal(t, policy, config.Policy, "Expected policy relative to the config file to be read")
+		assert.Equal(t, map[string]string{"team": "green"}, config.Tags)
+	}
+}
+
+func TestReadRepoConfig_ErrorCases(t *testing.T) {
+	for name, configString := range map[string]string{
+		"unknown field":       "scan_on_push: true\nencryption: KMS",
+		"invalid mutability":  "image_tag_mutability: sometimes",
+		"missing policy file": "policy_file: missing.json",
+	} {
+		t.Run(name, func(t *testing.T) {
+			tmpfile, err := ioutil.TempFile("", "repoconfig")
+			assert.NoError(t, err, "Unexpected error in creating test file")
+			defer os.Remove(tmpfile.Name())
+			_, err = tmpfile.Write([]byte(configString))
+			assert.NoError(t, err, "Unexpected error writing file")
+			assert.NoError(t, tmpfile.Close())
+
+			_, err = ReadRepoConfig(tmpfile.Name())
+			assert.Error(t, err, "Expected error reading config")
+		})
+	}
+}
+
+func TestReadPolicy_InvalidJSON(t *testing.T) {
+	tmpfile, err := ioutil.TempFile("", "policy")
+	assert.NoError(t, err, "Unexpected error in creating test file")
+	defer os.Remove(tmpfile.Name())
+	_, err = tmpfile.Write([]byte(`{"Version":`))
+	assert.NoError(t, err, "Unexpected error writing file")
+	assert.NoError(t, tmpfile.Close())
+
+	_, err = ReadPolicy(tmpfile.Name())
+	assert.Error(t, err, "Expected error for a policy which is not JSON")
+}